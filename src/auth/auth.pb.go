@@ -77,27 +77,34 @@ const (
 	Permission_CLUSTER_LICENSE_DELETE_CLUSTER             Permission = 136
 	Permission_CLUSTER_LICENSE_LIST_CLUSTERS              Permission = 137
 	// TODO(actgardner): Make k8s secrets into nouns and add an Update RPC
-	Permission_CLUSTER_CREATE_SECRET       Permission = 143
-	Permission_CLUSTER_LIST_SECRETS        Permission = 144
-	Permission_SECRET_DELETE               Permission = 145
-	Permission_SECRET_INSPECT              Permission = 146
-	Permission_CLUSTER_DELETE_ALL          Permission = 138
-	Permission_REPO_READ                   Permission = 200
-	Permission_REPO_WRITE                  Permission = 201
-	Permission_REPO_MODIFY_BINDINGS        Permission = 202
-	Permission_REPO_DELETE                 Permission = 203
-	Permission_REPO_INSPECT_COMMIT         Permission = 204
-	Permission_REPO_LIST_COMMIT            Permission = 205
-	Permission_REPO_DELETE_COMMIT          Permission = 206
-	Permission_REPO_CREATE_BRANCH          Permission = 207
-	Permission_REPO_LIST_BRANCH            Permission = 208
-	Permission_REPO_DELETE_BRANCH          Permission = 209
-	Permission_REPO_INSPECT_FILE           Permission = 210
-	Permission_REPO_LIST_FILE              Permission = 211
-	Permission_REPO_ADD_PIPELINE_READER    Permission = 212
-	Permission_REPO_REMOVE_PIPELINE_READER Permission = 213
-	Permission_REPO_ADD_PIPELINE_WRITER    Permission = 214
-	Permission_PIPELINE_LIST_JOB           Permission = 301
+	Permission_CLUSTER_CREATE_SECRET         Permission = 143
+	Permission_CLUSTER_LIST_SECRETS          Permission = 144
+	Permission_SECRET_DELETE                 Permission = 145
+	Permission_SECRET_INSPECT                Permission = 146
+	Permission_CLUSTER_DELETE_ALL            Permission = 138
+	Permission_CLUSTER_ROTATE_STORAGE_KEY    Permission = 149
+	Permission_CLUSTER_INSPECT_STORAGE_KEY   Permission = 150
+	Permission_CLUSTER_EDIT_QUOTA            Permission = 151
+	Permission_CLUSTER_EDIT_WEBHOOKS         Permission = 152
+	Permission_CLUSTER_LIST_WEBHOOKS         Permission = 153
+	Permission_REPO_READ                     Permission = 200
+	Permission_REPO_WRITE                    Permission = 201
+	Permission_REPO_MODIFY_BINDINGS          Permission = 202
+	Permission_REPO_DELETE                   Permission = 203
+	Permission_REPO_INSPECT_COMMIT           Permission = 204
+	Permission_REPO_LIST_COMMIT              Permission = 205
+	Permission_REPO_DELETE_COMMIT            Permission = 206
+	Permission_REPO_CREATE_BRANCH            Permission = 207
+	Permission_REPO_LIST_BRANCH              Permission = 208
+	Permission_REPO_DELETE_BRANCH            Permission = 209
+	Permission_REPO_INSPECT_FILE             Permission = 210
+	Permission_REPO_LIST_FILE                Permission = 211
+	Permission_REPO_ADD_PIPELINE_READER      Permission = 212
+	Permission_REPO_REMOVE_PIPELINE_READER   Permission = 213
+	Permission_REPO_ADD_PIPELINE_WRITER      Permission = 214
+	Permission_REPO_MODIFY_BRANCH_PROTECTION Permission = 215
+	Permission_REPO_BYPASS_BRANCH_PROTECTION Permission = 216
+	Permission_PIPELINE_LIST_JOB             Permission = 301
 )
 
 var Permission_name = map[int32]string{
@@ -147,6 +154,11 @@ var Permission_name = map[int32]string{
 	145: "SECRET_DELETE",
 	146: "SECRET_INSPECT",
 	138: "CLUSTER_DELETE_ALL",
+	149: "CLUSTER_ROTATE_STORAGE_KEY",
+	150: "CLUSTER_INSPECT_STORAGE_KEY",
+	151: "CLUSTER_EDIT_QUOTA",
+	152: "CLUSTER_EDIT_WEBHOOKS",
+	153: "CLUSTER_LIST_WEBHOOKS",
 	200: "REPO_READ",
 	201: "REPO_WRITE",
 	202: "REPO_MODIFY_BINDINGS",
@@ -162,6 +174,8 @@ var Permission_name = map[int32]string{
 	212: "REPO_ADD_PIPELINE_READER",
 	213: "REPO_REMOVE_PIPELINE_READER",
 	214: "REPO_ADD_PIPELINE_WRITER",
+	215: "REPO_MODIFY_BRANCH_PROTECTION",
+	216: "REPO_BYPASS_BRANCH_PROTECTION",
 	301: "PIPELINE_LIST_JOB",
 }
 
@@ -212,6 +226,11 @@ var Permission_value = map[string]int32{
 	"SECRET_DELETE":                              145,
 	"SECRET_INSPECT":                             146,
 	"CLUSTER_DELETE_ALL":                         138,
+	"CLUSTER_ROTATE_STORAGE_KEY":                 149,
+	"CLUSTER_INSPECT_STORAGE_KEY":                150,
+	"CLUSTER_EDIT_QUOTA":                         151,
+	"CLUSTER_EDIT_WEBHOOKS":                      152,
+	"CLUSTER_LIST_WEBHOOKS":                      153,
 	"REPO_READ":                                  200,
 	"REPO_WRITE":                                 201,
 	"REPO_MODIFY_BINDINGS":                       202,
@@ -227,6 +246,8 @@ var Permission_value = map[string]int32{
 	"REPO_ADD_PIPELINE_READER":                   212,
 	"REPO_REMOVE_PIPELINE_READER":                213,
 	"REPO_ADD_PIPELINE_WRITER":                   214,
+	"REPO_MODIFY_BRANCH_PROTECTION":              215,
+	"REPO_BYPASS_BRANCH_PROTECTION":              216,
 	"PIPELINE_LIST_JOB":                          301,
 }
 
@@ -3188,181 +3209,185 @@ func init() {
 func init() { proto.RegisterFile("auth/auth.proto", fileDescriptor_712ec48c1eaf43a2) }
 
 var fileDescriptor_712ec48c1eaf43a2 = []byte{
-	// 2778 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x59, 0x77, 0xdb, 0xc6,
-	0xf5, 0x37, 0x24, 0x4b, 0x22, 0xaf, 0x36, 0x78, 0x44, 0x49, 0x14, 0x6c, 0x89, 0x12, 0x1c, 0xc7,
-	0xcb, 0xff, 0x1f, 0x29, 0x51, 0x9a, 0xd6, 0x49, 0xfc, 0xc2, 0x05, 0xa2, 0x91, 0x50, 0x24, 0xcf,
-	0x00, 0xb4, 0xe3, 0x9e, 0x9e, 0xa2, 0x14, 0x39, 0x96, 0x50, 0x4b, 0x04, 0x03, 0x80, 0xaa, 0x95,
-	0x36, 0x6d, 0xd3, 0x7d, 0x4f, 0xba, 0x9c, 0xbe, 0xf7, 0x03, 0xf4, 0xa5, 0xfd, 0x12, 0xe9, 0x9e,
-	0xae, 0x8f, 0x6e, 0x8f, 0x3e, 0x42, 0x4f, 0x4f, 0x9f, 0x7b, 0x30, 0x18, 0x00, 0x03, 0x10, 0x94,
-	0x1d, 0xe7, 0xe4, 0x45, 0xc2, 0xdc, 0xfb, 0xbb, 0xcb, 0xdc, 0xb9, 0x33, 0xb8, 0x73, 0x41, 0x98,
-	0x6f, 0x0f, 0xdc, 0x83, 0x2d, 0xef, 0xcf, 0x66, 0xdf, 0xb6, 0x5c, 0x0b, 0x4d, 0x79, 0xcf, 0xc6,
-	0xf1, 0xb6, 0x94, 0xdb, 0xb7, 0xf6, 0x2d, 0x4a, 0xdb, 0xf2, 0x9e, 0x7c, 0xb6, 0x54, 0xd8, 0xb7,
-	0xac, 0xfd, 0x43, 0xb2, 0x45, 0x47, 0x7b, 0x83, 0xfb, 0x5b, 0xae, 0x79, 0x44, 0x1c, 0xb7, 0x7d,
-	0xd4, 0xf7, 0x01, 0xf2, 0xf3, 0x30, 0x5f, 0xec, 0xb8, 0xe6, 0x71, 0xdb, 0x25, 0x98, 0xbc, 0x39,
-	0x20, 0x8e, 0x8b, 0x56, 0x01, 0x6c, 0xcb, 0x72, 0x0d, 0xd7, 0x7a, 0x40, 0x7a, 0x79, 0x61, 0x5d,
-	0xb8, 0x96, 0xc5, 0x59, 0x8f, 0xa2, 0x7b, 0x04, 0xf9, 0x05, 0x10, 0x23, 0x09, 0xa7, 0x6f, 0xf5,
-	0x1c, 0xe2, 0x89, 0xf4, 0xdb, 0x9d, 0x83, 0xb8, 0x88, 0x47, 0xf1, 0x45, 0x16, 0xe0, 0x42, 0x85,
-	0xb4, 0xe3, 0x66, 0xe4, 0x1c, 0x20, 0x9e, 0xe8, 0x6b, 0x92, 0x3f, 0x05, 0x4b, 0xd8, 0x72, 0x3d,
-	0x4a, 0x60, 0xf0, 0x09, 0xdd, 0xba, 0x09, 0xcb, 0x43, 0x82, 0x91, 0x77, 0x67, 0x49, 0xfe, 0x62,
-	0x0c, 0xa0, 0xa1, 0x56, 0xca, 0x65, 0xab, 0x77, 0xdf, 0xdc, 0x47, 0x4b, 0x30, 0x69, 0x3a, 0xce,
-	0x80, 0xd8, 0x0c, 0xc9, 0x46, 0xe8, 0x3a, 0x64, 0x3b, 0x87, 0x26, 0xe9, 0xb9, 0x86, 0xd9, 0xcd,
-	0x8f, 0x79, 0xac, 0xd2, 0xcc, 0xe9, 0xa3, 0x42, 0xa6, 0x4c, 0x89, 0x6a, 0x05, 0x67, 0x7c, 0xb6,
-	0xda, 0x45, 0x97, 0x61, 0x96, 0x41, 0x1d, 0xd2, 0xb1, 0x89, 0x9b, 0x1f, 0xa7, 0x9a, 0x66, 0x7c,
-	0xa2, 0x46, 0x69, 0x68, 0x1b, 0x66, 0x6c, 0xd2, 0x35, 0x6d, 0xd2, 0x71, 0x8d, 0x81, 0x6d, 0xe6,
-	0xcf, 0x53, 0x95, 0xf3, 0xa7, 0x8f, 0x0a, 0xd3, 0x98, 0xd1, 0x5b, 0x58, 0xc5, 0xd3, 0x01, 0xa8,
-	0x65, 0x9b, 0x9e, 0x6f, 0x4e, 0xc7, 0xea, 0x13, 0x27, 0x3f, 0xb1, 0x3e, 0xee, 0xf9, 0xe6, 0x8f,
-	0xd0, 0x27, 0x60, 0xc9, 0x26, 0x6f, 0x0e, 0x4c, 0x9b, 0x18, 0xe4, 0xa8, 0x6d, 0x1e, 0x1a, 0xc7,
-	0xc4, 0x36, 0xef, 0x9b, 0xa4, 0x9b, 0x9f, 0x5c, 0x17, 0xae, 0x65, 0x70, 0x8e, 0x71, 0x15, 0x8f,
-	0x79, 0x87, 0xf1, 0xd0, 0x75, 0x10, 0x0f, 0xad, 0x4e, 0xfb, 0xf0, 0xc0, 0x72, 0x5c, 0x83, 0xcd,
-	0x79, 0x8a, 0xe2, 0xe7, 0x43, 0xba, 0x4a, 0xc9, 0xf2, 0x0a, 0x2c, 0x57, 0x89, 0xeb, 0x47, 0x68,
-	0x60, 0xb7, 0x5d, 0xd3, 0x0a, 0xd6, 0x45, 0x6e, 0x41, 0x7e, 0x98, 0xc5, 0x22, 0xff, 0x32, 0xcc,
-	0x76, 0x78, 0x06, 0x0d, 0xe9, 0xf4, 0xf6, 0xc2, 0x26, 0xcb, 0xda, 0xcd, 0x28, 0xee, 0x38, 0x8e,
-	0x94, 0x75, 0x58, 0xd6, 0xd2, 0x2d, 0x7e, 0x14, 0xad, 0x12, 0xe4, 0xb5, 0x11, 0xce, 0xca, 0xbf,
-	0x12, 0x20, 0x4b, 0x33, 0x42, 0xed, 0xdd, 0xb7, 0x50, 0x1e, 0xa6, 0x9c, 0xc1, 0xde, 0xe7, 0x49,
-	0xc7, 0x65, 0x79, 0x10, 0x0c, 0x91, 0x06, 0x40, 0x1e, 0xf6, 0x4d, 0x66, 0x7b, 0x8c, 0xda, 0x96,
-	0x36, 0xfd, 0x8d, 0xb6, 0x19, 0x6c, 0xb4, 0x4d, 0x3d, 0xd8, 0x68, 0xa5, 0xe5, 0x7f, 0x3f, 0x2a,
-	0xcc, 0x77, 0xf7, 0x5e, 0x91, 0x23, 0x29, 0xf9, 0xbd, 0x7f, 0x16, 0x04, 0xcc, 0xa9, 0x41, 0x9f,
-	0x84, 0x99, 0x83, 0xb6, 0x73, 0x40, 0xba, 0x2c, 0x4b, 0x69, 0xc6, 0x94, 0x16, 0x02, 0x51, 0x4a,
-	0x34, 0x3c, 0x84, 0x8c, 0xa7, 0x7d, 0xa0, 0x9f, 0xbc, 0x9f, 0x85, 0x85, 0xe2, 0xc0, 0x3d, 0x20,
-	0x3d, 0xd7, 0xec, 0x70, 0x7b, 0xf8, 0xff, 0x01, 0x2c, 0xb3, 0xdb, 0x31, 0x1c, 0x6f, 0x47, 0xf8,
-	0x13, 0x28, 0xcd, 0x9e, 0x3e, 0x2a, 0x64, 0xbd, 0xd0, 0x68, 0x74, 0x9b, 0x64, 0x3d, 0x00, 0x7d,
-	0x44, 0x2b, 0x90, 0x31, 0x03, 0xc3, 0x63, 0xfe, 0x64, 0x4d, 0xa6, 0xff, 0x25, 0xc8, 0xc5, 0xf5,
-	0x3f, 0xd9, 0x8e, 0x9f, 0x87, 0xd9, 0xbb, 0x07, 0x56, 0xf1, 0x48, 0x0d, 0xb2, 0xe4, 0x1d, 0x01,
-	0xe6, 0x02, 0x0a, 0x53, 0x21, 0x41, 0x66, 0xe0, 0x10, 0xbb, 0xd7, 0x3e, 0x62, 0x1e, 0xe2, 0x70,
-	0xfc, 0xb1, 0xc4, 0x58, 0xd6, 0xe0, 0x52, 0x95, 0xb8, 0xd8, 0x3a, 0x24, 0xce, 0x8e, 0x65, 0x37,
-	0x89, 0x7d, 0x64, 0x3a, 0x0e, 0x97, 0x57, 0x2f, 0x02, 0xf4, 0x43, 0x22, 0x75, 0x69, 0x8e, 0x4b,
-	0x2a, 0x0e, 0xcf, 0xc1, 0xe4, 0x0a, 0xac, 0x8e, 0x50, 0xca, 0xa6, 0x79, 0x19, 0x26, 0x6c, 0x8f,
-	0x9b, 0x17, 0xd6, 0xc7, 0xaf, 0x4d, 0x6f, 0xcf, 0x86, 0x0a, 0x3d, 0x19, 0xec, 0xf3, 0x64, 0x1b,
-	0x26, 0xa8, 0x0a, 0xb4, 0x15, 0x47, 0xaf, 0xc4, 0xd0, 0x8e, 0xff, 0x57, 0xe9, 0xb9, 0xf6, 0x09,
-	0x93, 0x94, 0x6e, 0x02, 0x44, 0x44, 0x24, 0xc2, 0xf8, 0x03, 0x72, 0xc2, 0xc2, 0xe9, 0x3d, 0xa2,
-	0x1c, 0x4c, 0x1c, 0xb7, 0x0f, 0x07, 0x84, 0x06, 0x31, 0x83, 0xfd, 0xc1, 0x2b, 0x63, 0x37, 0x05,
-	0xf9, 0xe7, 0x02, 0x4c, 0x7b, 0xa2, 0x25, 0xb3, 0xd7, 0x35, 0x7b, 0xfb, 0xe8, 0x55, 0x98, 0x22,
-	0x3d, 0xd7, 0x36, 0x43, 0xe3, 0x1b, 0x31, 0xe3, 0x0c, 0xb6, 0xa9, 0xf8, 0x18, 0xdf, 0x89, 0x40,
-	0x42, 0x7a, 0x0d, 0x66, 0x78, 0x46, 0x8a, 0x23, 0xcf, 0xf0, 0x8e, 0x4c, 0x6f, 0xcf, 0xc5, 0x67,
-	0xc6, 0x3b, 0xa6, 0x42, 0x06, 0x13, 0xc7, 0x1a, 0xd8, 0x1d, 0x82, 0xae, 0xc3, 0x79, 0xf7, 0xa4,
-	0x4f, 0xd8, 0x6a, 0x2c, 0x46, 0x42, 0x0c, 0xa0, 0x9f, 0xf4, 0x09, 0xa6, 0x10, 0x84, 0xe0, 0x3c,
-	0xcd, 0x25, 0x3f, 0x83, 0xe9, 0xb3, 0xfc, 0x35, 0x01, 0x26, 0x5a, 0x0e, 0xb1, 0x1d, 0xf4, 0x2a,
-	0x64, 0x83, 0xec, 0x0a, 0xe6, 0xb7, 0x1a, 0x6a, 0xa3, 0x10, 0xfa, 0x97, 0xf2, 0xfd, 0xb9, 0x45,
-	0x78, 0xe9, 0x16, 0xcc, 0xc5, 0x99, 0x1f, 0x2a, 0xd0, 0x0f, 0x61, 0xb2, 0x6a, 0x5b, 0x83, 0xbe,
-	0x83, 0x5e, 0x84, 0xc9, 0x7d, 0xfa, 0xc4, 0x3c, 0xb8, 0x18, 0x7a, 0xe0, 0x03, 0xd8, 0x3f, 0xdf,
-	0x3e, 0x83, 0x4a, 0x2f, 0xc3, 0x34, 0x47, 0xfe, 0x50, 0x96, 0xdf, 0x15, 0xe0, 0xbc, 0x17, 0xde,
-	0x30, 0x36, 0x42, 0x14, 0x1b, 0xf4, 0x12, 0x4c, 0x47, 0x79, 0xec, 0xe4, 0xc7, 0xd6, 0xc7, 0x47,
-	0xe5, 0x3b, 0x8f, 0x43, 0xb7, 0x60, 0xce, 0x66, 0xc1, 0x37, 0xbc, 0xb8, 0x3b, 0xf9, 0x71, 0x2a,
-	0x39, 0x62, 0x6d, 0x66, 0x6d, 0x6e, 0xe4, 0xc8, 0x0f, 0x41, 0xf4, 0xce, 0x13, 0xcb, 0x36, 0xdf,
-	0x0a, 0x0f, 0xab, 0xe7, 0x20, 0x13, 0x80, 0xd8, 0x51, 0x7e, 0x61, 0x48, 0x17, 0x0e, 0x21, 0x4f,
-	0xe9, 0xb7, 0xfc, 0x6b, 0x01, 0x2e, 0x70, 0xa6, 0xd9, 0xee, 0x5c, 0x03, 0x68, 0x07, 0xc4, 0x2e,
-	0xb5, 0x9e, 0xc1, 0x1c, 0x05, 0xbd, 0x00, 0x59, 0xa7, 0xed, 0x9a, 0x0e, 0x7d, 0x99, 0x9e, 0x61,
-	0x2a, 0x42, 0xa1, 0xe7, 0x60, 0x8a, 0x52, 0x7b, 0xfb, 0x2c, 0x32, 0xa9, 0x02, 0x01, 0x06, 0x5d,
-	0x82, 0x6c, 0xdf, 0x36, 0x7b, 0x1d, 0xb3, 0xdf, 0x3e, 0xf4, 0x8b, 0x00, 0x1c, 0x11, 0xe4, 0x1d,
-	0x58, 0xac, 0x12, 0x37, 0x92, 0x73, 0x9e, 0x2e, 0x68, 0x72, 0x1f, 0x36, 0xe2, 0x7a, 0xbc, 0xc3,
-	0x2a, 0xb0, 0xf2, 0x94, 0x0b, 0x11, 0xf3, 0x7c, 0x2c, 0xe9, 0x39, 0x81, 0xa5, 0xa4, 0xe7, 0x2c,
-	0xe6, 0x89, 0x05, 0x14, 0x9e, 0x30, 0xf1, 0x72, 0xc1, 0xd1, 0x38, 0x46, 0x6b, 0x1f, 0x76, 0x72,
-	0xbe, 0x0d, 0xf9, 0x5d, 0xab, 0x6b, 0xde, 0x3f, 0xe1, 0xce, 0xa8, 0x8f, 0x63, 0x3e, 0x91, 0xf9,
-	0x71, 0xde, 0xfc, 0x45, 0x58, 0x49, 0x31, 0xcf, 0x2a, 0x0a, 0x7f, 0xf1, 0x3e, 0xb2, 0x63, 0xf2,
-	0x6d, 0x1a, 0xca, 0x14, 0x0b, 0x68, 0x13, 0xa6, 0xf6, 0x7c, 0x12, 0xd3, 0x93, 0x4b, 0x3b, 0xb3,
-	0x71, 0x00, 0x92, 0x3f, 0x07, 0xd3, 0x1a, 0xa1, 0xf1, 0xa4, 0x45, 0x4e, 0x0e, 0x26, 0x7a, 0x56,
-	0xaf, 0x13, 0x9c, 0x0b, 0xfe, 0xc0, 0xa3, 0xd2, 0x2a, 0x92, 0xc5, 0xc0, 0x1f, 0xa0, 0x2b, 0x30,
-	0xd7, 0xb1, 0x7a, 0xc7, 0xc4, 0xf6, 0xa4, 0x0d, 0x62, 0xdb, 0xb4, 0x46, 0xc9, 0xd0, 0x0a, 0x8b,
-	0x51, 0x15, 0xdb, 0x96, 0x17, 0x61, 0xa1, 0x4a, 0x5c, 0xaf, 0xcc, 0xa8, 0x59, 0xfb, 0x66, 0x58,
-	0x25, 0xde, 0x85, 0x5c, 0x9c, 0xcc, 0x26, 0x70, 0x1d, 0xb2, 0x87, 0x1e, 0xc1, 0x18, 0xd8, 0x87,
-	0xac, 0x4e, 0xa1, 0x55, 0x35, 0x45, 0xb5, 0x70, 0x0d, 0x67, 0x28, 0xbb, 0x65, 0xd3, 0x05, 0xf0,
-	0xcb, 0x19, 0xe6, 0x16, 0x1d, 0xc8, 0x55, 0xaa, 0x18, 0x5b, 0x7b, 0x89, 0xeb, 0x02, 0x5d, 0xae,
-	0x3d, 0x2b, 0xa8, 0xde, 0xfc, 0x01, 0x5a, 0x81, 0x71, 0xd7, 0xf5, 0x27, 0x36, 0x5e, 0x9a, 0x3a,
-	0x7d, 0x54, 0x18, 0xd7, 0xf5, 0x1a, 0xf6, 0x68, 0xf2, 0x73, 0x6c, 0xb1, 0xf6, 0x92, 0xd7, 0x87,
-	0x1c, 0x4c, 0xf0, 0x55, 0x8e, 0x3f, 0x90, 0x37, 0x61, 0x09, 0x93, 0x63, 0xeb, 0x01, 0xf1, 0xce,
-	0x94, 0xa4, 0xe5, 0x14, 0xfc, 0x0a, 0x2c, 0x0f, 0xe1, 0x59, 0x9a, 0xec, 0xd2, 0x52, 0xd7, 0x3f,
-	0xe3, 0x77, 0x2c, 0xdb, 0x7b, 0xd3, 0x04, 0xba, 0xce, 0xaa, 0x91, 0x96, 0xc2, 0x97, 0x89, 0xbf,
-	0x21, 0xd8, 0x88, 0xd5, 0xb8, 0x09, 0x75, 0xcc, 0xd4, 0x1d, 0xc8, 0xf9, 0xe9, 0xba, 0x4b, 0x8e,
-	0xf6, 0x88, 0xed, 0x70, 0x3e, 0x53, 0xe9, 0xc0, 0x67, 0x3a, 0xf0, 0x5e, 0x35, 0xed, 0x6e, 0x97,
-	0xa9, 0xf7, 0x1e, 0x3d, 0x9b, 0x36, 0x39, 0xb2, 0x8e, 0x09, 0xdb, 0x05, 0x6c, 0x24, 0x2f, 0xc3,
-	0x62, 0x42, 0x2f, 0x33, 0x88, 0x40, 0xac, 0x06, 0xce, 0x04, 0xb9, 0x70, 0x8b, 0xd6, 0x61, 0xa1,
-	0x83, 0x43, 0xc7, 0x50, 0x6c, 0x1f, 0x0a, 0xc9, 0x73, 0xe5, 0xff, 0xe0, 0x02, 0xa7, 0x91, 0xad,
-	0xd1, 0x52, 0xec, 0xc5, 0x1a, 0xc5, 0xe2, 0x2a, 0xcc, 0x57, 0x89, 0x4b, 0x5f, 0xef, 0x67, 0x4e,
-	0x55, 0x7e, 0x9e, 0xfa, 0xc9, 0x80, 0x4c, 0xe9, 0xa5, 0x64, 0xc9, 0x90, 0xe5, 0x6a, 0x02, 0x2f,
-	0xcc, 0xca, 0x43, 0xd7, 0x6e, 0x77, 0xdc, 0x70, 0x45, 0xc3, 0x19, 0x56, 0x61, 0x25, 0x85, 0xc7,
-	0xd4, 0xde, 0x80, 0x49, 0x9a, 0x12, 0x41, 0x11, 0x80, 0xc2, 0x2d, 0x1b, 0xde, 0x3e, 0x30, 0x43,
-	0xc8, 0x65, 0x2f, 0x6b, 0x1c, 0xd7, 0xb2, 0x87, 0xd3, 0xec, 0x1a, 0x9f, 0x66, 0xe9, 0x5a, 0x58,
-	0xea, 0x49, 0x90, 0x1f, 0x56, 0xc2, 0xd6, 0xe7, 0x16, 0xac, 0x25, 0xd2, 0xf2, 0x43, 0xa4, 0xa0,
-	0xbc, 0x01, 0x85, 0x91, 0xd2, 0xcc, 0xc0, 0x3a, 0xac, 0x55, 0xc8, 0x21, 0x71, 0x89, 0xe2, 0x15,
-	0xe2, 0xa4, 0x3b, 0x1c, 0xac, 0x0d, 0x28, 0x8c, 0x44, 0xf8, 0x4a, 0x6e, 0xfc, 0x67, 0x0e, 0x20,
-	0x7a, 0x2d, 0xa0, 0x25, 0x40, 0x4d, 0x05, 0xef, 0xaa, 0x9a, 0xa6, 0x36, 0xea, 0x46, 0xab, 0xfe,
-	0x7a, 0xbd, 0x71, 0xb7, 0x2e, 0x9e, 0x43, 0x17, 0x61, 0xb9, 0x5c, 0x6b, 0x69, 0xba, 0x82, 0x8d,
-	0xdd, 0x46, 0x45, 0xdd, 0xb9, 0x67, 0x94, 0xd4, 0x7a, 0x45, 0xad, 0x57, 0x35, 0xb1, 0x8b, 0xf2,
-	0x90, 0x0b, 0x98, 0x55, 0x45, 0x8f, 0x38, 0x04, 0x5d, 0x84, 0x25, 0x9e, 0xd3, 0x2c, 0x96, 0x6f,
-	0x57, 0x8c, 0x5a, 0xa3, 0xaa, 0x89, 0x3f, 0x13, 0xd0, 0x0a, 0x2c, 0x06, 0xcc, 0x62, 0x4b, 0xbf,
-	0x6d, 0x14, 0xcb, 0xba, 0x7a, 0xa7, 0xa8, 0x2b, 0xe2, 0x7d, 0xde, 0x1c, 0x65, 0x55, 0x94, 0x90,
-	0xb9, 0x3f, 0xc4, 0xf4, 0x34, 0x97, 0x1b, 0xf5, 0x1d, 0xb5, 0x2a, 0x1e, 0x0c, 0x31, 0xb5, 0x88,
-	0x69, 0xa2, 0x0d, 0xb8, 0x34, 0x24, 0x89, 0x1b, 0xa5, 0x86, 0x6e, 0xe8, 0x8d, 0xd7, 0x95, 0xba,
-	0xf8, 0x7d, 0x01, 0x5d, 0x81, 0x8d, 0x18, 0x84, 0xcd, 0xb6, 0x8a, 0x1b, 0xad, 0xa6, 0xb1, 0xab,
-	0xec, 0x96, 0x14, 0xac, 0x89, 0x47, 0xa9, 0x3e, 0x50, 0x8c, 0x26, 0xf6, 0xd0, 0x7a, 0x8a, 0x19,
-	0x5f, 0x41, 0x4b, 0xf3, 0xc4, 0x2d, 0x54, 0x80, 0x8b, 0x31, 0x84, 0xf2, 0x86, 0x8e, 0x8b, 0x65,
-	0xe6, 0x86, 0x26, 0xf6, 0xd1, 0x1a, 0x48, 0x31, 0x00, 0x56, 0x34, 0xbd, 0x81, 0x15, 0xe6, 0xe7,
-	0x9b, 0x68, 0x0b, 0x6e, 0x0c, 0x99, 0x88, 0x16, 0x4e, 0x33, 0x76, 0x1a, 0xd8, 0x68, 0x62, 0xb5,
-	0x5e, 0x56, 0x9b, 0xc5, 0x9a, 0xf8, 0x43, 0x01, 0x5d, 0x05, 0x39, 0x11, 0xd1, 0x9a, 0xa2, 0x2b,
-	0x86, 0xf2, 0x46, 0x53, 0xc5, 0x4a, 0x25, 0x30, 0xfc, 0x03, 0x01, 0x3d, 0x03, 0x85, 0x84, 0xe5,
-	0x3b, 0x8d, 0xd7, 0x15, 0xea, 0x79, 0x80, 0xfa, 0x91, 0x80, 0x2e, 0xc3, 0x5a, 0x1c, 0xd5, 0xd0,
-	0x8b, 0xba, 0x62, 0xe0, 0x46, 0x18, 0xcb, 0x9f, 0x0a, 0xfc, 0x2c, 0x95, 0xba, 0xae, 0xe0, 0x26,
-	0x56, 0x35, 0x25, 0x5a, 0x66, 0x9b, 0x0f, 0x14, 0x07, 0xb8, 0xad, 0x14, 0xb1, 0x5e, 0x52, 0x8a,
-	0xba, 0xe8, 0x8c, 0x50, 0xe1, 0xaf, 0x78, 0x45, 0x11, 0x5d, 0xb4, 0x01, 0xab, 0x29, 0x00, 0x2e,
-	0x5f, 0x06, 0xbc, 0x0e, 0xb5, 0xa2, 0xd4, 0x75, 0x55, 0xbf, 0xc7, 0xa7, 0xc5, 0x71, 0x2a, 0x80,
-	0x4b, 0xaa, 0x2f, 0xa4, 0x02, 0xca, 0x58, 0xf1, 0x66, 0xac, 0x56, 0x9a, 0xe2, 0xc3, 0x54, 0x40,
-	0xab, 0x59, 0x09, 0x00, 0x27, 0xfc, 0x7a, 0x86, 0x80, 0x9a, 0xaa, 0xe9, 0x1e, 0x5b, 0x13, 0xdf,
-	0x42, 0x97, 0x20, 0x9f, 0xea, 0x82, 0x27, 0xfd, 0xc5, 0x54, 0xf5, 0x6c, 0x01, 0x3d, 0xc0, 0x97,
-	0xd0, 0x55, 0xb8, 0x3c, 0xca, 0x41, 0xaf, 0x30, 0x30, 0xca, 0x35, 0x55, 0xa9, 0xeb, 0xe2, 0xdb,
-	0xa9, 0x40, 0xe6, 0x28, 0x0f, 0xfc, 0x32, 0x7a, 0x36, 0xca, 0x97, 0xb8, 0xc3, 0x1c, 0x4c, 0x13,
-	0xbf, 0x82, 0xae, 0xc0, 0x7a, 0xaa, 0xe3, 0xbc, 0xb6, 0xaf, 0x0a, 0xe8, 0x5a, 0x8a, 0x5d, 0x36,
-	0x03, 0x1e, 0xf9, 0x8e, 0x80, 0x96, 0x01, 0x05, 0xc8, 0x8a, 0x52, 0x6a, 0x55, 0x8d, 0x4a, 0x6b,
-	0xb7, 0x29, 0x7e, 0x5d, 0x40, 0xab, 0x51, 0x88, 0x6a, 0x6a, 0x59, 0xa9, 0xf3, 0xa9, 0xf4, 0x8d,
-	0x54, 0x76, 0x98, 0x26, 0xdf, 0x14, 0xd0, 0x7a, 0x14, 0xc2, 0x50, 0xba, 0x52, 0x31, 0x18, 0x4d,
-	0xfc, 0x56, 0x2c, 0xa5, 0x03, 0x04, 0x8b, 0x4c, 0x00, 0xfa, 0x76, 0x2a, 0x88, 0x4d, 0x23, 0x00,
-	0x7d, 0x47, 0x40, 0x72, 0x94, 0x93, 0x01, 0x88, 0x86, 0x8e, 0x11, 0x35, 0xf1, 0xbb, 0x02, 0x92,
-	0xa2, 0xc3, 0x8f, 0x2d, 0x94, 0xa6, 0x94, 0xb1, 0xa2, 0x8b, 0xef, 0x7a, 0x07, 0x63, 0x2e, 0x92,
-	0xd7, 0x74, 0xc6, 0xd1, 0xc4, 0xf7, 0x04, 0x84, 0x60, 0xd6, 0x1f, 0x31, 0xb3, 0xe2, 0x8f, 0x05,
-	0xb4, 0x00, 0x73, 0x8c, 0xa6, 0xd6, 0xb5, 0xa6, 0x52, 0xd6, 0xc5, 0x9f, 0x24, 0xc2, 0x48, 0x1d,
-	0x2c, 0xd6, 0x6a, 0xe2, 0xf7, 0x04, 0x34, 0x07, 0x59, 0xac, 0x34, 0x1b, 0x06, 0x56, 0x8a, 0x15,
-	0xf1, 0x7d, 0x01, 0xcd, 0x03, 0xd0, 0xf1, 0x5d, 0xac, 0xea, 0x8a, 0xf8, 0x1b, 0x6a, 0x9d, 0x12,
-	0x92, 0xe7, 0xfc, 0x6f, 0x05, 0x24, 0xc2, 0x34, 0x65, 0x31, 0xdb, 0xbf, 0x13, 0x50, 0x1e, 0x16,
-	0x28, 0x85, 0x59, 0x36, 0xca, 0x8d, 0xdd, 0x5d, 0x55, 0x17, 0x7f, 0x2f, 0xa0, 0x45, 0x10, 0x29,
-	0xc7, 0x9f, 0xb9, 0x4f, 0xfe, 0x03, 0xf5, 0x8b, 0x53, 0x11, 0x30, 0xfe, 0x18, 0x31, 0x58, 0x34,
-	0x4a, 0xb8, 0x58, 0x2f, 0xdf, 0x16, 0xff, 0x94, 0x50, 0xc4, 0xc8, 0x1f, 0x0c, 0x29, 0x62, 0x8c,
-	0x3f, 0x0b, 0x68, 0x09, 0x2e, 0xc4, 0x5c, 0xda, 0x51, 0x6b, 0x8a, 0xf8, 0x17, 0x1a, 0xa6, 0x48,
-	0x0f, 0x25, 0xfe, 0x95, 0x66, 0x0d, 0x25, 0x7a, 0xb9, 0xd0, 0x54, 0x9b, 0x4a, 0x4d, 0xad, 0x2b,
-	0x34, 0x34, 0x0a, 0x16, 0xff, 0x46, 0xb3, 0x86, 0x05, 0x6b, 0xb7, 0x71, 0x47, 0x19, 0x42, 0xfc,
-	0x7d, 0x84, 0x02, 0x1a, 0x4b, 0x2c, 0xfe, 0x83, 0x3a, 0x13, 0x52, 0xa9, 0xe1, 0xd7, 0x1a, 0x25,
-	0xf1, 0x97, 0x63, 0x37, 0x1a, 0x30, 0xc3, 0xdf, 0xe5, 0xbd, 0x77, 0x21, 0x56, 0xb4, 0x46, 0x0b,
-	0x97, 0x15, 0x43, 0xbf, 0xd7, 0x54, 0xb8, 0x57, 0xef, 0x34, 0x4c, 0x05, 0xb9, 0x25, 0xa0, 0x0c,
-	0x9c, 0xf7, 0xcc, 0x89, 0x63, 0x68, 0x16, 0xb2, 0xde, 0xfc, 0x0c, 0x3a, 0x1c, 0xdf, 0xfe, 0xaf,
-	0x08, 0xe3, 0xc5, 0xa6, 0x8a, 0x8a, 0x90, 0x09, 0xbe, 0x21, 0xa0, 0x7c, 0x58, 0xb8, 0x24, 0x3e,
-	0x44, 0x48, 0x2b, 0x29, 0x1c, 0x56, 0x55, 0x9c, 0x43, 0x55, 0x80, 0xe8, 0xf3, 0x01, 0x92, 0x42,
-	0xe8, 0xd0, 0x87, 0x06, 0xe9, 0x62, 0x2a, 0x2f, 0x54, 0x74, 0x8f, 0x56, 0x7e, 0xb1, 0x96, 0x30,
-	0x5a, 0x8f, 0xfa, 0x32, 0xe9, 0x3d, 0x68, 0x69, 0xe3, 0x0c, 0x04, 0xaf, 0x5a, 0x1b, 0xad, 0x5a,
-	0x7b, 0xac, 0x6a, 0x6d, 0xb4, 0xea, 0x5d, 0x98, 0xe1, 0xfb, 0xb2, 0xe8, 0x52, 0x14, 0xab, 0xe1,
-	0x76, 0xb0, 0xb4, 0x3a, 0x82, 0x1b, 0xaa, 0xab, 0x40, 0x36, 0xec, 0x8d, 0xa0, 0x95, 0x18, 0x9a,
-	0x6f, 0xd5, 0x48, 0x52, 0x1a, 0x2b, 0xd4, 0xa2, 0xc1, 0x5c, 0xfc, 0xca, 0x8f, 0xd6, 0xf8, 0x30,
-	0x0d, 0x77, 0x31, 0xa4, 0xc2, 0x48, 0x7e, 0xa8, 0xf4, 0x01, 0x48, 0xa3, 0x3b, 0x17, 0xe8, 0xc6,
-	0x08, 0x05, 0x29, 0xf7, 0x8a, 0x27, 0x31, 0xf6, 0x2a, 0x4c, 0xfa, 0x5d, 0x6a, 0xb4, 0x14, 0x82,
-	0x63, 0x8d, 0x6c, 0x69, 0x79, 0x88, 0x1e, 0x0a, 0x1f, 0x84, 0xd7, 0xfd, 0x78, 0x2b, 0x18, 0x5d,
-	0xe1, 0x0d, 0x8f, 0xec, 0x3f, 0x4b, 0xcf, 0x3e, 0x0e, 0x16, 0x5a, 0xfa, 0x0c, 0x5c, 0x18, 0xea,
-	0x3a, 0xa0, 0x28, 0x6f, 0x46, 0x35, 0x44, 0x24, 0xf9, 0x2c, 0x48, 0x62, 0x19, 0x79, 0xd5, 0x6b,
-	0x49, 0xcf, 0x12, 0x7a, 0x0b, 0x23, 0xf9, 0x7c, 0xc2, 0xf2, 0x0d, 0x00, 0x2e, 0x61, 0x53, 0xda,
-	0x05, 0x5c, 0xc2, 0xa6, 0x75, 0x0d, 0xe4, 0x73, 0xa8, 0x09, 0xb3, 0xb1, 0xdb, 0x3a, 0x5a, 0x8d,
-	0xbb, 0x90, 0x68, 0x07, 0x48, 0x6b, 0xa3, 0xd8, 0xa1, 0xc6, 0x3b, 0x30, 0x9f, 0xb8, 0xcb, 0xa0,
-	0x02, 0xd7, 0x94, 0x49, 0xbb, 0xea, 0x4b, 0xeb, 0xa3, 0x01, 0xa1, 0xde, 0xde, 0xd0, 0xc5, 0x3f,
-	0xb8, 0x23, 0xa1, 0xab, 0xa3, 0xc4, 0x13, 0x77, 0x30, 0xe9, 0xda, 0xe3, 0x81, 0x89, 0x43, 0x27,
-	0x76, 0xfd, 0x8f, 0x1f, 0x3a, 0x69, 0x8d, 0x86, 0xf8, 0xa1, 0x93, 0xde, 0x3b, 0xa0, 0x41, 0x8f,
-	0xdd, 0xf2, 0xb9, 0xa0, 0xa7, 0x75, 0x15, 0xb8, 0xa0, 0xa7, 0x37, 0x07, 0xe8, 0xb9, 0x13, 0x5e,
-	0xe6, 0xb9, 0x73, 0x27, 0xd9, 0x32, 0xe0, 0xce, 0x9d, 0xa1, 0xbb, 0x3f, 0xdd, 0x0e, 0x8b, 0xa9,
-	0x0d, 0x85, 0xf8, 0xc6, 0x1b, 0xd9, 0x70, 0x78, 0x8c, 0xf6, 0x22, 0x64, 0x82, 0xd6, 0x00, 0xf7,
-	0xb2, 0x4a, 0xb4, 0x15, 0xa4, 0x95, 0x14, 0x0e, 0xbf, 0x5f, 0x87, 0xfa, 0x01, 0xdc, 0x7e, 0x1d,
-	0xd5, 0x47, 0xe0, 0xf6, 0xeb, 0xc8, 0x76, 0x82, 0xbf, 0xe2, 0xc9, 0xfb, 0x3d, 0xe2, 0x33, 0x33,
-	0xb5, 0x7f, 0xc0, 0xad, 0xf8, 0xc8, 0xe6, 0x00, 0x4d, 0xde, 0x11, 0x77, 0x73, 0x2e, 0x79, 0xcf,
-	0xbe, 0xdf, 0x73, 0xc9, 0xfb, 0x98, 0x6b, 0x3e, 0xdb, 0x84, 0xf1, 0xaf, 0xf8, 0xfc, 0x26, 0x4c,
-	0xfd, 0x61, 0x00, 0xbf, 0x09, 0xd3, 0x7f, 0x00, 0x20, 0x9f, 0x2b, 0xdd, 0x7c, 0xff, 0x74, 0x4d,
-	0xf8, 0xe0, 0x74, 0x4d, 0xf8, 0xd7, 0xe9, 0x9a, 0xf0, 0xe9, 0x1b, 0xfb, 0xa6, 0x7b, 0x30, 0xd8,
-	0xdb, 0xec, 0x58, 0x47, 0x5b, 0xfd, 0x76, 0xe7, 0xe0, 0xa4, 0x4b, 0x6c, 0xfe, 0xe9, 0x78, 0x7b,
-	0xcb, 0xb1, 0x3b, 0xf4, 0x67, 0x16, 0x7b, 0x93, 0xf4, 0x6b, 0xe3, 0x8b, 0xff, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0xc2, 0x55, 0x0d, 0x17, 0x7a, 0x21, 0x00, 0x00,
+	// 2845 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xd9, 0x77, 0xdb, 0xc6,
+	0xd5, 0x0f, 0x44, 0x4b, 0x22, 0xaf, 0x36, 0x78, 0xb4, 0x51, 0xd0, 0x42, 0x09, 0x8e, 0x63, 0xd9,
+	0xdf, 0x17, 0x29, 0x51, 0xbe, 0x7c, 0x75, 0x12, 0xbf, 0x70, 0x81, 0x68, 0xc4, 0x14, 0xc9, 0x02,
+	0xa0, 0x1d, 0xf7, 0xf4, 0x14, 0xa5, 0xc8, 0xb1, 0x84, 0x5a, 0x22, 0x18, 0x00, 0x54, 0xad, 0xb4,
+	0x69, 0x9b, 0xee, 0x7b, 0xd2, 0x2d, 0x6d, 0x1f, 0xfb, 0x07, 0xf4, 0xa5, 0xfd, 0x27, 0xd2, 0x3d,
+	0xdd, 0xfb, 0xe4, 0xf6, 0xe8, 0x4f, 0xe8, 0x43, 0x9f, 0x7b, 0x30, 0x18, 0x00, 0x03, 0x10, 0x90,
+	0x9d, 0xe4, 0xe4, 0xc5, 0xc6, 0xdc, 0xfb, 0xbb, 0xbf, 0x7b, 0xe7, 0xce, 0x9d, 0xc1, 0xf0, 0x42,
+	0x30, 0xd3, 0x1e, 0x38, 0x87, 0xdb, 0xee, 0x3f, 0x5b, 0x7d, 0xcb, 0x74, 0x4c, 0x34, 0xee, 0x3e,
+	0xeb, 0x27, 0x3b, 0xc2, 0xdc, 0x81, 0x79, 0x60, 0x12, 0xd9, 0xb6, 0xfb, 0xe4, 0xa9, 0x85, 0xc2,
+	0x81, 0x69, 0x1e, 0x1c, 0xe1, 0x6d, 0x32, 0xda, 0x1f, 0xdc, 0xdb, 0x76, 0x8c, 0x63, 0x6c, 0x3b,
+	0xed, 0xe3, 0xbe, 0x07, 0x10, 0x9f, 0x81, 0x99, 0x62, 0xc7, 0x31, 0x4e, 0xda, 0x0e, 0x56, 0xf0,
+	0xab, 0x03, 0x6c, 0x3b, 0x68, 0x15, 0xc0, 0x32, 0x4d, 0x47, 0x77, 0xcc, 0xfb, 0xb8, 0x97, 0xe7,
+	0xd6, 0xb9, 0xcd, 0x9c, 0x92, 0x73, 0x25, 0x9a, 0x2b, 0x10, 0x9f, 0x05, 0x3e, 0xb4, 0xb0, 0xfb,
+	0x66, 0xcf, 0xc6, 0xae, 0x49, 0xbf, 0xdd, 0x39, 0x8c, 0x9a, 0xb8, 0x12, 0xcf, 0x64, 0x16, 0x2e,
+	0x56, 0x70, 0x3b, 0xea, 0x46, 0x9c, 0x03, 0xc4, 0x0a, 0x3d, 0x26, 0xf1, 0x23, 0xb0, 0xa0, 0x98,
+	0x8e, 0x2b, 0xf1, 0x1d, 0x3e, 0x66, 0x58, 0xd7, 0x61, 0x71, 0xc8, 0x30, 0x8c, 0xee, 0x3c, 0xcb,
+	0x9f, 0x8d, 0x00, 0x34, 0xe4, 0x4a, 0xb9, 0x6c, 0xf6, 0xee, 0x19, 0x07, 0x68, 0x01, 0xc6, 0x0c,
+	0xdb, 0x1e, 0x60, 0x8b, 0x22, 0xe9, 0x08, 0x5d, 0x85, 0x5c, 0xe7, 0xc8, 0xc0, 0x3d, 0x47, 0x37,
+	0xba, 0xf9, 0x11, 0x57, 0x55, 0x9a, 0x3c, 0x7b, 0x58, 0xc8, 0x96, 0x89, 0x50, 0xae, 0x28, 0x59,
+	0x4f, 0x2d, 0x77, 0xd1, 0x25, 0x98, 0xa2, 0x50, 0x1b, 0x77, 0x2c, 0xec, 0xe4, 0x33, 0x84, 0x69,
+	0xd2, 0x13, 0xaa, 0x44, 0x86, 0x76, 0x60, 0xd2, 0xc2, 0x5d, 0xc3, 0xc2, 0x1d, 0x47, 0x1f, 0x58,
+	0x46, 0xfe, 0x02, 0xa1, 0x9c, 0x39, 0x7b, 0x58, 0x98, 0x50, 0xa8, 0xbc, 0xa5, 0xc8, 0xca, 0x84,
+	0x0f, 0x6a, 0x59, 0x86, 0x1b, 0x9b, 0xdd, 0x31, 0xfb, 0xd8, 0xce, 0x8f, 0xae, 0x67, 0xdc, 0xd8,
+	0xbc, 0x11, 0xfa, 0x3f, 0x58, 0xb0, 0xf0, 0xab, 0x03, 0xc3, 0xc2, 0x3a, 0x3e, 0x6e, 0x1b, 0x47,
+	0xfa, 0x09, 0xb6, 0x8c, 0x7b, 0x06, 0xee, 0xe6, 0xc7, 0xd6, 0xb9, 0xcd, 0xac, 0x32, 0x47, 0xb5,
+	0x92, 0xab, 0xbc, 0x4d, 0x75, 0xe8, 0x2a, 0xf0, 0x47, 0x66, 0xa7, 0x7d, 0x74, 0x68, 0xda, 0x8e,
+	0x4e, 0xe7, 0x3c, 0x4e, 0xf0, 0x33, 0x81, 0x5c, 0x26, 0x62, 0x71, 0x09, 0x16, 0xab, 0xd8, 0xf1,
+	0x32, 0x34, 0xb0, 0xda, 0x8e, 0x61, 0xfa, 0xeb, 0x22, 0xb6, 0x20, 0x3f, 0xac, 0xa2, 0x99, 0x7f,
+	0x01, 0xa6, 0x3a, 0xac, 0x82, 0xa4, 0x74, 0x62, 0x67, 0x76, 0x8b, 0x56, 0xed, 0x56, 0x98, 0x77,
+	0x25, 0x8a, 0x14, 0x35, 0x58, 0x54, 0x93, 0x3d, 0x7e, 0x10, 0x56, 0x01, 0xf2, 0x6a, 0x4a, 0xb0,
+	0xe2, 0x2f, 0x38, 0xc8, 0x91, 0x8a, 0x90, 0x7b, 0xf7, 0x4c, 0x94, 0x87, 0x71, 0x7b, 0xb0, 0xff,
+	0x29, 0xdc, 0x71, 0x68, 0x1d, 0xf8, 0x43, 0xa4, 0x02, 0xe0, 0x07, 0x7d, 0x83, 0xfa, 0x1e, 0x21,
+	0xbe, 0x85, 0x2d, 0x6f, 0xa3, 0x6d, 0xf9, 0x1b, 0x6d, 0x4b, 0xf3, 0x37, 0x5a, 0x69, 0xf1, 0xdf,
+	0x0f, 0x0b, 0x33, 0xdd, 0xfd, 0x17, 0xc5, 0xd0, 0x4a, 0x7c, 0xeb, 0x9f, 0x05, 0x4e, 0x61, 0x68,
+	0xd0, 0xff, 0xc3, 0xe4, 0x61, 0xdb, 0x3e, 0xc4, 0x5d, 0x5a, 0xa5, 0xa4, 0x62, 0x4a, 0xb3, 0xbe,
+	0x29, 0x11, 0xea, 0x2e, 0x42, 0x54, 0x26, 0x3c, 0xa0, 0x57, 0xbc, 0x9f, 0x80, 0xd9, 0xe2, 0xc0,
+	0x39, 0xc4, 0x3d, 0xc7, 0xe8, 0x30, 0x7b, 0xf8, 0x7f, 0x01, 0x4c, 0xa3, 0xdb, 0xd1, 0x6d, 0x77,
+	0x47, 0x78, 0x13, 0x28, 0x4d, 0x9d, 0x3d, 0x2c, 0xe4, 0xdc, 0xd4, 0xa8, 0xae, 0x50, 0xc9, 0xb9,
+	0x00, 0xf2, 0x88, 0x96, 0x20, 0x6b, 0xf8, 0x8e, 0x47, 0xbc, 0xc9, 0x1a, 0x94, 0xff, 0x79, 0x98,
+	0x8b, 0xf2, 0x3f, 0xde, 0x8e, 0x9f, 0x81, 0xa9, 0x3b, 0x87, 0x66, 0xf1, 0x58, 0xf6, 0xab, 0xe4,
+	0x0d, 0x0e, 0xa6, 0x7d, 0x09, 0xa5, 0x10, 0x20, 0x3b, 0xb0, 0xb1, 0xd5, 0x6b, 0x1f, 0xd3, 0x08,
+	0x95, 0x60, 0xfc, 0xa1, 0xe4, 0x58, 0x54, 0x61, 0xa5, 0x8a, 0x1d, 0xc5, 0x3c, 0xc2, 0xf6, 0xae,
+	0x69, 0x35, 0xb1, 0x75, 0x6c, 0xd8, 0x36, 0x53, 0x57, 0xcf, 0x01, 0xf4, 0x03, 0x21, 0x09, 0x69,
+	0x9a, 0x29, 0x2a, 0x06, 0xcf, 0xc0, 0xc4, 0x0a, 0xac, 0xa6, 0x90, 0xd2, 0x69, 0x5e, 0x82, 0x51,
+	0xcb, 0xd5, 0xe6, 0xb9, 0xf5, 0xcc, 0xe6, 0xc4, 0xce, 0x54, 0x40, 0xe8, 0xda, 0x28, 0x9e, 0x4e,
+	0xb4, 0x60, 0x94, 0x50, 0xa0, 0xed, 0x28, 0x7a, 0x29, 0x82, 0xb6, 0xbd, 0x7f, 0xa5, 0x9e, 0x63,
+	0x9d, 0x52, 0x4b, 0xe1, 0x3a, 0x40, 0x28, 0x44, 0x3c, 0x64, 0xee, 0xe3, 0x53, 0x9a, 0x4e, 0xf7,
+	0x11, 0xcd, 0xc1, 0xe8, 0x49, 0xfb, 0x68, 0x80, 0x49, 0x12, 0xb3, 0x8a, 0x37, 0x78, 0x71, 0xe4,
+	0x3a, 0x27, 0xbe, 0xcd, 0xc1, 0x84, 0x6b, 0x5a, 0x32, 0x7a, 0x5d, 0xa3, 0x77, 0x80, 0x5e, 0x82,
+	0x71, 0xdc, 0x73, 0x2c, 0x23, 0x70, 0xbe, 0x11, 0x71, 0x4e, 0x61, 0x5b, 0x92, 0x87, 0xf1, 0x82,
+	0xf0, 0x2d, 0x84, 0x97, 0x61, 0x92, 0x55, 0x24, 0x04, 0xf2, 0x24, 0x1b, 0xc8, 0xc4, 0xce, 0x74,
+	0x74, 0x66, 0x6c, 0x60, 0x32, 0x64, 0x15, 0x6c, 0x9b, 0x03, 0xab, 0x83, 0xd1, 0x55, 0xb8, 0xe0,
+	0x9c, 0xf6, 0x31, 0x5d, 0x8d, 0xf9, 0xd0, 0x88, 0x02, 0xb4, 0xd3, 0x3e, 0x56, 0x08, 0x04, 0x21,
+	0xb8, 0x40, 0x6a, 0xc9, 0xab, 0x60, 0xf2, 0x2c, 0x7e, 0x91, 0x83, 0xd1, 0x96, 0x8d, 0x2d, 0x1b,
+	0xbd, 0x04, 0x39, 0xbf, 0xba, 0xfc, 0xf9, 0xad, 0x06, 0x6c, 0x04, 0xb2, 0xd5, 0xf2, 0xf5, 0xde,
+	0xdc, 0x42, 0xbc, 0x70, 0x03, 0xa6, 0xa3, 0xca, 0xf7, 0x94, 0xe8, 0x07, 0x30, 0x56, 0xb5, 0xcc,
+	0x41, 0xdf, 0x46, 0xcf, 0xc1, 0xd8, 0x01, 0x79, 0xa2, 0x11, 0x2c, 0x07, 0x11, 0x78, 0x00, 0xfa,
+	0x9f, 0xe7, 0x9f, 0x42, 0x85, 0x17, 0x60, 0x82, 0x11, 0xbf, 0x27, 0xcf, 0x6f, 0x72, 0x70, 0xc1,
+	0x4d, 0x6f, 0x90, 0x1b, 0x2e, 0xcc, 0x0d, 0x7a, 0x1e, 0x26, 0xc2, 0x3a, 0xb6, 0xf3, 0x23, 0xeb,
+	0x99, 0xb4, 0x7a, 0x67, 0x71, 0xe8, 0x06, 0x4c, 0x5b, 0x34, 0xf9, 0xba, 0x9b, 0x77, 0x3b, 0x9f,
+	0x59, 0xcf, 0xa4, 0xaf, 0xcd, 0x94, 0xc5, 0x8c, 0x6c, 0xf1, 0x01, 0xf0, 0xee, 0x79, 0x62, 0x5a,
+	0xc6, 0x6b, 0xc1, 0x61, 0xf5, 0x34, 0x64, 0x7d, 0x10, 0x3d, 0xca, 0x2f, 0x0e, 0x71, 0x29, 0x01,
+	0xe4, 0x7d, 0xc6, 0x2d, 0xfe, 0x92, 0x83, 0x8b, 0x8c, 0x6b, 0xba, 0x3b, 0xd7, 0x00, 0xda, 0xbe,
+	0xb0, 0x4b, 0xbc, 0x67, 0x15, 0x46, 0x82, 0x9e, 0x85, 0x9c, 0xdd, 0x76, 0x0c, 0x9b, 0xbc, 0x4c,
+	0xcf, 0x71, 0x15, 0xa2, 0xd0, 0xd3, 0x30, 0x4e, 0xa4, 0xbd, 0x83, 0x7c, 0x26, 0xdd, 0xc0, 0xc7,
+	0xa0, 0x15, 0xc8, 0xf5, 0x2d, 0xa3, 0xd7, 0x31, 0xfa, 0xed, 0x23, 0xef, 0x12, 0xa0, 0x84, 0x02,
+	0x71, 0x17, 0xe6, 0xab, 0xd8, 0x09, 0xed, 0xec, 0xf7, 0x97, 0x34, 0xb1, 0x0f, 0x1b, 0x51, 0x1e,
+	0xf7, 0xb0, 0xf2, 0xbd, 0xbc, 0xcf, 0x85, 0x88, 0x44, 0x3e, 0x12, 0x8f, 0x1c, 0xc3, 0x42, 0x3c,
+	0x72, 0x9a, 0xf3, 0xd8, 0x02, 0x72, 0x8f, 0x59, 0x78, 0x73, 0xfe, 0xd1, 0x38, 0x42, 0xee, 0x3e,
+	0xde, 0x40, 0x7c, 0x1d, 0xf2, 0x7b, 0x66, 0xd7, 0xb8, 0x77, 0xca, 0x9c, 0x51, 0x1f, 0xc6, 0x7c,
+	0x42, 0xf7, 0x19, 0xd6, 0xfd, 0x32, 0x2c, 0x25, 0xb8, 0xa7, 0x37, 0x0a, 0x6f, 0xf1, 0x3e, 0x70,
+	0x60, 0xe2, 0x4d, 0x58, 0x88, 0xf3, 0xd0, 0x54, 0x6e, 0xc1, 0xf8, 0xbe, 0x27, 0xa2, 0x3c, 0x73,
+	0x49, 0x67, 0xb6, 0xe2, 0x83, 0xc4, 0x4f, 0xc2, 0x84, 0x8a, 0x49, 0x3e, 0xc9, 0x25, 0x67, 0x0e,
+	0x46, 0x7b, 0x66, 0xaf, 0xe3, 0x9f, 0x0b, 0xde, 0xc0, 0x95, 0x92, 0x5b, 0x24, 0xcd, 0x81, 0x37,
+	0x40, 0x97, 0x61, 0xba, 0x63, 0xf6, 0x4e, 0xb0, 0xe5, 0x5a, 0xeb, 0xd8, 0xb2, 0xc8, 0x1d, 0x25,
+	0xab, 0x4c, 0x85, 0x52, 0xc9, 0xb2, 0xc4, 0x79, 0x98, 0xad, 0x62, 0xc7, 0xbd, 0x66, 0xd4, 0xcc,
+	0x03, 0x23, 0xb8, 0x25, 0xde, 0x81, 0xb9, 0xa8, 0x98, 0x4e, 0xe0, 0x2a, 0xe4, 0x8e, 0x5c, 0x81,
+	0x3e, 0xb0, 0x8e, 0xf2, 0x5c, 0x78, 0xab, 0x26, 0xa8, 0x96, 0x52, 0x53, 0xb2, 0x44, 0xdd, 0xb2,
+	0xc8, 0x02, 0x78, 0xd7, 0x19, 0x1a, 0x16, 0x19, 0x88, 0x55, 0x42, 0xac, 0x98, 0xfb, 0xb1, 0x9f,
+	0x0b, 0x64, 0xb9, 0xf6, 0x4d, 0xff, 0xf6, 0xe6, 0x0d, 0xd0, 0x12, 0x64, 0x1c, 0xc7, 0x9b, 0x58,
+	0xa6, 0x34, 0x7e, 0xf6, 0xb0, 0x90, 0xd1, 0xb4, 0x9a, 0xe2, 0xca, 0xc4, 0xa7, 0x61, 0x3e, 0x46,
+	0x44, 0x43, 0x9c, 0x83, 0x51, 0xf6, 0x96, 0xe3, 0x0d, 0xc4, 0x2d, 0x58, 0x50, 0xf0, 0x89, 0x79,
+	0x1f, 0xbb, 0x67, 0x4a, 0xdc, 0x73, 0x02, 0x7e, 0x09, 0x16, 0x87, 0xf0, 0xb4, 0x4c, 0xf6, 0xc8,
+	0x55, 0xd7, 0x3b, 0xe3, 0x77, 0x4d, 0xcb, 0x7d, 0xd3, 0xf8, 0x5c, 0xe7, 0xdd, 0x91, 0x16, 0x82,
+	0x97, 0x89, 0xb7, 0x21, 0xe8, 0x88, 0xde, 0x71, 0x63, 0x74, 0xd4, 0xd5, 0x6d, 0x98, 0xf3, 0xca,
+	0x75, 0x0f, 0x1f, 0xef, 0x63, 0xcb, 0x66, 0x62, 0x26, 0xd6, 0x7e, 0xcc, 0x64, 0xe0, 0xbe, 0x6a,
+	0xda, 0xdd, 0x2e, 0xa5, 0x77, 0x1f, 0x5d, 0x9f, 0x16, 0x3e, 0x36, 0x4f, 0x30, 0xdd, 0x05, 0x74,
+	0x24, 0x2e, 0xc2, 0x7c, 0x8c, 0x97, 0x3a, 0x44, 0xc0, 0x57, 0xfd, 0x60, 0xfc, 0x5a, 0xb8, 0x01,
+	0x2b, 0x81, 0x2c, 0xe9, 0x18, 0x8a, 0xec, 0x43, 0x2e, 0x7e, 0xae, 0xfc, 0x0f, 0x5c, 0x64, 0x18,
+	0xe9, 0x1a, 0x2d, 0x44, 0x5e, 0xac, 0x61, 0x2e, 0xae, 0xc0, 0x4c, 0x15, 0x3b, 0xe4, 0xf5, 0x7e,
+	0xee, 0x54, 0xc5, 0x67, 0x80, 0x0f, 0x81, 0x94, 0x74, 0x25, 0x7e, 0x65, 0xc8, 0x31, 0x77, 0x02,
+	0x37, 0xcd, 0xd2, 0x03, 0xc7, 0x6a, 0x77, 0x9c, 0x60, 0x45, 0x83, 0x19, 0x56, 0x61, 0x29, 0x41,
+	0x47, 0x69, 0xaf, 0xc1, 0x18, 0x29, 0x09, 0xff, 0x12, 0x80, 0x82, 0x2d, 0x1b, 0xfc, 0xfa, 0x50,
+	0x28, 0x42, 0x2c, 0xbb, 0x55, 0x63, 0x3b, 0xa6, 0x35, 0x5c, 0x66, 0x9b, 0x6c, 0x99, 0x25, 0xb3,
+	0xd0, 0xd2, 0x13, 0x20, 0x3f, 0x4c, 0x42, 0xd7, 0xe7, 0x06, 0xac, 0xc5, 0xca, 0xf2, 0x3d, 0x94,
+	0xa0, 0xb8, 0x01, 0x85, 0x54, 0x6b, 0xea, 0x60, 0x1d, 0xd6, 0x2a, 0xf8, 0x08, 0x3b, 0x58, 0x72,
+	0x2f, 0xe2, 0xb8, 0x3b, 0x9c, 0xac, 0x0d, 0x28, 0xa4, 0x22, 0x3c, 0x92, 0x6b, 0xef, 0xf2, 0x00,
+	0xe1, 0x6b, 0x01, 0x2d, 0x00, 0x6a, 0x4a, 0xca, 0x9e, 0xac, 0xaa, 0x72, 0xa3, 0xae, 0xb7, 0xea,
+	0xb7, 0xea, 0x8d, 0x3b, 0x75, 0xfe, 0x09, 0xb4, 0x0c, 0x8b, 0xe5, 0x5a, 0x4b, 0xd5, 0x24, 0x45,
+	0xdf, 0x6b, 0x54, 0xe4, 0xdd, 0xbb, 0x7a, 0x49, 0xae, 0x57, 0xe4, 0x7a, 0x55, 0xe5, 0xbb, 0x28,
+	0x0f, 0x73, 0xbe, 0xb2, 0x2a, 0x69, 0xa1, 0x06, 0xa3, 0x65, 0x58, 0x60, 0x35, 0xcd, 0x62, 0xf9,
+	0x66, 0x45, 0xaf, 0x35, 0xaa, 0x2a, 0xff, 0x43, 0x0e, 0x2d, 0xc1, 0xbc, 0xaf, 0x2c, 0xb6, 0xb4,
+	0x9b, 0x7a, 0xb1, 0xac, 0xc9, 0xb7, 0x8b, 0x9a, 0xc4, 0xdf, 0x63, 0xdd, 0x11, 0x55, 0x45, 0x0a,
+	0x94, 0x07, 0x43, 0x4a, 0x97, 0xb9, 0xdc, 0xa8, 0xef, 0xca, 0x55, 0xfe, 0x70, 0x48, 0xa9, 0x86,
+	0x4a, 0x03, 0x6d, 0xc0, 0xca, 0x90, 0xa5, 0xd2, 0x28, 0x35, 0x34, 0x5d, 0x6b, 0xdc, 0x92, 0xea,
+	0xfc, 0xb7, 0x38, 0x74, 0x19, 0x36, 0x22, 0x10, 0x3a, 0xdb, 0xaa, 0xd2, 0x68, 0x35, 0xf5, 0x3d,
+	0x69, 0xaf, 0x24, 0x29, 0x2a, 0x7f, 0x9c, 0x18, 0x03, 0xc1, 0xa8, 0x7c, 0x0f, 0xad, 0xc3, 0x4a,
+	0xb2, 0x52, 0x6f, 0xa9, 0xae, 0xb9, 0x89, 0x0a, 0xb0, 0x1c, 0x41, 0x48, 0xaf, 0x68, 0x4a, 0xb1,
+	0x4c, 0xc3, 0x50, 0xf9, 0x3e, 0x5a, 0x03, 0x21, 0x02, 0x50, 0x24, 0x55, 0x6b, 0x28, 0x12, 0x8d,
+	0xf3, 0x55, 0xb4, 0x0d, 0xd7, 0x86, 0x5c, 0x84, 0x0b, 0xa7, 0xea, 0xbb, 0x0d, 0x45, 0x6f, 0x2a,
+	0x72, 0xbd, 0x2c, 0x37, 0x8b, 0x35, 0xfe, 0x3b, 0x1c, 0xba, 0x02, 0x62, 0x2c, 0xa3, 0x35, 0x49,
+	0x93, 0x74, 0xe9, 0x95, 0xa6, 0xac, 0x48, 0x15, 0xdf, 0xf1, 0xb7, 0x39, 0xf4, 0x24, 0x14, 0x62,
+	0x9e, 0x6f, 0x37, 0x6e, 0x49, 0x24, 0x72, 0x1f, 0xf5, 0x5d, 0x0e, 0x5d, 0x82, 0xb5, 0x28, 0xaa,
+	0xa1, 0x15, 0x35, 0x49, 0x57, 0x1a, 0x41, 0x2e, 0x7f, 0xc0, 0xb1, 0xb3, 0x94, 0xea, 0x9a, 0xa4,
+	0x34, 0x15, 0x59, 0x95, 0xc2, 0x65, 0xb6, 0xd8, 0x44, 0x31, 0x80, 0x9b, 0x52, 0x51, 0xd1, 0x4a,
+	0x52, 0x51, 0xe3, 0xed, 0x14, 0x0a, 0x6f, 0xc5, 0x2b, 0x12, 0xef, 0xa0, 0x0d, 0x58, 0x4d, 0x00,
+	0x30, 0xf5, 0x32, 0x60, 0x39, 0xe4, 0x8a, 0x54, 0xd7, 0x64, 0xed, 0x2e, 0x5b, 0x16, 0x27, 0x89,
+	0x00, 0xa6, 0xa8, 0x3e, 0x9d, 0x08, 0x28, 0x2b, 0x92, 0x3b, 0x63, 0xb9, 0xd2, 0xe4, 0x1f, 0x24,
+	0x02, 0x5a, 0xcd, 0x8a, 0x0f, 0x38, 0x65, 0xd7, 0x33, 0x00, 0xd4, 0x64, 0x55, 0x73, 0xd5, 0x2a,
+	0xff, 0x1a, 0x5a, 0x81, 0x7c, 0x62, 0x08, 0xae, 0xf5, 0x67, 0x12, 0xe9, 0xe9, 0x02, 0xba, 0x80,
+	0xcf, 0xa2, 0x2b, 0x70, 0x29, 0x2d, 0x40, 0xf7, 0x62, 0xa0, 0x97, 0x6b, 0xb2, 0x54, 0xd7, 0xf8,
+	0xd7, 0x13, 0x81, 0x34, 0x50, 0x16, 0xf8, 0x39, 0xf4, 0x14, 0x88, 0x43, 0x40, 0x12, 0x30, 0x03,
+	0x53, 0xf9, 0xcf, 0xa3, 0xcb, 0xb0, 0x9e, 0x18, 0x38, 0xcb, 0xf6, 0x05, 0x0e, 0x6d, 0xc2, 0xa5,
+	0xb4, 0x19, 0xb0, 0xc8, 0x37, 0x38, 0xb4, 0x08, 0xc8, 0x47, 0x56, 0xa4, 0x52, 0xab, 0xaa, 0x57,
+	0x5a, 0x7b, 0x4d, 0xfe, 0x4b, 0x1c, 0x5a, 0x0d, 0x53, 0x54, 0x93, 0xcb, 0x52, 0x9d, 0x2d, 0xa5,
+	0x2f, 0x27, 0xaa, 0x83, 0x32, 0xf9, 0x0a, 0x87, 0xd6, 0x61, 0x39, 0xae, 0x2e, 0x56, 0x2a, 0x3a,
+	0x95, 0xf1, 0x5f, 0x8d, 0x94, 0xb4, 0x8f, 0xa0, 0x99, 0xf1, 0x41, 0x5f, 0x4b, 0x04, 0xd1, 0x69,
+	0xf8, 0xa0, 0xaf, 0x73, 0x48, 0x84, 0xd5, 0x38, 0x88, 0xa4, 0x8e, 0x0a, 0x55, 0xfe, 0x1b, 0x1c,
+	0x12, 0xc2, 0xc3, 0x8f, 0x2e, 0x94, 0x2a, 0x95, 0x15, 0x49, 0xe3, 0xdf, 0x74, 0x0f, 0xc6, 0xb9,
+	0xd0, 0x5e, 0xd5, 0xa8, 0x46, 0xe5, 0xdf, 0xe2, 0x10, 0x82, 0x29, 0x6f, 0x44, 0xdd, 0xf2, 0xdf,
+	0xe3, 0xd0, 0x2c, 0x4c, 0x53, 0x99, 0x5c, 0x57, 0x9b, 0x52, 0x59, 0xe3, 0xbf, 0x1f, 0x4b, 0x23,
+	0x09, 0xb0, 0x58, 0xab, 0xf1, 0xdf, 0x74, 0x37, 0x65, 0x50, 0x89, 0x74, 0xd3, 0xba, 0x27, 0x4b,
+	0xb1, 0x2a, 0xe9, 0xb7, 0xa4, 0xbb, 0xfc, 0x8f, 0x22, 0x99, 0xa2, 0x7c, 0x11, 0xc4, 0xdb, 0x11,
+	0x6e, 0xa9, 0x22, 0x6b, 0xfa, 0x47, 0x5b, 0x0d, 0xad, 0xc8, 0xff, 0x38, 0x32, 0x29, 0xa2, 0xb8,
+	0x23, 0x95, 0x6e, 0x36, 0x1a, 0xb7, 0x54, 0xfe, 0x27, 0x11, 0x1d, 0x99, 0x54, 0xa0, 0xfb, 0x29,
+	0x87, 0xa6, 0x21, 0xa7, 0x48, 0xcd, 0x86, 0xae, 0x48, 0xc5, 0x0a, 0xff, 0x0e, 0x87, 0x66, 0x00,
+	0xc8, 0xf8, 0x8e, 0x22, 0x6b, 0x12, 0xff, 0x2b, 0x92, 0x11, 0x22, 0x88, 0xbf, 0x7b, 0x7e, 0xcd,
+	0x21, 0x1e, 0x26, 0x88, 0x8a, 0xe6, 0xe3, 0x37, 0x1c, 0xca, 0xc3, 0x2c, 0x91, 0xf8, 0xd1, 0x97,
+	0x1b, 0x7b, 0x7b, 0xb2, 0xc6, 0xff, 0x96, 0x43, 0xf3, 0xc0, 0x13, 0x8d, 0xb7, 0x1a, 0x9e, 0xf8,
+	0x77, 0x64, 0x3e, 0x0c, 0x85, 0xaf, 0xf8, 0x7d, 0xa8, 0xa0, 0x2b, 0x54, 0x52, 0x8a, 0xf5, 0xf2,
+	0x4d, 0xfe, 0x0f, 0x31, 0x22, 0x2a, 0x7e, 0x77, 0x88, 0x88, 0x2a, 0xfe, 0xc8, 0xa1, 0x05, 0xb8,
+	0x18, 0x09, 0x69, 0x57, 0xae, 0x49, 0xfc, 0x9f, 0xc8, 0xd2, 0x85, 0x3c, 0x44, 0xf8, 0x67, 0x52,
+	0xc9, 0x44, 0xe8, 0xd6, 0x67, 0x53, 0x6e, 0x4a, 0x35, 0xb9, 0x2e, 0x91, 0xd4, 0x48, 0x0a, 0xff,
+	0x17, 0xb2, 0x3e, 0x34, 0x59, 0x7b, 0x8d, 0xdb, 0xd2, 0x10, 0xe2, 0xaf, 0x29, 0x04, 0x24, 0x97,
+	0x0a, 0xff, 0x37, 0x52, 0x9e, 0x91, 0x64, 0x92, 0x28, 0xf5, 0xa6, 0xd2, 0xd0, 0xa4, 0xb2, 0x26,
+	0x37, 0xea, 0xfc, 0xdf, 0x43, 0x4c, 0xe9, 0x6e, 0xb3, 0xa8, 0xaa, 0x09, 0x98, 0x7f, 0x90, 0x49,
+	0x05, 0xec, 0x64, 0x02, 0x2f, 0x37, 0x4a, 0xfc, 0xcf, 0x47, 0xae, 0x35, 0x60, 0x92, 0xed, 0x53,
+	0xb8, 0xef, 0x79, 0x45, 0x52, 0x1b, 0x2d, 0xa5, 0x2c, 0xe9, 0xda, 0xdd, 0xa6, 0xc4, 0x5c, 0x2b,
+	0x26, 0x60, 0xdc, 0xdf, 0x37, 0x1c, 0xca, 0xc2, 0x05, 0xd7, 0x27, 0x3f, 0x82, 0xa6, 0x20, 0xe7,
+	0xe6, 0x49, 0x27, 0xc3, 0xcc, 0xce, 0x7f, 0x78, 0xc8, 0x14, 0x9b, 0x32, 0x2a, 0x42, 0xd6, 0xff,
+	0x3e, 0x82, 0xf2, 0xc1, 0xa5, 0x2c, 0xf6, 0x91, 0x45, 0x58, 0x4a, 0xd0, 0xd0, 0x1b, 0xd3, 0x13,
+	0xa8, 0x0a, 0x10, 0x7e, 0x1a, 0x41, 0x42, 0x00, 0x1d, 0xfa, 0x88, 0x22, 0x2c, 0x27, 0xea, 0x02,
+	0xa2, 0xbb, 0xe4, 0x56, 0x1b, 0x69, 0x77, 0xa3, 0xf5, 0xc0, 0x24, 0xa5, 0xa3, 0x2f, 0x6c, 0x9c,
+	0x83, 0x60, 0xa9, 0xd5, 0x74, 0x6a, 0xf5, 0x91, 0xd4, 0x6a, 0x3a, 0xf5, 0x1e, 0x4c, 0xb2, 0x3d,
+	0x67, 0xb4, 0x12, 0xe6, 0x6a, 0xb8, 0xd5, 0x2d, 0xac, 0xa6, 0x68, 0x03, 0xba, 0x0a, 0xe4, 0x82,
+	0xbe, 0x0f, 0x5a, 0x8a, 0xa0, 0xd9, 0x36, 0x94, 0x20, 0x24, 0xa9, 0x02, 0x16, 0x15, 0xa6, 0xa3,
+	0xed, 0x0c, 0xb4, 0xc6, 0xa6, 0x69, 0xb8, 0x43, 0x23, 0x14, 0x52, 0xf5, 0x01, 0xe9, 0x7d, 0x10,
+	0xd2, 0xbb, 0x32, 0xe8, 0x5a, 0x0a, 0x41, 0xc2, 0x6f, 0xa6, 0xc7, 0x71, 0xf6, 0x12, 0x8c, 0x79,
+	0x1d, 0x78, 0xb4, 0x10, 0x80, 0x23, 0x4d, 0x7a, 0x61, 0x71, 0x48, 0x1e, 0x18, 0x1f, 0x06, 0xad,
+	0x8c, 0x68, 0x9b, 0x1b, 0x5d, 0x66, 0x1d, 0xa7, 0xf6, 0xd6, 0x85, 0xa7, 0x1e, 0x05, 0x0b, 0x3c,
+	0x7d, 0x1c, 0x2e, 0x0e, 0x75, 0x54, 0x50, 0x58, 0x37, 0x69, 0xcd, 0x1e, 0x41, 0x3c, 0x0f, 0x12,
+	0x5b, 0x46, 0x96, 0x7a, 0x2d, 0x1e, 0x59, 0x8c, 0xb7, 0x90, 0xaa, 0x67, 0x0b, 0x96, 0x6d, 0x6e,
+	0x30, 0x05, 0x9b, 0xd0, 0x0a, 0x11, 0x56, 0x53, 0xb4, 0x01, 0x5d, 0x13, 0xa6, 0x22, 0x9d, 0x08,
+	0xb4, 0x1a, 0x0d, 0x21, 0xd6, 0xea, 0x10, 0xd6, 0xd2, 0xd4, 0x01, 0xe3, 0x6d, 0x98, 0x89, 0xfd,
+	0x4e, 0x43, 0x05, 0xa6, 0xe1, 0x94, 0xd4, 0xc6, 0x10, 0xd6, 0xd3, 0x01, 0x01, 0x6f, 0x6f, 0xa8,
+	0xa9, 0xe1, 0xff, 0xfe, 0x43, 0x57, 0xd2, 0xcc, 0x63, 0xbf, 0x2f, 0x85, 0xcd, 0x47, 0x03, 0x63,
+	0x87, 0x4e, 0xa4, 0xb5, 0x11, 0x3d, 0x74, 0x92, 0x9a, 0x28, 0xc2, 0xc6, 0x39, 0x08, 0x36, 0xe9,
+	0x91, 0x0e, 0x06, 0x93, 0xf4, 0xa4, 0x8e, 0x89, 0xb0, 0x96, 0xa6, 0x66, 0xcf, 0x9d, 0xa0, 0x51,
+	0xc1, 0x9c, 0x3b, 0xf1, 0x76, 0x88, 0x20, 0x24, 0xa9, 0x98, 0xed, 0x30, 0x9f, 0xd8, 0x2c, 0x89,
+	0x6e, 0xbc, 0xd4, 0x66, 0xca, 0x23, 0xd8, 0x8b, 0x90, 0xf5, 0xdb, 0x1e, 0xcc, 0xcb, 0x2a, 0xd6,
+	0x32, 0x11, 0x96, 0x12, 0x34, 0xec, 0x7e, 0x1d, 0xea, 0x75, 0x30, 0xfb, 0x35, 0xad, 0x47, 0x22,
+	0x88, 0xe7, 0x41, 0xd8, 0x15, 0x8f, 0xf7, 0x2e, 0x10, 0x5b, 0x99, 0x89, 0xbd, 0x11, 0x61, 0xe3,
+	0x1c, 0x04, 0x5b, 0xbc, 0x29, 0x7d, 0x07, 0xa6, 0x78, 0xcf, 0xef, 0x5d, 0x08, 0x9b, 0x8f, 0x06,
+	0x46, 0x36, 0x61, 0xf4, 0x2f, 0x14, 0xd8, 0x4d, 0x98, 0xf8, 0x47, 0x0f, 0xc2, 0x7a, 0x3a, 0xc0,
+	0xe7, 0x2d, 0x5d, 0x7f, 0xe7, 0x6c, 0x8d, 0x7b, 0xf7, 0x6c, 0x8d, 0xfb, 0xd7, 0xd9, 0x1a, 0xf7,
+	0xb1, 0x6b, 0x07, 0x86, 0x73, 0x38, 0xd8, 0xdf, 0xea, 0x98, 0xc7, 0xdb, 0xee, 0xf7, 0xd8, 0xd3,
+	0x2e, 0xb6, 0xd8, 0xa7, 0x93, 0x9d, 0x6d, 0xdb, 0xea, 0x90, 0x3f, 0x21, 0xd9, 0x1f, 0x23, 0x5f,
+	0x52, 0x9f, 0xfb, 0xef, 0x00, 0x33, 0x2c, 0xe5, 0xbe, 0x56, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3,8 +3,11 @@ package client
 import "github.com/pachyderm/pachyderm/v2/src/pfs"
 
 type putFileConfig struct {
-	tag    string
-	append bool
+	tag                                              string
+	append                                           bool
+	delimiter                                        pfs.Delimiter
+	targetFileDatums, targetFileBytes, headerRecords int64
+	attributes                                       map[string]string
 }
 
 // PutFileOption configures a PutFile call.
@@ -24,6 +27,48 @@ func WithTagPutFile(tag string) PutFileOption {
 	}
 }
 
+// WithAttributesPutFile configures the PutFile call to set attributes on the
+// files, such as their content type.
+func WithAttributesPutFile(attributes map[string]string) PutFileOption {
+	return func(pf *putFileConfig) {
+		pf.attributes = attributes
+	}
+}
+
+// WithSplitPutFile configures the PutFile call to split the content into
+// records using delimiter, and write them to numbered files in a directory at
+// the path. Without WithAppendPutFile, existing files in the directory are
+// deleted first.
+func WithSplitPutFile(delimiter pfs.Delimiter) PutFileOption {
+	return func(pf *putFileConfig) {
+		pf.delimiter = delimiter
+	}
+}
+
+// WithTargetFileDatumsPutFile configures a split PutFile call to write n
+// records to each file.
+func WithTargetFileDatumsPutFile(n int64) PutFileOption {
+	return func(pf *putFileConfig) {
+		pf.targetFileDatums = n
+	}
+}
+
+// WithTargetFileBytesPutFile configures a split PutFile call to start a new
+// file once the current one holds at least n bytes of records.
+func WithTargetFileBytesPutFile(n int64) PutFileOption {
+	return func(pf *putFileConfig) {
+		pf.targetFileBytes = n
+	}
+}
+
+// WithHeaderRecordsPutFile configures a split PutFile call to write the first
+// n records at the start of every file.
+func WithHeaderRecordsPutFile(n int64) PutFileOption {
+	return func(pf *putFileConfig) {
+		pf.headerRecords = n
+	}
+}
+
 type deleteFileConfig struct {
 	tag       string
 	recursive bool
@@ -110,6 +110,59 @@ func (c APIClient) ListRepoByType(repoType string) (_ []*pfs.RepoInfo, retErr er
 	return clientsdk.ListRepoInfo(client)
 }
 
+// ListRepoPage returns info about up to pageSize user repos, starting from
+// continuation (from the first repo if it's empty). It also returns the
+// continuation token for the next page, which is empty if there are no more
+// repos.
+func (c APIClient) ListRepoPage(pageSize int64, continuation string) (_ []*pfs.RepoInfo, _ string, retErr error) {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	client, err := c.PfsAPIClient.ListRepo(
+		ctx,
+		&pfs.ListRepoRequest{Type: pfs.UserRepoType, PageSize: pageSize, Continuation: continuation},
+	)
+	if err != nil {
+		return nil, "", grpcutil.ScrubGRPC(err)
+	}
+	ris, err := clientsdk.ListRepoInfo(client)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(ris) == 0 {
+		return nil, "", nil
+	}
+	return ris, ris[len(ris)-1].Continuation, nil
+}
+
+// ListRepoByMetadata returns info about the user repos with all of the
+// key/value pairs in metadata.
+func (c APIClient) ListRepoByMetadata(metadata map[string]string) (_ []*pfs.RepoInfo, retErr error) {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	client, err := c.PfsAPIClient.ListRepo(
+		ctx,
+		&pfs.ListRepoRequest{Type: pfs.UserRepoType, Metadata: metadata},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return clientsdk.ListRepoInfo(client)
+}
+
+// SetRepoMetadata adds the key/value pairs in metadata to the metadata of a
+// repo, and removes removeKeys from it.
+func (c APIClient) SetRepoMetadata(repoName string, metadata map[string]string, removeKeys ...string) error {
+	_, err := c.PfsAPIClient.SetMetadata(
+		c.Ctx(),
+		&pfs.SetMetadataRequest{
+			Repo:       NewRepo(repoName),
+			Metadata:   metadata,
+			RemoveKeys: removeKeys,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // DeleteRepo deletes a repo and reclaims the storage space it was using. Note
 // that as of 1.0 we do not reclaim the blocks that the Repo was referencing,
 // this is because they may also be referenced by other Repos and deleting them
@@ -129,6 +182,89 @@ func (c APIClient) DeleteRepo(repoName string, force bool) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// RenameRepo renames a Repo, keeping its commits and branches. The inputs of
+// pipelines which read from it are updated to its new name.
+func (c APIClient) RenameRepo(repoName string, newName string) error {
+	_, err := c.PfsAPIClient.RenameRepo(
+		c.Ctx(),
+		&pfs.RenameRepoRequest{
+			Repo:    NewRepo(repoName),
+			NewName: newName,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ForkRepo creates the repo dst with the given branches of the repo src, or
+// all of its branches if none are given. The new branches start at the heads
+// of the source branches and share their data, so nothing is copied.
+func (c APIClient) ForkRepo(src, dst string, branches ...string) error {
+	_, err := c.PfsAPIClient.ForkRepo(
+		c.Ctx(),
+		&pfs.ForkRepoRequest{
+			Source:      NewRepo(src),
+			Destination: NewRepo(dst),
+			Branches:    branches,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ExportRepo writes a bundle with the commits, branches and file contents of
+// repo to w, which can be imported by another cluster with ImportRepo. If base
+// is not nil, the bundle is incremental: it leaves out base and its ancestors,
+// which must have been imported from an earlier bundle.
+func (c APIClient) ExportRepo(repo string, base *pfs.Commit, w io.Writer) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	ctx, cancel := context.WithCancel(c.Ctx())
+	defer cancel()
+	client, err := c.PfsAPIClient.ExportRepo(ctx, &pfs.ExportRepoRequest{
+		Repo: NewRepo(repo),
+		Base: base,
+	})
+	if err != nil {
+		return err
+	}
+	return grpcutil.WriteFromStreamingBytesClient(client, w)
+}
+
+// ImportRepo imports the bundle read from r, which was returned by ExportRepo,
+// into repo, or into the repo it was exported from if repo is "".
+func (c APIClient) ImportRepo(repo string, r io.Reader) (_ *pfs.ImportRepoResponse, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	ctx, cancel := context.WithCancel(c.Ctx())
+	defer cancel()
+	client, err := c.PfsAPIClient.ImportRepo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	request := &pfs.ImportRepoRequest{}
+	if repo != "" {
+		request.Repo = NewRepo(repo)
+	}
+	if _, err := grpcutil.ChunkReader(r, func(data []byte) error {
+		request.Data = data
+		if err := client.Send(request); err != nil {
+			return err
+		}
+		request = &pfs.ImportRepoRequest{}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	// The request with the repo is still unsent if r was empty.
+	if request.Repo != nil {
+		if err := client.Send(request); err != nil {
+			return nil, err
+		}
+	}
+	return client.CloseAndRecv()
+}
+
 // StartCommit begins the process of committing data to a Repo. Once started
 // you can write to the Commit with PutFile and when all the data has been
 // written you must finish the Commit with FinishCommit. NOTE, data is not
@@ -284,6 +420,90 @@ func (c APIClient) ListCommitByRepo(repo *pfs.Repo) ([]*pfs.CommitInfo, error) {
 	return c.ListCommit(repo, nil, nil, 0)
 }
 
+// ListCommitByMetadata lists the commits in a repo with all of the key/value
+// pairs in metadata.
+func (c APIClient) ListCommitByMetadata(repo *pfs.Repo, metadata map[string]string) (_ []*pfs.CommitInfo, retErr error) {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	stream, err := c.PfsAPIClient.ListCommit(ctx, &pfs.ListCommitRequest{
+		Repo:     repo,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	var result []*pfs.CommitInfo
+	for {
+		ci, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, grpcutil.ScrubGRPC(err)
+		}
+		result = append(result, ci)
+	}
+	return result, nil
+}
+
+// ListCommitPage lists up to pageSize commits in a repo, newest first,
+// starting from continuation (from the newest commit if it's empty). It also
+// returns the continuation token for the next page, which is empty if there
+// are no more commits.
+func (c APIClient) ListCommitPage(repo *pfs.Repo, pageSize int64, continuation string) (_ []*pfs.CommitInfo, _ string, retErr error) {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	stream, err := c.PfsAPIClient.ListCommit(ctx, &pfs.ListCommitRequest{
+		Repo:         repo,
+		PageSize:     pageSize,
+		Continuation: continuation,
+	})
+	if err != nil {
+		return nil, "", grpcutil.ScrubGRPC(err)
+	}
+	var result []*pfs.CommitInfo
+	for {
+		ci, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, "", grpcutil.ScrubGRPC(err)
+		}
+		result = append(result, ci)
+	}
+	if len(result) == 0 {
+		return nil, "", nil
+	}
+	return result, result[len(result)-1].Continuation, nil
+}
+
+// SetCommitMetadata adds the key/value pairs in metadata to the metadata of a
+// commit, and removes removeKeys from it.
+func (c APIClient) SetCommitMetadata(repoName string, branchName string, commitID string, metadata map[string]string, removeKeys ...string) error {
+	_, err := c.PfsAPIClient.SetMetadata(
+		c.Ctx(),
+		&pfs.SetMetadataRequest{
+			Commit:     NewCommit(repoName, branchName, commitID),
+			Metadata:   metadata,
+			RemoveKeys: removeKeys,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// SetBranchMetadata adds the key/value pairs in metadata to the metadata of a
+// branch, and removes removeKeys from it.
+func (c APIClient) SetBranchMetadata(repoName string, branchName string, metadata map[string]string, removeKeys ...string) error {
+	_, err := c.PfsAPIClient.SetMetadata(
+		c.Ctx(),
+		&pfs.SetMetadataRequest{
+			Branch:     NewBranch(repoName, branchName),
+			Metadata:   metadata,
+			RemoveKeys: removeKeys,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // CreateBranch creates a new branch
 func (c APIClient) CreateBranch(repoName string, branchName string, commitBranch string, commitID string, provenance []*pfs.Branch) error {
 	var head *pfs.Commit
@@ -320,6 +540,24 @@ func (c APIClient) CreateBranchTrigger(repoName string, branchName string, commi
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ProtectBranch replaces the protection of a branch, keeping its head,
+// provenance and trigger. An empty protection removes it.
+func (c APIClient) ProtectBranch(repoName string, branchName string, protection *pfs.BranchProtection) error {
+	branchInfo, err := c.InspectBranch(repoName, branchName)
+	if err != nil {
+		return err
+	}
+	_, err = c.PfsAPIClient.CreateBranch(
+		c.Ctx(),
+		&pfs.CreateBranchRequest{
+			Branch:     branchInfo.Branch,
+			Provenance: branchInfo.DirectProvenance,
+			Protection: protection,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // InspectBranch returns information on a specific PFS branch
 func (c APIClient) InspectBranch(repoName string, branchName string) (*pfs.BranchInfo, error) {
 	branchInfo, err := c.PfsAPIClient.InspectBranch(
@@ -361,6 +599,35 @@ func (c APIClient) DeleteBranch(repoName string, branchName string, force bool)
 	return grpcutil.ScrubGRPC(err)
 }
 
+// RenameBranch renames a branch, keeping its commits. The inputs of pipelines
+// which read from it are updated to its new name.
+func (c APIClient) RenameBranch(repoName string, branchName string, newName string) error {
+	_, err := c.PfsAPIClient.RenameBranch(
+		c.Ctx(),
+		&pfs.RenameBranchRequest{
+			Branch:  NewBranch(repoName, branchName),
+			NewName: newName,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// MergeBranch creates a commit on the target branch which merges the changes
+// made on the source branch since their common ancestor. Files changed
+// differently on both branches are returned as conflicts, and resolved by
+// strategy.
+func (c APIClient) MergeBranch(repoName string, source string, target string, strategy pfs.MergeStrategy) (*pfs.MergeBranchResponse, error) {
+	resp, err := c.PfsAPIClient.MergeBranch(
+		c.Ctx(),
+		&pfs.MergeBranchRequest{
+			Source:   NewBranch(repoName, source),
+			Target:   NewBranch(repoName, target),
+			Strategy: strategy,
+		},
+	)
+	return resp, grpcutil.ScrubGRPC(err)
+}
+
 func (c APIClient) inspectCommitSet(id string, wait bool, cb func(*pfs.CommitInfo) error) error {
 	req := &pfs.InspectCommitSetRequest{
 		CommitSet: NewCommitSet(id),
@@ -485,6 +752,38 @@ func (c APIClient) ClearCommit(repoName string, branchName string, commitID stri
 	return err
 }
 
+// RevertCommit creates a commit on branch which undoes the changes made by
+// commit. If branch is nil, the commit is created on the branch of commit.
+func (c APIClient) RevertCommit(commit *pfs.Commit, branch *pfs.Branch, description string) (_ *pfs.Commit, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	return c.PfsAPIClient.RevertCommit(
+		c.Ctx(),
+		&pfs.RevertCommitRequest{
+			Commit:      commit,
+			Branch:      branch,
+			Description: description,
+		},
+	)
+}
+
+// CherryPickCommit creates a commit on branch which applies the changes made
+// by commit.
+func (c APIClient) CherryPickCommit(commit *pfs.Commit, branch *pfs.Branch, description string) (_ *pfs.Commit, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	return c.PfsAPIClient.CherryPickCommit(
+		c.Ctx(),
+		&pfs.CherryPickCommitRequest{
+			Commit:      commit,
+			Branch:      branch,
+			Description: description,
+		},
+	)
+}
+
 // Fsck performs checks on pfs. Errors that are encountered will be passed
 // onError. These aren't errors in the traditional sense, in that they don't
 // prevent the completion of fsck. Errors that do prevent completion will be
@@ -535,6 +834,69 @@ func (c APIClient) FsckFastExit() error {
 	}
 }
 
+// RotateStorageKey creates a new version of the master key that wraps chunk
+// encryption keys. Existing chunk encryption keys are rewrapped with it in the
+// background, InspectStorageKey reports the progress.
+func (c APIClient) RotateStorageKey() (*pfs.StorageKeyInfo, error) {
+	info, err := c.PfsAPIClient.RotateStorageKey(c.Ctx(), &pfs.RotateStorageKeyRequest{})
+	return info, grpcutil.ScrubGRPC(err)
+}
+
+// InspectStorageKey returns the version of the master key that wraps chunk
+// encryption keys, and the progress of rewrapping existing keys with it.
+func (c APIClient) InspectStorageKey() (*pfs.StorageKeyInfo, error) {
+	info, err := c.PfsAPIClient.InspectStorageKey(c.Ctx(), &pfs.InspectStorageKeyRequest{})
+	return info, grpcutil.ScrubGRPC(err)
+}
+
+// CreateQuota creates a quota on a repo, or updates it if update is true.
+// If principal is empty, the quota applies to all principals.
+func (c APIClient) CreateQuota(repoName, principal string, sizeBytes, fileCount int64, update bool) error {
+	_, err := c.PfsAPIClient.CreateQuota(
+		c.Ctx(),
+		&pfs.CreateQuotaRequest{
+			Quota: &pfs.Quota{
+				Repo:      NewRepo(repoName),
+				Principal: principal,
+				SizeBytes: sizeBytes,
+				FileCount: fileCount,
+			},
+			Update: update,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// InspectQuota returns the quota on a repo for principal, and the usage of the
+// repo.
+func (c APIClient) InspectQuota(repoName, principal string) (*pfs.QuotaInfo, error) {
+	info, err := c.PfsAPIClient.InspectQuota(
+		c.Ctx(),
+		&pfs.InspectQuotaRequest{
+			Repo:      NewRepo(repoName),
+			Principal: principal,
+		},
+	)
+	return info, grpcutil.ScrubGRPC(err)
+}
+
+// ListQuota returns the quotas on a repo, or on all repos if repoName is
+// empty.
+func (c APIClient) ListQuota(repoName string) ([]*pfs.QuotaInfo, error) {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	request := &pfs.ListQuotaRequest{}
+	if repoName != "" {
+		request.Repo = NewRepo(repoName)
+	}
+	client, err := c.PfsAPIClient.ListQuota(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	infos, err := clientsdk.ListQuotaInfo(client)
+	return infos, grpcutil.ScrubGRPC(err)
+}
+
 // RunPFSLoadTest runs a PFS load test.
 func (c APIClient) RunPFSLoadTest(spec []byte, seed ...int64) (_ *pfs.RunLoadTestResponse, retErr error) {
 	defer func() {
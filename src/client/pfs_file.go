@@ -132,7 +132,7 @@ func (mfc *modifyFileCore) PutFile(path string, r io.Reader, opts ...PutFileOpti
 	return mfc.maybeError(func() error {
 		if !config.append {
 			if err := mfc.sendDeleteFile(&pfs.DeleteFile{
-				Path: path,
+				Path: config.deletePath(path),
 				Tag:  config.tag,
 			}); err != nil {
 				return err
@@ -141,26 +141,42 @@ func (mfc *modifyFileCore) PutFile(path string, r io.Reader, opts ...PutFileOpti
 		emptyFile := true
 		if _, err := grpcutil.ChunkReader(r, func(data []byte) error {
 			emptyFile = false
-			return mfc.sendPutFile(&pfs.AddFile{
-				Path: path,
-				Tag:  config.tag,
-				Source: &pfs.AddFile_Raw{
-					Raw: &types.BytesValue{Value: data},
-				},
-			})
+			af := config.addFile(path)
+			af.Source = &pfs.AddFile_Raw{
+				Raw: &types.BytesValue{Value: data},
+			}
+			return mfc.sendPutFile(af)
 		}); err != nil {
 			return err
 		}
-		if emptyFile {
-			return mfc.sendPutFile(&pfs.AddFile{
-				Path: path,
-				Tag:  config.tag,
-			})
+		if emptyFile && config.delimiter == pfs.Delimiter_NONE {
+			return mfc.sendPutFile(config.addFile(path))
 		}
 		return nil
 	})
 }
 
+// deletePath returns the path that must be deleted to overwrite path, which
+// is the directory of split files if the put is split.
+func (config *putFileConfig) deletePath(path string) string {
+	if config.delimiter != pfs.Delimiter_NONE {
+		return strings.TrimRight(path, "/") + "/"
+	}
+	return path
+}
+
+func (config *putFileConfig) addFile(path string) *pfs.AddFile {
+	return &pfs.AddFile{
+		Path:             path,
+		Tag:              config.tag,
+		Delimiter:        config.delimiter,
+		TargetFileDatums: config.targetFileDatums,
+		TargetFileBytes:  config.targetFileBytes,
+		HeaderRecords:    config.headerRecords,
+		Attributes:       config.attributes,
+	}
+}
+
 func (mfc *modifyFileCore) maybeError(f func() error) (retErr error) {
 	if mfc.err != nil {
 		return mfc.err
@@ -207,16 +223,18 @@ func (mfc *modifyFileCore) PutFileTAR(r io.Reader, opts ...PutFileOption) error
 			}
 			if hdr.Size == 0 {
 				if err := mfc.sendPutFile(&pfs.AddFile{
-					Path: p,
-					Tag:  config.tag,
+					Path:       p,
+					Tag:        config.tag,
+					Attributes: config.attributes,
 				}); err != nil {
 					return err
 				}
 			} else {
 				if _, err := grpcutil.ChunkReader(tr, func(data []byte) error {
 					return mfc.sendPutFile(&pfs.AddFile{
-						Path: p,
-						Tag:  config.tag,
+						Path:       p,
+						Tag:        config.tag,
+						Attributes: config.attributes,
 						Source: &pfs.AddFile_Raw{
 							Raw: &types.BytesValue{Value: data},
 						},
@@ -238,23 +256,20 @@ func (mfc *modifyFileCore) PutFileURL(path, url string, recursive bool, opts ...
 	return mfc.maybeError(func() error {
 		if !config.append {
 			if err := mfc.sendDeleteFile(&pfs.DeleteFile{
-				Path: path,
+				Path: config.deletePath(path),
 				Tag:  config.tag,
 			}); err != nil {
 				return err
 			}
 		}
-		pf := &pfs.AddFile{
-			Path: path,
-			Tag:  config.tag,
-			Source: &pfs.AddFile_Url{
-				Url: &pfs.AddFile_URLSource{
-					URL:       url,
-					Recursive: recursive,
-				},
+		af := config.addFile(path)
+		af.Source = &pfs.AddFile_Url{
+			Url: &pfs.AddFile_URLSource{
+				URL:       url,
+				Recursive: recursive,
 			},
 		}
-		return mfc.sendPutFile(pf)
+		return mfc.sendPutFile(af)
 	})
 }
 
@@ -328,9 +343,17 @@ func (c APIClient) WithRenewer(cb func(context.Context, *renew.StringSet) error)
 
 // WithCreateFileSetClient provides a scoped fileset client.
 func (c APIClient) WithCreateFileSetClient(cb func(ModifyFile) error) (resp *pfs.CreateFileSetResponse, retErr error) {
+	return c.WithCreateFileSetClientForCommit(nil, cb)
+}
+
+// WithCreateFileSetClientForCommit provides a scoped fileset client for a
+// fileset that is going to be added to commit, which is written with the
+// storage options (such as compression) of the commit's repo. The fileset
+// is not added to commit.
+func (c APIClient) WithCreateFileSetClientForCommit(commit *pfs.Commit, cb func(ModifyFile) error) (resp *pfs.CreateFileSetResponse, retErr error) {
 	cancelCtx, cancel := context.WithCancel(c.Ctx())
 	defer cancel()
-	ctfsc, err := c.WithCtx(cancelCtx).NewCreateFileSetClient()
+	ctfsc, err := c.WithCtx(cancelCtx).newCreateFileSetClient(commit)
 	if err != nil {
 		return nil, err
 	}
@@ -350,6 +373,10 @@ type CreateFileSetClient struct {
 
 // NewCreateFileSetClient returns a CreateFileSetClient instance backed by this client
 func (c APIClient) NewCreateFileSetClient() (_ *CreateFileSetClient, retErr error) {
+	return c.newCreateFileSetClient(nil)
+}
+
+func (c APIClient) newCreateFileSetClient(commit *pfs.Commit) (_ *CreateFileSetClient, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
@@ -357,6 +384,13 @@ func (c APIClient) NewCreateFileSetClient() (_ *CreateFileSetClient, retErr erro
 	if err != nil {
 		return nil, err
 	}
+	if commit != nil {
+		if err := client.Send(&pfs.ModifyFileRequest{
+			Body: &pfs.ModifyFileRequest_SetCommit{SetCommit: commit},
+		}); err != nil {
+			return nil, err
+		}
+	}
 	return &CreateFileSetClient{
 		client: client,
 		modifyFileCore: modifyFileCore{
@@ -460,19 +494,47 @@ func (c APIClient) GetFileReader(commit *pfs.Commit, path string) (io.Reader, er
 	return tr, nil
 }
 
-// GetFileReadSeeker returns a reader for the contents of a file at a specific
-// Commit that permits Seeking to different points in the file.
-func (c APIClient) GetFileReadSeeker(commit *pfs.Commit, path string) (io.ReadSeeker, error) {
-	fi, err := c.InspectFile(commit, path)
+// GetFileRange writes size bytes of the contents of a file at a specific
+// Commit, starting at offset, to w. If size is 0, all of the bytes after
+// offset are written. Only the parts of the file that overlap the range are
+// read by pachd.
+func (c APIClient) GetFileRange(commit *pfs.Commit, path string, offset, size int64, w io.Writer) (retErr error) {
+	r, err := c.getFileRange(commit, path, offset, size)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func (c APIClient) getFileRange(commit *pfs.Commit, path string, offset, size int64) (_ io.Reader, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	client, err := c.PfsAPIClient.GetFileRange(c.Ctx(), &pfs.GetFileRangeRequest{
+		File:        commit.NewFile(path),
+		OffsetBytes: offset,
+		SizeBytes:   size,
+	})
 	if err != nil {
 		return nil, err
 	}
-	r, err := c.GetFileReader(commit, path)
+	return grpcutil.NewStreamingBytesReader(client, nil), nil
+}
+
+// GetFileReadSeeker returns a reader for the contents of a file at a specific
+// Commit that permits Seeking to different points in the file. The contents
+// are requested when they are first read after a seek, starting at the
+// offset that was seeked to.
+func (c APIClient) GetFileReadSeeker(commit *pfs.Commit, path string) (io.ReadSeeker, error) {
+	fi, err := c.InspectFile(commit, path)
 	if err != nil {
 		return nil, err
 	}
 	return &getFileReadSeeker{
-		Reader: r,
 		c:      c,
 		file:   commit.NewFile(path),
 		offset: 0,
@@ -481,46 +543,41 @@ func (c APIClient) GetFileReadSeeker(commit *pfs.Commit, path string) (io.ReadSe
 }
 
 type getFileReadSeeker struct {
-	io.Reader
+	r            io.Reader
 	c            APIClient
 	file         *pfs.File
 	offset, size int64
 }
 
-func (gfrs *getFileReadSeeker) Seek(offset int64, whence int) (int64, error) {
-	getFileReader := func(offset int64) (io.Reader, error) {
-		r, err := gfrs.c.GetFileReader(gfrs.file.Commit, gfrs.file.Path)
+func (gfrs *getFileReadSeeker) Read(data []byte) (int, error) {
+	if gfrs.r == nil {
+		r, err := gfrs.c.getFileRange(gfrs.file.Commit, gfrs.file.Path, gfrs.offset, 0)
 		if err != nil {
-			return nil, err
-		}
-		// TODO: Replace with file range request when implemented in PFS.
-		if _, err := io.CopyN(ioutil.Discard, r, offset); err != nil {
-			return nil, err
+			return 0, err
 		}
-		return r, nil
+		gfrs.r = r
 	}
+	n, err := gfrs.r.Read(data)
+	gfrs.offset += int64(n)
+	return n, err
+}
+
+func (gfrs *getFileReadSeeker) Seek(offset int64, whence int) (int64, error) {
 	switch whence {
 	case io.SeekStart:
-		r, err := getFileReader(offset)
-		if err != nil {
-			return gfrs.offset, err
-		}
-		gfrs.offset = offset
-		gfrs.Reader = r
 	case io.SeekCurrent:
-		r, err := getFileReader(gfrs.offset + offset)
-		if err != nil {
-			return gfrs.offset, err
-		}
-		gfrs.offset += offset
-		gfrs.Reader = r
+		offset += gfrs.offset
 	case io.SeekEnd:
-		r, err := getFileReader(gfrs.size - offset)
-		if err != nil {
-			return gfrs.offset, err
-		}
-		gfrs.offset = gfrs.size - offset
-		gfrs.Reader = r
+		offset += gfrs.size
+	default:
+		return gfrs.offset, errors.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return gfrs.offset, errors.Errorf("cannot seek to negative offset %d", offset)
+	}
+	if offset != gfrs.offset {
+		gfrs.offset = offset
+		gfrs.r = nil
 	}
 	return gfrs.offset, nil
 }
@@ -556,17 +613,61 @@ func (c APIClient) InspectFile(commit *pfs.Commit, path string) (_ *pfs.FileInfo
 	return fi, err
 }
 
-// ListFile returns info about all files in a Commit under path, calling cb with each FileInfo.
-func (c APIClient) ListFile(commit *pfs.Commit, path string, cb func(fi *pfs.FileInfo) error) (retErr error) {
+// InspectFileHistory returns metadata about a previous version of the
+// specified file. history selects the version, see pfs.InspectFileRequest.
+func (c APIClient) InspectFileHistory(commit *pfs.Commit, path string, history int64) (_ *pfs.FileInfo, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
-	client, err := c.PfsAPIClient.ListFile(
+	fi, err := c.PfsAPIClient.InspectFile(
 		c.Ctx(),
-		&pfs.ListFileRequest{
-			File: commit.NewFile(path),
+		&pfs.InspectFileRequest{
+			File:    commit.NewFile(path),
+			History: history,
 		},
 	)
+	return fi, err
+}
+
+// ListFile returns info about all files in a Commit under path, calling cb with each FileInfo.
+func (c APIClient) ListFile(commit *pfs.Commit, path string, cb func(fi *pfs.FileInfo) error) (retErr error) {
+	return c.ListFileHistory(commit, path, 0, cb)
+}
+
+// ListFileHistory returns info about all files in a Commit under path, along
+// with up to history previous versions of each file (all versions if history
+// is -1), calling cb with each FileInfo.
+func (c APIClient) ListFileHistory(commit *pfs.Commit, path string, history int64, cb func(fi *pfs.FileInfo) error) (retErr error) {
+	return c.listFile(&pfs.ListFileRequest{
+		File:    commit.NewFile(path),
+		History: history,
+	}, cb)
+}
+
+// ListFilePage returns info about up to pageSize files in a Commit under
+// path, starting from continuation (from the first file if it's empty). It
+// also returns the continuation token for the next page, which is empty if
+// there are no more files.
+func (c APIClient) ListFilePage(commit *pfs.Commit, path string, pageSize int64, continuation string) (_ []*pfs.FileInfo, _ string, retErr error) {
+	var fis []*pfs.FileInfo
+	if err := c.listFile(&pfs.ListFileRequest{
+		File:         commit.NewFile(path),
+		PageSize:     pageSize,
+		Continuation: continuation,
+	}, func(fi *pfs.FileInfo) error {
+		fis = append(fis, fi)
+		return nil
+	}); err != nil {
+		return nil, "", err
+	}
+	return fis, fileContinuation(fis), nil
+}
+
+func (c APIClient) listFile(request *pfs.ListFileRequest, cb func(fi *pfs.FileInfo) error) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	client, err := c.PfsAPIClient.ListFile(c.Ctx(), request)
 	if err != nil {
 		return err
 	}
@@ -606,16 +707,37 @@ func (c APIClient) ListFileAll(commit *pfs.Commit, path string) (_ []*pfs.FileIn
 // calling cb with each FileInfo. The pattern is documented here:
 // https://golang.org/pkg/path/filepath/#Match
 func (c APIClient) GlobFile(commit *pfs.Commit, pattern string, cb func(fi *pfs.FileInfo) error) (retErr error) {
+	return c.globFile(&pfs.GlobFileRequest{
+		Commit:  commit,
+		Pattern: pattern,
+	}, cb)
+}
+
+// GlobFilePage returns up to pageSize files that match a given glob pattern
+// in a given commit, starting from continuation (from the first match if it's
+// empty). It also returns the continuation token for the next page, which is
+// empty if there are no more matches.
+func (c APIClient) GlobFilePage(commit *pfs.Commit, pattern string, pageSize int64, continuation string) (_ []*pfs.FileInfo, _ string, retErr error) {
+	var fis []*pfs.FileInfo
+	if err := c.globFile(&pfs.GlobFileRequest{
+		Commit:       commit,
+		Pattern:      pattern,
+		PageSize:     pageSize,
+		Continuation: continuation,
+	}, func(fi *pfs.FileInfo) error {
+		fis = append(fis, fi)
+		return nil
+	}); err != nil {
+		return nil, "", err
+	}
+	return fis, fileContinuation(fis), nil
+}
+
+func (c APIClient) globFile(request *pfs.GlobFileRequest, cb func(fi *pfs.FileInfo) error) (retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
-	client, err := c.PfsAPIClient.GlobFile(
-		c.Ctx(),
-		&pfs.GlobFileRequest{
-			Commit:  commit,
-			Pattern: pattern,
-		},
-	)
+	client, err := c.PfsAPIClient.GlobFile(c.Ctx(), request)
 	if err != nil {
 		return err
 	}
@@ -636,6 +758,14 @@ func (c APIClient) GlobFile(commit *pfs.Commit, pattern string, cb func(fi *pfs.
 	}
 }
 
+// fileContinuation returns the continuation token of the last file in a page.
+func fileContinuation(fis []*pfs.FileInfo) string {
+	if len(fis) == 0 {
+		return ""
+	}
+	return fis[len(fis)-1].Continuation
+}
+
 // GlobFileAll returns files that match a given glob pattern in a given commit.
 // The pattern is documented here: https://golang.org/pkg/path/filepath/#Match
 func (c APIClient) GlobFileAll(commit *pfs.Commit, pattern string) (_ []*pfs.FileInfo, retErr error) {
@@ -707,12 +837,47 @@ func (c APIClient) DiffFileAll(newCommit *pfs.Commit, newPath string, oldCommit
 	return newFis, oldFis, nil
 }
 
+// DiffFileSummary returns a summary of the differences between 2 paths at 2
+// commits.
+func (c APIClient) DiffFileSummary(newCommit *pfs.Commit, newPath string, oldCommit *pfs.Commit, oldPath string) (_ *pfs.DiffFileSummary, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	ctx, cancel := context.WithCancel(c.Ctx())
+	defer cancel()
+	var oldFile *pfs.File
+	if oldCommit != nil {
+		oldFile = oldCommit.NewFile(oldPath)
+	}
+	client, err := c.PfsAPIClient.DiffFile(ctx, &pfs.DiffFileRequest{
+		NewFile: newCommit.NewFile(newPath),
+		OldFile: oldFile,
+		Summary: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Summary, nil
+}
+
 // WalkFile walks the files under path.
-func (c APIClient) WalkFile(commit *pfs.Commit, path string, cb func(*pfs.FileInfo) error) (retErr error) {
+func (c APIClient) WalkFile(commit *pfs.Commit, path string, cb func(*pfs.FileInfo) error) error {
+	return c.WalkFileHistory(commit, path, 0, cb)
+}
+
+// WalkFileHistory walks the files under path, returning up to history
+// versions of each (all versions if history is -1), newest first. See
+// WalkFileRequest for the semantics of history.
+func (c APIClient) WalkFileHistory(commit *pfs.Commit, path string, history int64, cb func(*pfs.FileInfo) error) (retErr error) {
 	client, err := c.PfsAPIClient.WalkFile(
 		c.Ctx(),
 		&pfs.WalkFileRequest{
-			File: commit.NewFile(path),
+			File:    commit.NewFile(path),
+			History: history,
 		})
 	if err != nil {
 		return err
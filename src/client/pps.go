@@ -848,6 +848,62 @@ func (c APIClient) ListSecret() ([]*pps.SecretInfo, error) {
 	return secretInfos.SecretInfo, nil
 }
 
+// CreateWebhook registers a webhook that url is POSTed the commit and job
+// events matched by the filters, either of which may be nil. The returned
+// WebhookInfo holds the secret that the payloads are signed with.
+func (c APIClient) CreateWebhook(name, url string, commits *pps.WebhookCommitFilter, jobs *pps.WebhookJobFilter, update bool) (*pps.WebhookInfo, error) {
+	webhookInfo, err := c.PpsAPIClient.CreateWebhook(
+		c.Ctx(),
+		&pps.CreateWebhookRequest{
+			Webhook: &pps.Webhook{Name: name},
+			URL:     url,
+			Commits: commits,
+			Jobs:    jobs,
+			Update:  update,
+		},
+	)
+	return webhookInfo, grpcutil.ScrubGRPC(err)
+}
+
+// ListWebhook returns info about all webhooks, without their secrets.
+func (c APIClient) ListWebhook() ([]*pps.WebhookInfo, error) {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	client, err := c.PpsAPIClient.ListWebhook(ctx, &pps.ListWebhookRequest{})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	webhookInfos, err := clientsdk.ListWebhookInfo(client)
+	return webhookInfos, grpcutil.ScrubGRPC(err)
+}
+
+// DeleteWebhook deletes a webhook and its delivery history.
+func (c APIClient) DeleteWebhook(name string) error {
+	_, err := c.PpsAPIClient.DeleteWebhook(
+		c.Ctx(),
+		&pps.DeleteWebhookRequest{
+			Webhook: &pps.Webhook{Name: name},
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ListWebhookDelivery returns the delivery history of a webhook, newest first.
+// If state is set, only the deliveries in that state are returned.
+func (c APIClient) ListWebhookDelivery(name string, state pps.WebhookDeliveryState) ([]*pps.WebhookDelivery, error) {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	client, err := c.PpsAPIClient.ListWebhookDelivery(ctx, &pps.ListWebhookDeliveryRequest{
+		Webhook: &pps.Webhook{Name: name},
+		State:   state,
+	})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	deliveries, err := clientsdk.ListWebhookDelivery(client)
+	return deliveries, grpcutil.ScrubGRPC(err)
+}
+
 // CreatePipelineService creates a new pipeline service.
 func (c APIClient) CreatePipelineService(
 	name string,
@@ -291,6 +291,16 @@ func (c *pfsBuilderClient) DeleteRepo(ctx context.Context, req *pfs.DeleteRepoRe
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{DeleteRepo: req})
 	return nil, nil
 }
+func (c *pfsBuilderClient) RenameRepo(ctx context.Context, req *pfs.RenameRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{RenameRepo: req})
+	return nil, nil
+}
+func (c *pfsBuilderClient) ForkRepo(ctx context.Context, req *pfs.ForkRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("ForkRepo")
+}
+func (c *pfsBuilderClient) SetMetadata(ctx context.Context, req *pfs.SetMetadataRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("SetMetadata")
+}
 func (c *pfsBuilderClient) StartCommit(ctx context.Context, req *pfs.StartCommitRequest, opts ...grpc.CallOption) (*pfs.Commit, error) {
 	// Note that since we are batching requests (no extra round-trips), we do not
 	// have the commit id to return here. If you need an operation that relies
@@ -306,6 +316,15 @@ func (c *pfsBuilderClient) SquashCommitSet(ctx context.Context, req *pfs.SquashC
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{SquashCommitSet: req})
 	return nil, nil
 }
+func (c *pfsBuilderClient) RevertCommit(ctx context.Context, req *pfs.RevertCommitRequest, opts ...grpc.CallOption) (*pfs.Commit, error) {
+	return nil, unsupportedError("RevertCommit")
+}
+func (c *pfsBuilderClient) CherryPickCommit(ctx context.Context, req *pfs.CherryPickCommitRequest, opts ...grpc.CallOption) (*pfs.Commit, error) {
+	return nil, unsupportedError("CherryPickCommit")
+}
+func (c *pfsBuilderClient) MergeBranch(ctx context.Context, req *pfs.MergeBranchRequest, opts ...grpc.CallOption) (*pfs.MergeBranchResponse, error) {
+	return nil, unsupportedError("MergeBranch")
+}
 func (c *pfsBuilderClient) CreateBranch(ctx context.Context, req *pfs.CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{CreateBranch: req})
 	return nil, nil
@@ -314,6 +333,10 @@ func (c *pfsBuilderClient) DeleteBranch(ctx context.Context, req *pfs.DeleteBran
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{DeleteBranch: req})
 	return nil, nil
 }
+func (c *pfsBuilderClient) RenameBranch(ctx context.Context, req *pfs.RenameBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{RenameBranch: req})
+	return nil, nil
+}
 func (c *ppsBuilderClient) StopJob(ctx context.Context, req *pps.StopJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{StopJob: req})
 	return nil, nil
@@ -371,6 +394,9 @@ func (c *pfsBuilderClient) ModifyFile(ctx context.Context, opts ...grpc.CallOpti
 func (c *pfsBuilderClient) GetFileTAR(ctx context.Context, req *pfs.GetFileRequest, opts ...grpc.CallOption) (pfs.API_GetFileTARClient, error) {
 	return nil, unsupportedError("GetFileTAR")
 }
+func (c *pfsBuilderClient) GetFileRange(ctx context.Context, req *pfs.GetFileRangeRequest, opts ...grpc.CallOption) (pfs.API_GetFileRangeClient, error) {
+	return nil, unsupportedError("GetFileRange")
+}
 func (c *pfsBuilderClient) InspectFile(ctx context.Context, req *pfs.InspectFileRequest, opts ...grpc.CallOption) (*pfs.FileInfo, error) {
 	return nil, unsupportedError("InspectFile")
 }
@@ -392,6 +418,27 @@ func (c *pfsBuilderClient) DeleteAll(ctx context.Context, req *types.Empty, opts
 func (c *pfsBuilderClient) Fsck(ctx context.Context, req *pfs.FsckRequest, opts ...grpc.CallOption) (pfs.API_FsckClient, error) {
 	return nil, unsupportedError("Fsck")
 }
+func (c *pfsBuilderClient) ExportRepo(ctx context.Context, req *pfs.ExportRepoRequest, opts ...grpc.CallOption) (pfs.API_ExportRepoClient, error) {
+	return nil, unsupportedError("ExportRepo")
+}
+func (c *pfsBuilderClient) ImportRepo(ctx context.Context, opts ...grpc.CallOption) (pfs.API_ImportRepoClient, error) {
+	return nil, unsupportedError("ImportRepo")
+}
+func (c *pfsBuilderClient) RotateStorageKey(ctx context.Context, req *pfs.RotateStorageKeyRequest, opts ...grpc.CallOption) (*pfs.StorageKeyInfo, error) {
+	return nil, unsupportedError("RotateStorageKey")
+}
+func (c *pfsBuilderClient) InspectStorageKey(ctx context.Context, req *pfs.InspectStorageKeyRequest, opts ...grpc.CallOption) (*pfs.StorageKeyInfo, error) {
+	return nil, unsupportedError("InspectStorageKey")
+}
+func (c *pfsBuilderClient) CreateQuota(ctx context.Context, req *pfs.CreateQuotaRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("CreateQuota")
+}
+func (c *pfsBuilderClient) InspectQuota(ctx context.Context, req *pfs.InspectQuotaRequest, opts ...grpc.CallOption) (*pfs.QuotaInfo, error) {
+	return nil, unsupportedError("InspectQuota")
+}
+func (c *pfsBuilderClient) ListQuota(ctx context.Context, req *pfs.ListQuotaRequest, opts ...grpc.CallOption) (pfs.API_ListQuotaClient, error) {
+	return nil, unsupportedError("ListQuota")
+}
 func (c *pfsBuilderClient) CreateFileSet(ctx context.Context, opts ...grpc.CallOption) (pfs.API_CreateFileSetClient, error) {
 	return nil, unsupportedError("CreateFileSet")
 }
@@ -474,6 +521,18 @@ func (c *ppsBuilderClient) DeleteSecret(ctx context.Context, req *pps.DeleteSecr
 func (c *ppsBuilderClient) InspectSecret(ctx context.Context, req *pps.InspectSecretRequest, opt ...grpc.CallOption) (*pps.SecretInfo, error) {
 	return nil, unsupportedError("InspectSecret")
 }
+func (c *ppsBuilderClient) CreateWebhook(ctx context.Context, req *pps.CreateWebhookRequest, opts ...grpc.CallOption) (*pps.WebhookInfo, error) {
+	return nil, unsupportedError("CreateWebhook")
+}
+func (c *ppsBuilderClient) ListWebhook(ctx context.Context, req *pps.ListWebhookRequest, opts ...grpc.CallOption) (pps.API_ListWebhookClient, error) {
+	return nil, unsupportedError("ListWebhook")
+}
+func (c *ppsBuilderClient) DeleteWebhook(ctx context.Context, req *pps.DeleteWebhookRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeleteWebhook")
+}
+func (c *ppsBuilderClient) ListWebhookDelivery(ctx context.Context, req *pps.ListWebhookDeliveryRequest, opts ...grpc.CallOption) (pps.API_ListWebhookDeliveryClient, error) {
+	return nil, unsupportedError("ListWebhookDelivery")
+}
 func (c *ppsBuilderClient) ListSecret(ctx context.Context, in *types.Empty, opt ...grpc.CallOption) (*pps.SecretInfos, error) {
 	return nil, unsupportedError("ListSecret")
 }
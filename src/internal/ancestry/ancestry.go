@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 )
@@ -72,6 +73,40 @@ func Add(s string, ancestors int) string {
 	return s
 }
 
+// timeLayouts are the formats accepted for the time in a time reference.
+var timeLayouts = []string{time.RFC3339, "2006-01-02T15:04Z07:00", "2006-01-02"}
+
+// ParseTime parses s for a time reference of the form base@time, which refers
+// to the newest commit on base (a branch or commit) that was finished at or
+// before time. time may be an RFC 3339 timestamp, optionally without seconds,
+// or a date, which is taken to be midnight UTC.
+// ParseTime returns the base reference and the time, or nil if s has no time
+// reference. For example:
+// foo@2021-06-01T00:00:00Z -> foo, 2021-06-01 00:00:00 UTC
+// foo@2021-06-01T00:00Z -> foo, 2021-06-01 00:00:00 UTC
+// foo@2021-06-01 -> foo, 2021-06-01 00:00:00 UTC
+func ParseTime(s string) (string, *time.Time, error) {
+	sepIndex := strings.Index(s, "@")
+	if sepIndex == -1 {
+		return s, nil, nil
+	}
+	t, err := ParseTimestamp(s[sepIndex+1:])
+	if err != nil {
+		return "", nil, err
+	}
+	return s[:sepIndex], &t, nil
+}
+
+// ParseTimestamp parses the time in a time reference.
+func ParseTimestamp(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("invalid time %q, expected an RFC 3339 timestamp or a date (YYYY-MM-DD)", s)
+}
+
 var (
 	valid              = regexp.MustCompile("^[a-zA-Z0-9_-]+$") // Matches a valid name
 	invalid            = regexp.MustCompile("[^a-zA-Z0-9_-]")   // matches an invalid character
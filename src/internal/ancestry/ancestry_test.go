@@ -2,6 +2,7 @@ package ancestry
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 )
@@ -32,6 +33,43 @@ func TestAncestry(t *testing.T) {
 	}
 }
 
+var timeTests = []struct {
+	in   string
+	name string
+	time time.Time
+}{
+	{"foo@2021-06-01T12:30:15Z", "foo", time.Date(2021, 6, 1, 12, 30, 15, 0, time.UTC)},
+	{"foo@2021-06-01T12:30:15.5Z", "foo", time.Date(2021, 6, 1, 12, 30, 15, 500000000, time.UTC)},
+	{"foo@2021-06-01T12:30Z", "foo", time.Date(2021, 6, 1, 12, 30, 0, 0, time.UTC)},
+	{"foo@2021-06-01T12:30+02:00", "foo", time.Date(2021, 6, 1, 10, 30, 0, 0, time.UTC)},
+	{"foo@2021-06-01", "foo", time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)},
+	{"@2021-06-01", "", time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)},
+}
+
+var invalidTimes = []string{
+	"foo@",
+	"foo@yesterday",
+	"foo@2021-06-01T12",
+	"foo@2021-06-01@2021-06-02",
+}
+
+func TestTime(t *testing.T) {
+	for i, test := range timeTests {
+		name, tm, err := ParseTime(test.in)
+		require.NoError(t, err, "timeTests[%d]", i)
+		require.Equal(t, test.name, name, "timeTests[%d]", i)
+		require.True(t, test.time.Equal(*tm), "timeTests[%d]", i)
+	}
+	name, tm, err := ParseTime("foo")
+	require.NoError(t, err)
+	require.Equal(t, "foo", name)
+	require.Nil(t, tm)
+	for i, in := range invalidTimes {
+		_, _, err := ParseTime(in)
+		require.YesError(t, err, "invalidTimes[%d]", i)
+	}
+}
+
 var validNames = []string{
 	"foo",
 	"foo2",
@@ -126,6 +126,9 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/InspectRepo":      authDisabledOr(authenticated),
 	"/pfs_v2.API/ListRepo":         authDisabledOr(authenticated),
 	"/pfs_v2.API/DeleteRepo":       authDisabledOr(authenticated),
+	"/pfs_v2.API/RenameRepo":       authDisabledOr(authenticated),
+	"/pfs_v2.API/ForkRepo":         authDisabledOr(authenticated),
+	"/pfs_v2.API/SetMetadata":      authDisabledOr(authenticated),
 	"/pfs_v2.API/StartCommit":      authDisabledOr(authenticated),
 	"/pfs_v2.API/FinishCommit":     authDisabledOr(authenticated),
 	"/pfs_v2.API/InspectCommit":    authDisabledOr(authenticated),
@@ -135,12 +138,17 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/InspectCommitSet": authDisabledOr(authenticated),
 	"/pfs_v2.API/ListCommitSet":    authDisabledOr(authenticated),
 	"/pfs_v2.API/SquashCommitSet":  authDisabledOr(authenticated),
+	"/pfs_v2.API/RevertCommit":     authDisabledOr(authenticated),
+	"/pfs_v2.API/CherryPickCommit": authDisabledOr(authenticated),
 	"/pfs_v2.API/CreateBranch":     authDisabledOr(authenticated),
 	"/pfs_v2.API/InspectBranch":    authDisabledOr(authenticated),
 	"/pfs_v2.API/ListBranch":       authDisabledOr(authenticated),
 	"/pfs_v2.API/DeleteBranch":     authDisabledOr(authenticated),
+	"/pfs_v2.API/RenameBranch":     authDisabledOr(authenticated),
+	"/pfs_v2.API/MergeBranch":      authDisabledOr(authenticated),
 	"/pfs_v2.API/ModifyFile":       authDisabledOr(authenticated),
 	"/pfs_v2.API/GetFileTAR":       authDisabledOr(authenticated),
+	"/pfs_v2.API/GetFileRange":     authDisabledOr(authenticated),
 	"/pfs_v2.API/InspectFile":      authDisabledOr(authenticated),
 	"/pfs_v2.API/ListFile":         authDisabledOr(authenticated),
 	"/pfs_v2.API/WalkFile":         authDisabledOr(authenticated),
@@ -148,12 +156,21 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/DiffFile":         authDisabledOr(authenticated),
 	"/pfs_v2.API/DeleteAll":        authDisabledOr(authenticated),
 	"/pfs_v2.API/Fsck":             authDisabledOr(authenticated),
+	"/pfs_v2.API/ExportRepo":       authDisabledOr(authenticated),
+	"/pfs_v2.API/ImportRepo":       authDisabledOr(authenticated),
 	"/pfs_v2.API/CreateFileSet":    authDisabledOr(authenticated),
 	"/pfs_v2.API/GetFileSet":       authDisabledOr(authenticated),
 	"/pfs_v2.API/AddFileSet":       authDisabledOr(authenticated),
 	"/pfs_v2.API/RenewFileSet":     authDisabledOr(authenticated),
 	"/pfs_v2.API/RunLoadTest":      authDisabledOr(authenticated),
 
+	"/pfs_v2.API/RotateStorageKey":  authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_ROTATE_STORAGE_KEY)),
+	"/pfs_v2.API/InspectStorageKey": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_INSPECT_STORAGE_KEY)),
+
+	"/pfs_v2.API/CreateQuota":  authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_EDIT_QUOTA)),
+	"/pfs_v2.API/InspectQuota": authDisabledOr(authenticated),
+	"/pfs_v2.API/ListQuota":    authDisabledOr(authenticated),
+
 	//
 	// PPS API
 	//
@@ -191,6 +208,11 @@ var authHandlers = map[string]authHandler{
 	"/pps_v2.API/DeleteSecret":  authDisabledOr(clusterPermissions(auth.Permission_SECRET_DELETE)),
 	"/pps_v2.API/InspectSecret": authDisabledOr(clusterPermissions(auth.Permission_SECRET_INSPECT)),
 
+	"/pps_v2.API/CreateWebhook":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_EDIT_WEBHOOKS)),
+	"/pps_v2.API/DeleteWebhook":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_EDIT_WEBHOOKS)),
+	"/pps_v2.API/ListWebhook":         authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LIST_WEBHOOKS)),
+	"/pps_v2.API/ListWebhookDelivery": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LIST_WEBHOOKS)),
+
 	//
 	// TransactionAPI
 	//
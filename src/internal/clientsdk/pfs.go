@@ -134,3 +134,33 @@ func ForEachSubscribeCommit(client pfs.API_SubscribeCommitClient, cb func(*pfs.C
 	}
 	return nil
 }
+
+func ForEachQuotaInfo(client pfs.API_ListQuotaClient, cb func(*pfs.QuotaInfo) error) error {
+	for {
+		x, err := client.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		if err := cb(x); err != nil {
+			if err == pacherr.ErrBreak {
+				err = nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func ListQuotaInfo(client pfs.API_ListQuotaClient) ([]*pfs.QuotaInfo, error) {
+	var results []*pfs.QuotaInfo
+	if err := ForEachQuotaInfo(client, func(x *pfs.QuotaInfo) error {
+		results = append(results, x)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -55,3 +55,63 @@ func ForEachJobSet(client pps.API_ListJobSetClient, cb func(*pps.JobSetInfo) err
 	}
 	return nil
 }
+
+func ForEachWebhookInfo(client pps.API_ListWebhookClient, cb func(*pps.WebhookInfo) error) error {
+	for {
+		x, err := client.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		if err := cb(x); err != nil {
+			if err == pacherr.ErrBreak {
+				err = nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func ListWebhookInfo(client pps.API_ListWebhookClient) ([]*pps.WebhookInfo, error) {
+	var webhookInfos []*pps.WebhookInfo
+	if err := ForEachWebhookInfo(client, func(wi *pps.WebhookInfo) error {
+		webhookInfos = append(webhookInfos, wi)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return webhookInfos, nil
+}
+
+func ForEachWebhookDelivery(client pps.API_ListWebhookDeliveryClient, cb func(*pps.WebhookDelivery) error) error {
+	for {
+		x, err := client.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		if err := cb(x); err != nil {
+			if err == pacherr.ErrBreak {
+				err = nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func ListWebhookDelivery(client pps.API_ListWebhookDeliveryClient) ([]*pps.WebhookDelivery, error) {
+	var deliveries []*pps.WebhookDelivery
+	if err := ForEachWebhookDelivery(client, func(d *pps.WebhookDelivery) error {
+		deliveries = append(deliveries, d)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
@@ -94,4 +94,22 @@ var DesiredClusterState migrations.State = migrations.InitialState().
 		collections := []col.PostgresCollection{}
 		collections = append(collections, licenseserver.AllCollections()...)
 		return col.SetupPostgresCollections(ctx, env.Tx, collections...)
+	}).
+	Apply("storage chunk store v1", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupPostgresStoreV1(env.Tx)
+	}).
+	Apply("storage chunk store v2", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupPostgresStoreV2(env.Tx)
+	}).
+	Apply("create pfs quotas collection", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, pfsdb.QuotaCollections()...)
+	}).
+	Apply("create pfs metadata collection", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, pfsdb.MetadataCollections()...)
+	}).
+	Apply("create pps webhook collections", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, ppsdb.WebhookCollections()...)
+	}).
+	Apply("pfs repos forked_from index", func(ctx context.Context, env migrations.Env) error {
+		return pfsdb.AddReposForkedFromIndex(ctx, env.Tx)
 	})
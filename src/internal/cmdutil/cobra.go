@@ -136,8 +136,10 @@ func ParseRepo(name string) *pfs.Repo {
 //   repo@branch=commit:path
 //   repo@commit
 //   repo@commit:path
+// A time may follow the branch or commit, as in repo@branch@time:path, to
+// refer to the newest commit finished at or before that time.
 func parseFile(arg string) (*pfs.File, int, error) {
-	var repo, branch, commit, path string
+	var repo, branch, commit, path, timestamp string
 	parts := strings.SplitN(arg, "@", 2)
 	if parts[0] == "" {
 		return nil, 0, errors.Errorf("invalid format \"%s\": repo cannot be empty", arg)
@@ -147,7 +149,19 @@ func parseFile(arg string) (*pfs.File, int, error) {
 
 	if len(parts) == 2 {
 		numFields = 2
-		parts = strings.SplitN(parts[1], ":", 2)
+		ref := parts[1]
+		if i := strings.Index(ref, "@"); i != -1 && !strings.Contains(ref[:i], ":") {
+			// Times contain colons, so the path is split off after the longest
+			// prefix which is a valid time.
+			var rest string
+			var err error
+			timestamp, rest, err = splitTime(ref[i+1:])
+			if err != nil {
+				return nil, 0, errors.Wrapf(err, "invalid format \"%s\"", arg)
+			}
+			ref = ref[:i] + rest
+		}
+		parts = strings.SplitN(ref, ":", 2)
 		if len(parts) == 2 {
 			numFields = 3
 			path = parts[1]
@@ -164,10 +178,25 @@ func parseFile(arg string) (*pfs.File, int, error) {
 			branch = parts[0]
 			commit = parts[1]
 		}
+		if timestamp != "" {
+			commit += "@" + timestamp
+		}
 	}
 	return ParseRepo(repo).NewCommit(branch, commit).NewFile(path), numFields, nil
 }
 
+// splitTime splits s, of the form "time[:path]", into the time and the
+// remainder of s.
+func splitTime(s string) (string, string, error) {
+	for i := len(s); i >= 0; i = strings.LastIndex(s[:i], ":") {
+		if _, err := ancestry.ParseTimestamp(s[:i]); err == nil {
+			return s[:i], s[i:], nil
+		}
+	}
+	_, err := ancestry.ParseTimestamp(s)
+	return "", "", err
+}
+
 // ParseCommit takes an argument of the form "repo[@branch-or-commit]" and
 // returns the corresponding *pfs.Commit.
 func ParseCommit(arg string) (*pfs.Commit, error) {
@@ -271,6 +300,23 @@ func ParseFiles(args []string) ([]*pfs.File, error) {
 	return results, nil
 }
 
+// ParseKeyValues parses flag arguments of the form "key=value", such as
+// --metadata, into a map from keys to values.
+func ParseKeyValues(args []string) (map[string]string, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]string)
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid argument \"%s\": expected key=value", arg)
+		}
+		result[parts[0]] = parts[1]
+	}
+	return result, nil
+}
+
 // ParseHistory parses a --history flag argument. Permissable values are "all"
 // "none", and integers greater than or equal to -1 (as strings).
 func ParseHistory(history string) (int64, error) {
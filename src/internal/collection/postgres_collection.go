@@ -236,23 +236,45 @@ func (c *postgresCollection) list(
 ) error {
 	query := fmt.Sprintf("select key, createdat, updatedat, proto from collections.%s", c.table)
 
+	var order, target string
+	if opts.Order != SortNone {
+		var err error
+		if order, err = orderToSQL(opts.Order); err != nil {
+			return err
+		} else if target, err = targetToSQL(opts.Target); err != nil {
+			return err
+		}
+	}
+
 	params := map[string]interface{}{}
-	if len(withFields) > 0 {
-		fields := []string{}
-		for k, v := range withFields {
-			fields = append(fields, fmt.Sprintf("%s = :%s", k, k))
-			params[k] = v
+	fields := []string{}
+	for k, v := range withFields {
+		fields = append(fields, fmt.Sprintf("%s = :%s", k, k))
+		params[k] = v
+	}
+	if opts.After != "" {
+		if opts.Order == SortNone {
+			return errors.Errorf("cannot list after a key without a sort order")
 		}
+		cmp := ">"
+		if opts.Order == SortDescend {
+			cmp = "<"
+		}
+		// Rows are compared with the row of the key by the sort target and
+		// then by key, which is the order they're returned in.
+		fields = append(fields, fmt.Sprintf("(%s, key) %s (select %s, key from collections.%s where key = :after)", target, cmp, target, c.table))
+		params["after"] = opts.After
+	}
+	if len(fields) > 0 {
 		query += " where " + strings.Join(fields, " and ")
 	}
 
 	if opts.Order != SortNone {
-		if order, err := orderToSQL(opts.Order); err != nil {
-			return err
-		} else if target, err := targetToSQL(opts.Target); err != nil {
-			return err
-		} else {
-			query += fmt.Sprintf(" order by %s %s", target, order)
+		// Rows with the same create or update time are ordered by key, so that
+		// the order is stable.
+		query += fmt.Sprintf(" order by %s %s", target, order)
+		if target != "key" {
+			query += fmt.Sprintf(", key %s", order)
 		}
 	}
 
@@ -206,6 +206,45 @@ func PostgresCollectionTests(suite *testing.T, newCollection func(context.Contex
 		})
 	})
 
+	suite.Run("ListAfter", func(subsuite *testing.T) {
+		subsuite.Parallel()
+		defaultRead, _ := initCollection(subsuite, newCollection)
+
+		collect := func(t *testing.T, opts *col.Options) []string {
+			keys := []string{}
+			testProto := &col.TestItem{}
+			require.NoError(t, defaultRead.List(testProto, opts, func(key string) error {
+				keys = append(keys, key)
+				return nil
+			}))
+			return keys
+		}
+
+		testAfter := func(t *testing.T, order col.SortOrder) {
+			t.Parallel()
+			// The rows all have the same created time, so they're ordered by key.
+			keys := collect(t, &col.Options{Target: col.SortByCreateRevision, Order: order})
+			require.Equal(t, defaultCollectionSize, len(keys))
+			after := collect(t, &col.Options{Target: col.SortByCreateRevision, Order: order, After: keys[3]})
+			require.Equal(t, keys[4:], after)
+		}
+
+		subsuite.Run("SortAscend", func(t *testing.T) {
+			testAfter(t, col.SortAscend)
+		})
+
+		subsuite.Run("SortDescend", func(t *testing.T) {
+			testAfter(t, col.SortDescend)
+		})
+
+		subsuite.Run("SortNone", func(t *testing.T) {
+			t.Parallel()
+			require.YesError(t, defaultRead.List(&col.TestItem{}, &col.Options{After: makeID(3)}, func(string) error {
+				return nil
+			}))
+		})
+	})
+
 	// TODO: postgres-specific collection tests:
 	// GetRevByIndex(index *Index, indexVal string, val proto.Message, opts *Options, f func(int64) error) error
 	// DeleteByIndex(index *Index, indexVal string) error
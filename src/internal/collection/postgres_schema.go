@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/jmoiron/sqlx"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -219,3 +220,49 @@ func SetupPostgresCollections(ctx context.Context, sqlTx *sqlx.Tx, collections .
 	}
 	return nil
 }
+
+// AddPostgresIndex adds idx, which must be one of the indexes of pgc, to the
+// table of the collection, which was set up without it. idx is set for the
+// existing rows, which are unmarshalled into the template of pgc.
+func AddPostgresIndex(ctx context.Context, sqlTx *sqlx.Tx, pgc PostgresCollection, idx *Index) error {
+	col := pgc.(*postgresCollection)
+	if err := col.validateIndex(idx); err != nil {
+		return err
+	}
+	name := indexFieldName(idx)
+	if _, err := sqlTx.ExecContext(ctx, fmt.Sprintf("alter table collections.%s add column %s text;", col.table, name)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if _, err := sqlTx.ExecContext(ctx, fmt.Sprintf("create index on collections.%s (%s);", col.table, name)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	var rows []model
+	if err := sqlTx.SelectContext(ctx, &rows, fmt.Sprintf("select key, proto from collections.%s;", col.table)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, row := range rows {
+		val := proto.Clone(col.template)
+		if err := proto.Unmarshal(row.Proto, val); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if _, err := sqlTx.ExecContext(ctx, fmt.Sprintf("update collections.%s set %s = $1 where key = $2;", col.table, name), idx.Extract(val), row.Key); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	// The notify trigger is passed the index fields, so that watches on the
+	// new index are notified.
+	indexFields := []string{"'key'"}
+	for _, idx := range col.indexes {
+		indexFields = append(indexFields, "'"+indexFieldName(idx)+"'")
+	}
+	notifyTrigger := fmt.Sprintf(`
+	drop trigger notify_trigger on collections.%s;
+	create trigger notify_trigger
+		after insert or update or delete on collections.%s
+		for each row execute procedure collections.notify_trigger_fn(%s);
+	`, col.table, col.table, strings.Join(indexFields, ", "))
+	if _, err := sqlTx.ExecContext(ctx, notifyTrigger); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
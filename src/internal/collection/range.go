@@ -32,12 +32,16 @@ type SortOrder = etcd.SortOrder
 type Options struct {
 	Target SortTarget
 	Order  SortOrder
+	// After restricts the iteration to the items after the item with this
+	// key in the sort order, which is used to paginate lists. It's only
+	// supported by postgres collections, and requires a sort order.
+	After string
 }
 
 // DefaultOptions are the default sort options when iterating through etcd
 // key/values.
 func DefaultOptions() *Options {
-	return &Options{SortByCreateRevision, SortDescend}
+	return &Options{Target: SortByCreateRevision, Order: SortDescend}
 }
 
 func listFuncs(opts *Options) (func(*mvccpb.KeyValue) etcd.OpOption, func(kv1 *mvccpb.KeyValue, kv2 *mvccpb.KeyValue) int) {
@@ -127,6 +127,18 @@ const (
 
 	// PutFileConcurrencyLimitEnvVar is the environment variable for the PutFile concurrency limit.
 	PutFileConcurrencyLimitEnvVar = "STORAGE_PUT_FILE_CONCURRENCY_LIMIT"
+
+	// CompressionEnvVar is the environment variable for the chunk compression algorithm.
+	CompressionEnvVar = "STORAGE_COMPRESSION"
+
+	// DiskCacheBytesEnvVar is the environment variable for the size of the persistent disk cache.
+	DiskCacheBytesEnvVar = "STORAGE_DISK_CACHE_BYTES"
+
+	// DiskCachePathEnvVar is the environment variable for the directory of the persistent disk cache.
+	DiskCachePathEnvVar = "STORAGE_DISK_CACHE_PATH"
+
+	// DiskCachePolicyEnvVar is the environment variable for the policy of the persistent disk cache.
+	DiskCachePolicyEnvVar = "STORAGE_DISK_CACHE_POLICY"
 )
 
 const (
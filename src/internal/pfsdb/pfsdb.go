@@ -2,6 +2,7 @@
 package pfsdb
 
 import (
+	"context"
 	"strings"
 
 	"github.com/gogo/protobuf/proto"
@@ -17,6 +18,8 @@ const (
 	reposCollectionName    = "repos"
 	branchesCollectionName = "branches"
 	commitsCollectionName  = "commits"
+	quotasCollectionName   = "quotas"
+	metadataCollectionName = "metadata"
 )
 
 var ReposTypeIndex = &col.Index{
@@ -33,7 +36,23 @@ var ReposNameIndex = &col.Index{
 	},
 }
 
-var reposIndexes = []*col.Index{ReposNameIndex, ReposTypeIndex}
+// ReposForkedFromIndex indexes repos by the key of the repo they were forked
+// from, or "" if they weren't forked.
+var ReposForkedFromIndex = &col.Index{
+	Name: "forked_from",
+	Extract: func(val proto.Message) string {
+		if forkedFrom := val.(*pfs.RepoInfo).ForkedFrom; forkedFrom != nil {
+			return RepoKey(forkedFrom)
+		}
+		return ""
+	},
+}
+
+var reposIndexes = []*col.Index{ReposNameIndex, ReposTypeIndex, ReposForkedFromIndex}
+
+// reposIndexesV0 are the indexes the repos collection was created with, see
+// AddReposForkedFromIndex.
+var reposIndexesV0 = []*col.Index{ReposNameIndex, ReposTypeIndex}
 
 func RepoKey(repo *pfs.Repo) string {
 	return repo.Name + "." + repo.Type
@@ -136,13 +155,159 @@ func Branches(db *sqlx.DB, listener col.PostgresListener) col.PostgresCollection
 	)
 }
 
+var QuotasRepoIndex = &col.Index{
+	Name: "repo",
+	Extract: func(val proto.Message) string {
+		return RepoKey(val.(*pfs.Quota).Repo)
+	},
+}
+
+var quotasIndexes = []*col.Index{QuotasRepoIndex}
+
+// QuotaKey returns the key of the quota on repo for principal, which is empty
+// for the quota that applies to all principals.
+func QuotaKey(repo *pfs.Repo, principal string) string {
+	return RepoKey(repo) + "/" + principal
+}
+
+// Quotas returns a collection of quotas
+func Quotas(db *sqlx.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		quotasCollectionName,
+		db,
+		listener,
+		&pfs.Quota{},
+		quotasIndexes,
+		func(key string) error {
+			return repoKeyCheck(strings.SplitN(key, "/", 2)[0])
+		},
+	)
+}
+
+// MetadataRepoIndex indexes metadata entries by the repo of their repo or
+// commit.
+var MetadataRepoIndex = &col.Index{
+	Name: "repo",
+	Extract: func(val proto.Message) string {
+		entry := val.(*pfs.MetadataEntry)
+		if entry.Commit != nil {
+			return RepoKey(entry.Commit.Branch.Repo)
+		}
+		return RepoKey(entry.Repo)
+	},
+}
+
+// MetadataObjectIndex indexes metadata entries by their repo or commit.
+var MetadataObjectIndex = &col.Index{
+	Name: "object",
+	Extract: func(val proto.Message) string {
+		return metadataObjectKey(val.(*pfs.MetadataEntry))
+	},
+}
+
+// MetadataPairIndex indexes metadata entries by their key/value pair, and
+// whether they belong to a repo or commit.
+var MetadataPairIndex = &col.Index{
+	Name: "pair",
+	Extract: func(val proto.Message) string {
+		entry := val.(*pfs.MetadataEntry)
+		if entry.Commit != nil {
+			return metadataPairKey(metadataCommitPrefix, entry.Key, entry.Value)
+		}
+		return metadataPairKey(metadataRepoPrefix, entry.Key, entry.Value)
+	},
+}
+
+var metadataIndexes = []*col.Index{MetadataRepoIndex, MetadataObjectIndex, MetadataPairIndex}
+
+const (
+	metadataRepoPrefix   = "repo"
+	metadataCommitPrefix = "commit"
+)
+
+// MetadataRepoKey returns the value of MetadataObjectIndex for the metadata
+// entries of repo.
+func MetadataRepoKey(repo *pfs.Repo) string {
+	return metadataRepoPrefix + "/" + RepoKey(repo)
+}
+
+// MetadataCommitKey returns the value of MetadataObjectIndex for the metadata
+// entries of commit.
+func MetadataCommitKey(commit *pfs.Commit) string {
+	return metadataCommitPrefix + "/" + CommitKey(commit)
+}
+
+// MetadataRepoPairKey returns the value of MetadataPairIndex for the metadata
+// entries of repos with the pair key=value.
+func MetadataRepoPairKey(key, value string) string {
+	return metadataPairKey(metadataRepoPrefix, key, value)
+}
+
+// MetadataCommitPairKey returns the value of MetadataPairIndex for the
+// metadata entries of commits with the pair key=value.
+func MetadataCommitPairKey(key, value string) string {
+	return metadataPairKey(metadataCommitPrefix, key, value)
+}
+
+func metadataPairKey(prefix, key, value string) string {
+	return prefix + "/" + key + "=" + value
+}
+
+// MetadataKey returns the key of a metadata entry.
+func MetadataKey(entry *pfs.MetadataEntry) string {
+	return metadataObjectKey(entry) + "/" + entry.Key
+}
+
+func metadataObjectKey(entry *pfs.MetadataEntry) string {
+	if entry.Commit != nil {
+		return MetadataCommitKey(entry.Commit)
+	}
+	return MetadataRepoKey(entry.Repo)
+}
+
+// Metadata returns a collection of metadata entries
+func Metadata(db *sqlx.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		metadataCollectionName,
+		db,
+		listener,
+		&pfs.MetadataEntry{},
+		metadataIndexes,
+		nil,
+	)
+}
+
 // AllCollections returns a list of all the PFS collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
 func AllCollections() []col.PostgresCollection {
 	return []col.PostgresCollection{
-		col.NewPostgresCollection(reposCollectionName, nil, nil, nil, reposIndexes, nil),
+		col.NewPostgresCollection(reposCollectionName, nil, nil, nil, reposIndexesV0, nil),
 		col.NewPostgresCollection(commitsCollectionName, nil, nil, nil, commitsIndexes, nil),
 		col.NewPostgresCollection(branchesCollectionName, nil, nil, nil, branchesIndexes, nil),
 	}
 }
+
+// AddReposForkedFromIndex adds ReposForkedFromIndex, which was added after
+// AllCollections, to the repos collection, for postgres-initialization
+// purposes.
+func AddReposForkedFromIndex(ctx context.Context, tx *sqlx.Tx) error {
+	repos := col.NewPostgresCollection(reposCollectionName, nil, nil, &pfs.RepoInfo{}, reposIndexes, nil)
+	return col.AddPostgresIndex(ctx, tx, repos, ReposForkedFromIndex)
+}
+
+// QuotaCollections returns a list of the PFS collections for quotas, which
+// were added after AllCollections, for postgres-initialization purposes.
+func QuotaCollections() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(quotasCollectionName, nil, nil, nil, quotasIndexes, nil),
+	}
+}
+
+// MetadataCollections returns a list of the PFS collections for metadata,
+// which were added after AllCollections, for postgres-initialization purposes.
+func MetadataCollections() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(metadataCollectionName, nil, nil, nil, metadataIndexes, nil),
+	}
+}
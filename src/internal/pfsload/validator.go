@@ -51,7 +51,7 @@ func NewValidator(client Client, spec *ValidatorSpec, random *rand.Rand) (Client
 // TODO: The performance of this is bad.
 func (v *Validator) RandomFile() (string, error) {
 	var files []string
-	if err := v.buffer.WalkAdditive(func(p, _ string, r io.Reader) error {
+	if err := v.buffer.WalkAdditive(func(p, _ string, _ map[string]string, r io.Reader) error {
 		files = append(files, p)
 		return nil
 	}); err != nil {
@@ -85,7 +85,7 @@ func (v *Validator) Validate(client Client, commit *pfs.Commit) (retErr error) {
 		}
 	}
 	var files []*file
-	if err := v.buffer.WalkAdditive(func(p, tag string, r io.Reader) error {
+	if err := v.buffer.WalkAdditive(func(p, tag string, _ map[string]string, r io.Reader) error {
 		buf := &bytes.Buffer{}
 		if _, err := io.Copy(buf, r); err != nil {
 			return err
@@ -153,7 +153,7 @@ func (vc *validatorClient) WithModifyFileClient(ctx context.Context, commit *pfs
 		for _, p := range vmfc.deletes {
 			vc.validator.buffer.Delete(p, fileset.DefaultFileTag)
 		}
-		return vmfc.buffer.WalkAdditive(func(p, tag string, r io.Reader) error {
+		return vmfc.buffer.WalkAdditive(func(p, tag string, _ map[string]string, r io.Reader) error {
 			w := vc.validator.buffer.Add(p, tag)
 			_, err := io.Copy(w, r)
 			return err
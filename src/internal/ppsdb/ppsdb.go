@@ -13,8 +13,10 @@ import (
 )
 
 const (
-	pipelinesCollectionName = "pipelines"
-	jobsCollectionName      = "jobs"
+	pipelinesCollectionName         = "pipelines"
+	jobsCollectionName              = "jobs"
+	webhooksCollectionName          = "webhooks"
+	webhookDeliveriesCollectionName = "webhook_deliveries"
 )
 
 var pipelinesIndexes = []*col.Index{}
@@ -74,6 +76,54 @@ func Jobs(db *sqlx.DB, listener col.PostgresListener) col.PostgresCollection {
 	)
 }
 
+// Webhooks returns a PostgresCollection of webhooks
+func Webhooks(db *sqlx.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		webhooksCollectionName,
+		db,
+		listener,
+		&pps.WebhookInfo{},
+		nil,
+		nil,
+	)
+}
+
+// WebhookDeliveriesWebhookIndex maps webhooks to their deliveries
+var WebhookDeliveriesWebhookIndex = &col.Index{
+	Name: "webhook",
+	Extract: func(val proto.Message) string {
+		return val.(*pps.WebhookDelivery).Webhook.Name
+	},
+}
+
+// WebhookDeliveriesStateIndex maps delivery states to the deliveries in them
+var WebhookDeliveriesStateIndex = &col.Index{
+	Name: "state",
+	Extract: func(val proto.Message) string {
+		return val.(*pps.WebhookDelivery).State.String()
+	},
+}
+
+var webhookDeliveriesIndexes = []*col.Index{WebhookDeliveriesWebhookIndex, WebhookDeliveriesStateIndex}
+
+// WebhookDeliveryKey returns the key of the delivery of the event with the
+// given ID to webhook.
+func WebhookDeliveryKey(webhook *pps.Webhook, id string) string {
+	return webhook.Name + "/" + id
+}
+
+// WebhookDeliveries returns a PostgresCollection of webhook deliveries
+func WebhookDeliveries(db *sqlx.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		webhookDeliveriesCollectionName,
+		db,
+		listener,
+		&pps.WebhookDelivery{},
+		webhookDeliveriesIndexes,
+		nil,
+	)
+}
+
 // AllCollections returns a list of all the PPS API collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
@@ -83,3 +133,12 @@ func AllCollections() []col.PostgresCollection {
 		col.NewPostgresCollection(jobsCollectionName, nil, nil, nil, jobsIndexes, nil),
 	}
 }
+
+// WebhookCollections returns a list of the PPS collections for webhooks,
+// which were added after AllCollections, for postgres-initialization purposes.
+func WebhookCollections() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(webhooksCollectionName, nil, nil, nil, nil, nil),
+		col.NewPostgresCollection(webhookDeliveriesCollectionName, nil, nil, nil, webhookDeliveriesIndexes, nil),
+	}
+}
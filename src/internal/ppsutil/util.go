@@ -255,13 +255,18 @@ func SetPipelineState(ctx context.Context, db *sqlx.DB, pipelinesCollection col.
 	return err
 }
 
-// JobInput fills in the commits for an Input
+// JobInput fills in the commits for an Input. PFS inputs which are pinned to a
+// commit read it, other inputs read the commits in the output commit's commit
+// set.
 func JobInput(pipelineInfo *pps.PipelineInfo, outputCommit *pfs.Commit) *pps.Input {
 	commitsetID := outputCommit.ID
 	jobInput := proto.Clone(pipelineInfo.Details.Input).(*pps.Input)
 	pps.VisitInput(jobInput, func(input *pps.Input) error {
 		if input.Pfs != nil {
 			input.Pfs.Commit = commitsetID
+			if input.Pfs.PinnedCommit != "" {
+				input.Pfs.Commit = input.Pfs.PinnedCommit
+			}
 		}
 		if input.Cron != nil {
 			input.Cron.Commit = commitsetID
@@ -106,6 +106,35 @@ type StorageConfiguration struct {
 	StorageFileSetsMaxOpen         int    `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
 	StorageDiskCacheSize           int    `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
 	StorageMemoryCacheSize         int    `env:"STORAGE_MEMORY_CACHE_SIZE,default=100"`
+	// StorageDiskCacheBytes bounds the size, in bytes, of the persistent disk
+	// cache for chunk objects. The disk cache bounded by the number of entries
+	// (StorageDiskCacheSize) is used instead if it is 0.
+	StorageDiskCacheBytes int64 `env:"STORAGE_DISK_CACHE_BYTES,default=0"`
+	// StorageDiskCachePath is the directory of the persistent disk cache.
+	StorageDiskCachePath string `env:"STORAGE_DISK_CACHE_PATH,default=/tmp/pfs-disk-cache"`
+	// StorageDiskCachePolicy is the admission and eviction policy of the
+	// persistent disk cache ("lru" or "tinylfu").
+	StorageDiskCachePolicy string `env:"STORAGE_DISK_CACHE_POLICY,default=lru"`
+	// StorageDiskCacheHostPath is a host path mounted at StorageDiskCachePath
+	// in the storage sidecars of pipeline workers, so that the persistent disk
+	// cache is shared by the workers on a node and survives pod restarts.
+	StorageDiskCacheHostPath string `env:"STORAGE_DISK_CACHE_HOST_PATH"`
+	// StorageCompression is the compression algorithm used for chunks (e.g.
+	// "zstd:3"), see chunk.ParseCompression. Chunks are not compressed unless
+	// it is set.
+	StorageCompression string `env:"STORAGE_COMPRESSION"`
+	// StorageCompressionRepoOverrides overrides StorageCompression for the
+	// chunks written to specific repos, as a comma separated list of
+	// repo=compression pairs (e.g. "images=none,logs=zstd:9").
+	StorageCompressionRepoOverrides string `env:"STORAGE_COMPRESSION_REPO_OVERRIDES"`
+	// StorageScrubRate is the rate, in bytes per second, at which the chunk
+	// scrubber reads objects from object storage. The scrubber is disabled if
+	// it is 0.
+	StorageScrubRate int64 `env:"STORAGE_SCRUB_RATE,default=0"`
+	// StorageScrubSecondaryURL is the URL (e.g. "s3://replica-bucket") of a
+	// secondary object store holding replicas of the chunk objects, which the
+	// scrubber uses to repair broken objects.
+	StorageScrubSecondaryURL string `env:"STORAGE_SCRUB_SECONDARY_URL"`
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
@@ -27,16 +27,25 @@ type CompressionAlgo int32
 const (
 	CompressionAlgo_NONE            CompressionAlgo = 0
 	CompressionAlgo_GZIP_BEST_SPEED CompressionAlgo = 1
+	CompressionAlgo_ZSTD            CompressionAlgo = 2
+	CompressionAlgo_LZ4             CompressionAlgo = 3
+	CompressionAlgo_SNAPPY          CompressionAlgo = 4
 )
 
 var CompressionAlgo_name = map[int32]string{
 	0: "NONE",
 	1: "GZIP_BEST_SPEED",
+	2: "ZSTD",
+	3: "LZ4",
+	4: "SNAPPY",
 }
 
 var CompressionAlgo_value = map[string]int32{
 	"NONE":            0,
 	"GZIP_BEST_SPEED": 1,
+	"ZSTD":            2,
+	"LZ4":             3,
+	"SNAPPY":          4,
 }
 
 func (x CompressionAlgo) String() string {
@@ -52,16 +61,19 @@ type EncryptionAlgo int32
 const (
 	EncryptionAlgo_ENCRYPTION_ALGO_UNKNOWN EncryptionAlgo = 0
 	EncryptionAlgo_CHACHA20                EncryptionAlgo = 1
+	EncryptionAlgo_AES_256_GCM             EncryptionAlgo = 2
 )
 
 var EncryptionAlgo_name = map[int32]string{
 	0: "ENCRYPTION_ALGO_UNKNOWN",
 	1: "CHACHA20",
+	2: "AES_256_GCM",
 }
 
 var EncryptionAlgo_value = map[string]int32{
 	"ENCRYPTION_ALGO_UNKNOWN": 0,
 	"CHACHA20":                1,
+	"AES_256_GCM":             2,
 }
 
 func (x EncryptionAlgo) String() string {
@@ -148,15 +160,18 @@ func (m *DataRef) GetSizeBytes() int64 {
 }
 
 type Ref struct {
-	Id                   []byte          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SizeBytes            int64           `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	Edge                 bool            `protobuf:"varint,3,opt,name=edge,proto3" json:"edge,omitempty"`
-	Dek                  []byte          `protobuf:"bytes,4,opt,name=dek,proto3" json:"dek,omitempty"`
-	EncryptionAlgo       EncryptionAlgo  `protobuf:"varint,5,opt,name=encryption_algo,json=encryptionAlgo,proto3,enum=chunk.EncryptionAlgo" json:"encryption_algo,omitempty"`
-	CompressionAlgo      CompressionAlgo `protobuf:"varint,6,opt,name=compression_algo,json=compressionAlgo,proto3,enum=chunk.CompressionAlgo" json:"compression_algo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Id              []byte          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SizeBytes       int64           `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Edge            bool            `protobuf:"varint,3,opt,name=edge,proto3" json:"edge,omitempty"`
+	Dek             []byte          `protobuf:"bytes,4,opt,name=dek,proto3" json:"dek,omitempty"`
+	EncryptionAlgo  EncryptionAlgo  `protobuf:"varint,5,opt,name=encryption_algo,json=encryptionAlgo,proto3,enum=chunk.EncryptionAlgo" json:"encryption_algo,omitempty"`
+	CompressionAlgo CompressionAlgo `protobuf:"varint,6,opt,name=compression_algo,json=compressionAlgo,proto3,enum=chunk.CompressionAlgo" json:"compression_algo,omitempty"`
+	// The version of the master key that wraps the dek, or 0 if the dek is not
+	// wrapped.
+	KeyVersion           uint64   `protobuf:"varint,7,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Ref) Reset()         { *m = Ref{} }
@@ -234,6 +249,13 @@ func (m *Ref) GetCompressionAlgo() CompressionAlgo {
 	return CompressionAlgo_NONE
 }
 
+func (m *Ref) GetKeyVersion() uint64 {
+	if m != nil {
+		return m.KeyVersion
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("chunk.CompressionAlgo", CompressionAlgo_name, CompressionAlgo_value)
 	proto.RegisterEnum("chunk.EncryptionAlgo", EncryptionAlgo_name, EncryptionAlgo_value)
@@ -246,33 +268,36 @@ func init() {
 }
 
 var fileDescriptor_4b743b4a788792d7 = []byte{
-	// 405 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x5d, 0x8b, 0xd3, 0x40,
-	0x14, 0xdd, 0x69, 0xba, 0x6b, 0xbd, 0x1b, 0x9a, 0x30, 0xa2, 0x06, 0xd4, 0x12, 0xfb, 0x14, 0xf6,
-	0xa1, 0x59, 0xe2, 0xa3, 0x22, 0xa4, 0xd9, 0xb0, 0xbb, 0x2a, 0x49, 0x99, 0x5d, 0x11, 0xf7, 0x25,
-	0xa4, 0xc9, 0xe4, 0x83, 0xed, 0x66, 0xc2, 0xcc, 0xac, 0x50, 0xc1, 0xff, 0xe7, 0xa3, 0x7f, 0x40,
-	0x90, 0xfe, 0x12, 0xc9, 0xb4, 0x54, 0x5b, 0xf6, 0x65, 0x38, 0xf7, 0xdc, 0x73, 0xcf, 0xb9, 0x03,
-	0x17, 0xc6, 0x75, 0x23, 0x29, 0x6f, 0xd2, 0x85, 0x2b, 0x24, 0xe3, 0x69, 0x49, 0xdd, 0xac, 0xba,
-	0x6f, 0x6e, 0xd7, 0xef, 0xa4, 0xe5, 0x4c, 0x32, 0x7c, 0xa8, 0x8a, 0xf1, 0x0f, 0x78, 0x74, 0x96,
-	0xca, 0x94, 0xd0, 0x02, 0xbf, 0x04, 0x8d, 0xd3, 0xc2, 0x42, 0x36, 0x72, 0x8e, 0x3d, 0x98, 0xac,
-	0xc5, 0x84, 0x16, 0xa4, 0xa3, 0x31, 0x86, 0x7e, 0x95, 0x8a, 0xca, 0xea, 0xd9, 0xc8, 0xd1, 0x89,
-	0xc2, 0xf8, 0x35, 0xe8, 0xac, 0x28, 0x04, 0x95, 0xc9, 0x7c, 0x29, 0xa9, 0xb0, 0x34, 0x1b, 0x39,
-	0x1a, 0x39, 0x5e, 0x73, 0xd3, 0x8e, 0xc2, 0xaf, 0x00, 0x44, 0xfd, 0x9d, 0x6e, 0x04, 0x7d, 0x25,
-	0x78, 0xdc, 0x31, 0xaa, 0x3d, 0xfe, 0x8d, 0x40, 0xeb, 0xb2, 0x87, 0xd0, 0xab, 0x73, 0x15, 0xad,
-	0x93, 0x5e, 0x9d, 0xef, 0x8d, 0xf5, 0xf6, 0xc6, 0xba, 0x65, 0x68, 0x5e, 0x52, 0x15, 0x38, 0x20,
-	0x0a, 0x63, 0x13, 0xb4, 0x9c, 0xde, 0xaa, 0x08, 0x9d, 0x74, 0x10, 0xbf, 0x07, 0x83, 0x36, 0x19,
-	0x5f, 0xb6, 0xb2, 0x66, 0x4d, 0x92, 0x2e, 0x4a, 0x66, 0x1d, 0xda, 0xc8, 0x19, 0x7a, 0x4f, 0x37,
-	0x9f, 0x0b, 0xb7, 0x5d, 0x7f, 0x51, 0x32, 0x32, 0xa4, 0x3b, 0x35, 0xf6, 0xc1, 0xcc, 0xd8, 0x5d,
-	0xcb, 0xa9, 0x10, 0x5b, 0x83, 0x23, 0x65, 0xf0, 0x6c, 0x63, 0x10, 0xfc, 0x6b, 0x2b, 0x07, 0x23,
-	0xdb, 0x25, 0x4e, 0x4e, 0xc1, 0xd8, 0xd3, 0xe0, 0x01, 0xf4, 0xa3, 0x38, 0x0a, 0xcd, 0x03, 0xfc,
-	0x04, 0x8c, 0xf3, 0x9b, 0xcb, 0x59, 0x32, 0x0d, 0xaf, 0xae, 0x93, 0xab, 0x59, 0x18, 0x9e, 0x99,
-	0xe8, 0xe4, 0x2d, 0x0c, 0x77, 0xd7, 0xc2, 0x2f, 0xe0, 0x79, 0x18, 0x05, 0xe4, 0xeb, 0xec, 0xfa,
-	0x32, 0x8e, 0x12, 0xff, 0xd3, 0x79, 0x9c, 0x7c, 0x8e, 0x3e, 0x46, 0xf1, 0x97, 0xc8, 0x3c, 0xc0,
-	0x3a, 0x0c, 0x82, 0x0b, 0x3f, 0xb8, 0xf0, 0xbd, 0x53, 0x13, 0x4d, 0x3f, 0xfc, 0x5c, 0x8d, 0xd0,
-	0xaf, 0xd5, 0x08, 0xfd, 0x59, 0x8d, 0xd0, 0xcd, 0xbb, 0xb2, 0x96, 0xd5, 0xfd, 0x7c, 0x92, 0xb1,
-	0x3b, 0xb7, 0x4d, 0xb3, 0x6a, 0x99, 0x53, 0xfe, 0x3f, 0xfa, 0xe6, 0xb9, 0x82, 0x67, 0xee, 0xc3,
-	0xc7, 0x32, 0x3f, 0x52, 0x77, 0xf2, 0xe6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xc8, 0xba,
-	0x42, 0x4d, 0x02, 0x00, 0x00,
+	// 461 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x5f, 0x8b, 0xd3, 0x4e,
+	0x14, 0xdd, 0x49, 0xba, 0x6d, 0x7f, 0xb7, 0xa5, 0x1d, 0xe6, 0x87, 0x5a, 0x50, 0x6b, 0xec, 0x53,
+	0xd8, 0x87, 0x46, 0xe2, 0x9f, 0x27, 0x11, 0xd2, 0x34, 0x74, 0x57, 0x6b, 0x12, 0x26, 0x55, 0xd9,
+	0xbe, 0x84, 0x34, 0x99, 0xfc, 0xa1, 0xdd, 0xa4, 0x24, 0xd9, 0x85, 0x08, 0x7e, 0x3f, 0x1f, 0xfd,
+	0x08, 0xd2, 0x0f, 0x22, 0x92, 0x69, 0x59, 0x6d, 0xf1, 0x65, 0x38, 0xf7, 0x9c, 0x7b, 0xcf, 0x99,
+	0x0b, 0x17, 0x46, 0x49, 0x5a, 0xb2, 0x3c, 0xf5, 0x36, 0x4a, 0x51, 0x66, 0xb9, 0x17, 0x31, 0xc5,
+	0x8f, 0x6f, 0xd3, 0xf5, 0xfe, 0x1d, 0x6f, 0xf3, 0xac, 0xcc, 0xc8, 0x39, 0x2f, 0x46, 0xdf, 0xa0,
+	0x35, 0xf5, 0x4a, 0x8f, 0xb2, 0x90, 0x3c, 0x01, 0x31, 0x67, 0xe1, 0x00, 0x49, 0x48, 0xee, 0xa8,
+	0x30, 0xde, 0x37, 0x53, 0x16, 0xd2, 0x9a, 0x26, 0x04, 0x1a, 0xb1, 0x57, 0xc4, 0x03, 0x41, 0x42,
+	0x72, 0x97, 0x72, 0x4c, 0x9e, 0x43, 0x37, 0x0b, 0xc3, 0x82, 0x95, 0xee, 0xaa, 0x2a, 0x59, 0x31,
+	0x10, 0x25, 0x24, 0x8b, 0xb4, 0xb3, 0xe7, 0x26, 0x35, 0x45, 0x9e, 0x02, 0x14, 0xc9, 0x57, 0x76,
+	0x68, 0x68, 0xf0, 0x86, 0xff, 0x6a, 0x86, 0xcb, 0xa3, 0x5f, 0x08, 0xc4, 0x3a, 0xbb, 0x07, 0x42,
+	0x12, 0xf0, 0xe8, 0x2e, 0x15, 0x92, 0xe0, 0x64, 0x4c, 0x38, 0x19, 0xab, 0x3f, 0xc3, 0x82, 0x88,
+	0xf1, 0xc0, 0x36, 0xe5, 0x98, 0x60, 0x10, 0x03, 0xb6, 0xe6, 0x11, 0x5d, 0x5a, 0x43, 0xf2, 0x0e,
+	0xfa, 0x2c, 0xf5, 0xf3, 0x6a, 0x5b, 0x26, 0x59, 0xea, 0x7a, 0x9b, 0x28, 0x1b, 0x9c, 0x4b, 0x48,
+	0xee, 0xa9, 0x0f, 0x0e, 0xcb, 0x19, 0xf7, 0xaa, 0xb6, 0x89, 0x32, 0xda, 0x63, 0x47, 0x35, 0xd1,
+	0x00, 0xfb, 0xd9, 0xcd, 0x36, 0x67, 0x45, 0x71, 0x6f, 0xd0, 0xe4, 0x06, 0x0f, 0x0f, 0x06, 0xfa,
+	0x1f, 0x99, 0x3b, 0xf4, 0xfd, 0x63, 0x82, 0x3c, 0x83, 0xce, 0x9a, 0x55, 0xee, 0x1d, 0xcb, 0x6b,
+	0x6a, 0xd0, 0x92, 0x90, 0xdc, 0xa0, 0xb0, 0x66, 0xd5, 0xe7, 0x3d, 0x73, 0x61, 0x41, 0xff, 0xc4,
+	0x84, 0xb4, 0xa1, 0x61, 0x5a, 0xa6, 0x81, 0xcf, 0xc8, 0xff, 0xd0, 0x9f, 0x2d, 0xaf, 0x6c, 0x77,
+	0x62, 0x38, 0x0b, 0xd7, 0xb1, 0x0d, 0x63, 0x8a, 0x51, 0x2d, 0x2f, 0x9d, 0xc5, 0x14, 0x0b, 0xa4,
+	0x05, 0xe2, 0x7c, 0xf9, 0x0a, 0x8b, 0x04, 0xa0, 0xe9, 0x98, 0x9a, 0x6d, 0x5f, 0xe3, 0xc6, 0xc5,
+	0x1c, 0x7a, 0xc7, 0x6b, 0x91, 0xc7, 0xf0, 0xc8, 0x30, 0x75, 0x7a, 0x6d, 0x2f, 0xae, 0x2c, 0xd3,
+	0xd5, 0xe6, 0x33, 0xcb, 0xfd, 0x64, 0x7e, 0x30, 0xad, 0x2f, 0x26, 0x3e, 0x23, 0x5d, 0x68, 0xeb,
+	0x97, 0x9a, 0x7e, 0xa9, 0xa9, 0x2f, 0x30, 0x22, 0x7d, 0xe8, 0x68, 0x86, 0xe3, 0xaa, 0xaf, 0xdf,
+	0xb8, 0x33, 0xfd, 0x23, 0x16, 0x26, 0xef, 0xbf, 0xef, 0x86, 0xe8, 0xc7, 0x6e, 0x88, 0x7e, 0xee,
+	0x86, 0x68, 0xf9, 0x36, 0x4a, 0xca, 0xf8, 0x76, 0x35, 0xf6, 0xb3, 0x1b, 0x65, 0xeb, 0xf9, 0x71,
+	0x15, 0xb0, 0xfc, 0x6f, 0x74, 0xa7, 0x2a, 0x45, 0xee, 0x2b, 0xff, 0xbe, 0xbe, 0x55, 0x93, 0x1f,
+	0xde, 0xcb, 0xdf, 0x03, 0x00, 0xff, 0xbd, 0x74, 0x21, 0x9e, 0x02, 0x00, 0x00,
 }
 
 func (m *DataRef) Marshal() (dAtA []byte, err error) {
@@ -355,6 +380,11 @@ func (m *Ref) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.KeyVersion != 0 {
+		i = encodeVarintChunk(dAtA, i, uint64(m.KeyVersion))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.CompressionAlgo != 0 {
 		i = encodeVarintChunk(dAtA, i, uint64(m.CompressionAlgo))
 		i--
@@ -460,6 +490,9 @@ func (m *Ref) Size() (n int) {
 	if m.CompressionAlgo != 0 {
 		n += 1 + sovChunk(uint64(m.CompressionAlgo))
 	}
+	if m.KeyVersion != 0 {
+		n += 1 + sovChunk(uint64(m.KeyVersion))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -805,6 +838,25 @@ func (m *Ref) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyVersion", wireType)
+			}
+			m.KeyVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChunk(dAtA[iNdEx:])
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
 	"strconv"
 	"testing"
@@ -98,6 +99,176 @@ func TestCopy(t *testing.T) {
 	}
 }
 
+var compressionAlgos = []struct {
+	algo  CompressionAlgo
+	level int
+}{
+	{CompressionAlgo_NONE, 0},
+	{CompressionAlgo_GZIP_BEST_SPEED, 0},
+	{CompressionAlgo_ZSTD, 1},
+	{CompressionAlgo_ZSTD, 3},
+	{CompressionAlgo_ZSTD, 19},
+	{CompressionAlgo_LZ4, 0},
+	{CompressionAlgo_SNAPPY, 0},
+}
+
+// textData generates n bytes of compressible, CSV-like text.
+func textData(random *rand.Rand, n int) []byte {
+	buf := &bytes.Buffer{}
+	for i := 0; buf.Len() < n; i++ {
+		fmt.Fprintf(buf, "%d,%s,%d\n", i, randutil.Bytes(random, 4), random.Intn(1000))
+	}
+	return buf.Bytes()[:n]
+}
+
+func TestCompression(t *testing.T) {
+	seed := time.Now().UTC().UnixNano()
+	msg := fmt.Sprint("seed: ", strconv.FormatInt(seed, 10))
+	random := rand.New(rand.NewSource(seed))
+	text := textData(random, units.MB)
+	incompressible := make([]byte, units.KB)
+	random.Read(incompressible)
+	roundTrip := func(t *testing.T, algo CompressionAlgo, level int, src []byte) CompressionAlgo {
+		dst := make([]byte, len(src))
+		algo, n, err := compress(algo, level, dst, src)
+		require.NoError(t, err, msg)
+		if algo != CompressionAlgo_NONE {
+			require.True(t, n < len(src), msg)
+		}
+		r, err := decompress(algo, bytes.NewReader(dst[:n]))
+		require.NoError(t, err, msg)
+		actual, err := ioutil.ReadAll(r)
+		require.NoError(t, err, msg)
+		require.True(t, bytes.Equal(src, actual), msg)
+		return algo
+	}
+	for _, c := range compressionAlgos {
+		t.Run(fmt.Sprintf("%v-%d", c.algo, c.level), func(t *testing.T) {
+			require.Equal(t, c.algo, roundTrip(t, c.algo, c.level, text), msg)
+			// Incompressible data is stored uncompressed.
+			require.Equal(t, CompressionAlgo_NONE, roundTrip(t, c.algo, c.level, incompressible), msg)
+		})
+	}
+}
+
+func TestWriterCompression(t *testing.T) {
+	seed := time.Now().UTC().UnixNano()
+	msg := fmt.Sprint("seed: ", strconv.FormatInt(seed, 10))
+	random := rand.New(rand.NewSource(seed))
+	data := textData(random, units.MB)
+	for _, c := range []struct {
+		name string
+		opts []StorageOption
+		algo CompressionAlgo
+	}{
+		// Chunks are not compressed by default.
+		{"Default", nil, CompressionAlgo_NONE},
+		{"Gzip", []StorageOption{WithCompression(CompressionAlgo_GZIP_BEST_SPEED)}, CompressionAlgo_GZIP_BEST_SPEED},
+		{"None", []StorageOption{WithCompression(CompressionAlgo_NONE)}, CompressionAlgo_NONE},
+		{"Zstd", []StorageOption{WithCompression(CompressionAlgo_ZSTD), WithCompressionLevel(3)}, CompressionAlgo_ZSTD},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			db := testutil.NewTestDB(t)
+			tr := track.NewTestTracker(t, db)
+			_, chunks := NewTestStorage(t, db, tr, c.opts...)
+			var refs []*Ref
+			cb := func(annotations []*Annotation) error {
+				for _, a := range annotations {
+					if a.NextDataRef != nil {
+						refs = append(refs, a.NextDataRef.Ref)
+					}
+				}
+				return nil
+			}
+			w := chunks.NewWriter(context.Background(), uuid.NewWithoutDashes(), cb)
+			require.NoError(t, w.Annotate(&Annotation{Data: &testAnnotation{}}), msg)
+			_, err := w.Write(data)
+			require.NoError(t, err, msg)
+			require.NoError(t, w.Close(), msg)
+			require.True(t, len(refs) > 0, msg)
+			var size int64
+			for _, ref := range refs {
+				require.Equal(t, c.algo, ref.CompressionAlgo, msg)
+				size += ref.SizeBytes
+			}
+			// SizeBytes is the uncompressed size of the chunks.
+			require.Equal(t, int64(len(data)), size, msg)
+		})
+	}
+}
+
+func TestParseCompression(t *testing.T) {
+	algo, level, err := ParseCompression("zstd:9")
+	require.NoError(t, err)
+	require.Equal(t, CompressionAlgo_ZSTD, algo)
+	require.Equal(t, 9, level)
+	algo, level, err = ParseCompression("gzip-best-speed")
+	require.NoError(t, err)
+	require.Equal(t, CompressionAlgo_GZIP_BEST_SPEED, algo)
+	require.Equal(t, 0, level)
+	_, _, err = ParseCompression("brotli")
+	require.YesError(t, err)
+	_, _, err = ParseCompression("zstd:fast")
+	require.YesError(t, err)
+}
+
+func TestRangeDataRefs(t *testing.T) {
+	ref := &Ref{}
+	dataRefs := []*DataRef{
+		{Ref: ref, OffsetBytes: 0, SizeBytes: 10},
+		{Ref: ref, OffsetBytes: 10, SizeBytes: 10},
+		{Ref: ref, OffsetBytes: 5, SizeBytes: 10},
+	}
+	type span struct{ offset, size int64 }
+	spans := func(dataRefs []*DataRef) []span {
+		var result []span
+		for _, dataRef := range dataRefs {
+			result = append(result, span{dataRef.OffsetBytes, dataRef.SizeBytes})
+		}
+		return result
+	}
+	require.Equal(t, []span{{0, 10}, {10, 10}, {5, 10}}, spans(RangeDataRefs(dataRefs, 0, 0)))
+	require.Equal(t, []span{{5, 5}, {10, 10}, {5, 10}}, spans(RangeDataRefs(dataRefs, 5, 0)))
+	require.Equal(t, []span{{13, 4}}, spans(RangeDataRefs(dataRefs, 13, 4)))
+	require.Equal(t, []span{{18, 2}, {5, 3}}, spans(RangeDataRefs(dataRefs, 18, 5)))
+	require.Equal(t, []span{{5, 10}}, spans(RangeDataRefs(dataRefs, 20, 100)))
+	require.Equal(t, 0, len(RangeDataRefs(dataRefs, 30, 0)))
+}
+
+// BenchmarkCompression compares the throughput and compression ratio of the
+// compression algorithms on random and text data of the sizes used by the
+// tests.
+func BenchmarkCompression(b *testing.B) {
+	seed := time.Now().UTC().UnixNano()
+	random := rand.New(rand.NewSource(seed))
+	for _, test := range tests {
+		for _, fixture := range []struct {
+			name string
+			data []byte
+		}{
+			{"Random", randutil.Bytes(random, test.n)},
+			{"Text", textData(random, test.n)},
+		} {
+			data := fixture.data
+			for _, c := range compressionAlgos {
+				b.Run(fmt.Sprintf("%v/%v-%d/%v", fixture.name, c.algo, c.level, units.HumanSize(float64(test.n))), func(b *testing.B) {
+					dst := make([]byte, len(data))
+					b.SetBytes(int64(len(data)))
+					b.ResetTimer()
+					var n int
+					for i := 0; i < b.N; i++ {
+						var err error
+						_, n, err = compress(c.algo, c.level, dst, data)
+						require.NoError(b, err)
+					}
+					b.StopTimer()
+					b.ReportMetric(float64(len(data))/float64(n), "ratio")
+				})
+			}
+		}
+	}
+}
+
 func BenchmarkWriter(b *testing.B) {
 	_, chunks := newTestStorage(b)
 	seed := time.Now().UTC().UnixNano()
@@ -0,0 +1,261 @@
+package chunk
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+const (
+	masterKeySize      = 32
+	keyRefreshInterval = time.Minute
+)
+
+// KeyRing provides the versions of a named master key in a KeyStore.
+// Master keys wrap the data encryption keys stored in chunk references.
+type KeyRing struct {
+	store KeyStore
+	name  string
+
+	mu        sync.Mutex
+	keys      map[uint64][]byte
+	latest    uint64
+	refreshed time.Time
+}
+
+// NewKeyRing returns a KeyRing for the master key with name in store.
+func NewKeyRing(store KeyStore, name string) *KeyRing {
+	return &KeyRing{
+		store: store,
+		name:  name,
+		keys:  make(map[uint64][]byte),
+	}
+}
+
+// Latest returns the latest version of the master key, creating the first
+// version if the key does not exist.
+// The latest version is cached for a short period, so a rotation may not be
+// observed immediately.
+func (kr *KeyRing) Latest(ctx context.Context) (uint64, []byte, error) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	if kr.latest == 0 || time.Since(kr.refreshed) > keyRefreshInterval {
+		if err := kr.refresh(ctx); err != nil {
+			return 0, nil, err
+		}
+	}
+	return kr.latest, kr.keys[kr.latest], nil
+}
+
+func (kr *KeyRing) refresh(ctx context.Context) error {
+	version, err := kr.store.LatestVersion(ctx, kr.name)
+	if errors.Is(err, sql.ErrNoRows) {
+		key, keyErr := newMasterKey()
+		if keyErr != nil {
+			return keyErr
+		}
+		// Another process may create the key concurrently, so the error is
+		// ignored and the latest version is read back.
+		if createErr := kr.store.Create(ctx, kr.name, key); createErr == nil {
+			version, err = 1, nil
+		} else {
+			version, err = kr.store.LatestVersion(ctx, kr.name)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := kr.get(ctx, version); err != nil {
+		return err
+	}
+	kr.latest = version
+	kr.refreshed = time.Now()
+	return nil
+}
+
+// Get returns a version of the master key.
+func (kr *KeyRing) Get(ctx context.Context, version uint64) ([]byte, error) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	return kr.get(ctx, version)
+}
+
+func (kr *KeyRing) get(ctx context.Context, version uint64) ([]byte, error) {
+	if key, ok := kr.keys[version]; ok {
+		return key, nil
+	}
+	key, err := kr.store.GetVersion(ctx, kr.name, version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting version %d of key %q", version, kr.name)
+	}
+	kr.keys[version] = key
+	return key, nil
+}
+
+// Rotate creates a new version of the master key, and returns its version.
+// New chunk references are wrapped with the new version.
+func (kr *KeyRing) Rotate(ctx context.Context) (uint64, error) {
+	key, err := newMasterKey()
+	if err != nil {
+		return 0, err
+	}
+	version, err := kr.store.Rotate(ctx, kr.name, key)
+	if err != nil {
+		return 0, err
+	}
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	kr.keys[version] = key
+	if version > kr.latest {
+		kr.latest = version
+		kr.refreshed = time.Now()
+	}
+	return version, nil
+}
+
+// Rewrap returns ref with its data encryption key wrapped by the latest
+// version of the master key.
+// ref is returned unchanged if it is already wrapped by the latest version.
+func (kr *KeyRing) Rewrap(ctx context.Context, ref *Ref) (*Ref, error) {
+	version, key, err := kr.Latest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ref.KeyVersion == version {
+		return ref, nil
+	}
+	dek, err := kr.unwrap(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := wrapKey(key, dek)
+	if err != nil {
+		return nil, err
+	}
+	return &Ref{
+		Id:              ref.Id,
+		SizeBytes:       ref.SizeBytes,
+		Edge:            ref.Edge,
+		Dek:             wrapped,
+		EncryptionAlgo:  ref.EncryptionAlgo,
+		CompressionAlgo: ref.CompressionAlgo,
+		KeyVersion:      version,
+	}, nil
+}
+
+// unwrap returns the data encryption key for ref.
+// A nil KeyRing can only unwrap references with unwrapped keys.
+func (kr *KeyRing) unwrap(ctx context.Context, ref *Ref) ([]byte, error) {
+	if ref.KeyVersion == 0 {
+		return ref.Dek, nil
+	}
+	if kr == nil {
+		return nil, errors.Errorf("no master key to unwrap chunk key with version %d", ref.KeyVersion)
+	}
+	key, err := kr.Get(ctx, ref.KeyVersion)
+	if err != nil {
+		return nil, err
+	}
+	return unwrapKey(key, ref.Dek)
+}
+
+// RewrapDataRef returns dataRef with its chunk reference rewrapped by keys.
+// dataRef is returned unchanged if its chunk reference is already wrapped by
+// the latest version of the master key, or keys is nil.
+func RewrapDataRef(ctx context.Context, keys *KeyRing, dataRef *DataRef) (*DataRef, error) {
+	if keys == nil {
+		return dataRef, nil
+	}
+	ref, err := keys.Rewrap(ctx, dataRef.Ref)
+	if err != nil {
+		return nil, err
+	}
+	if ref == dataRef.Ref {
+		return dataRef, nil
+	}
+	return &DataRef{
+		Ref:         ref,
+		Hash:        dataRef.Hash,
+		OffsetBytes: dataRef.OffsetBytes,
+		SizeBytes:   dataRef.SizeBytes,
+	}, nil
+}
+
+func newMasterKey() ([]byte, error) {
+	key := make([]byte, masterKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return key, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	return aead, errors.EnsureStack(err)
+}
+
+// wrapKey encrypts dek with key using AES-256-GCM.
+// The nonce is derived from key and dek so that wrapping is deterministic, and
+// is prepended to the output.
+func wrapKey(key, dek []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := append([]byte{}, Hash(append(append([]byte{}, key...), dek...))[:aead.NonceSize()]...)
+	return aead.Seal(nonce, nonce, dek, nil), nil
+}
+
+// unwrapKey decrypts a data encryption key wrapped by wrapKey.
+func unwrapKey(key, wrapped []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.Errorf("wrapped key is too short")
+	}
+	nonce, ctext := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dek, err := aead.Open(nil, nonce, ctext, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unwrapping chunk key")
+	}
+	return dek, nil
+}
+
+// seal encrypts and authenticates ptext with dek using AES-256-GCM, appending
+// the output to dst.
+// A zero nonce is used, which is safe because every data encryption key is
+// derived from the content it encrypts.
+func seal(dek, dst, ptext []byte) ([]byte, error) {
+	aead, err := newGCM(dek)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	return aead.Seal(dst, nonce, ptext, nil), nil
+}
+
+// open decrypts and authenticates ctext sealed by seal.
+func open(dek, ctext []byte) ([]byte, error) {
+	aead, err := newGCM(dek)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	ptext, err := aead.Open(nil, nonce, ctext, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decrypting chunk")
+	}
+	return ptext, nil
+}
@@ -0,0 +1,123 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"math/rand"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+)
+
+type memKeyStore map[string][][]byte
+
+func (s memKeyStore) Create(_ context.Context, name string, data []byte) error {
+	if len(s[name]) > 0 {
+		return errors.Errorf("key %q exists", name)
+	}
+	s[name] = [][]byte{data}
+	return nil
+}
+
+func (s memKeyStore) Get(ctx context.Context, name string) ([]byte, error) {
+	version, err := s.LatestVersion(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetVersion(ctx, name, version)
+}
+
+func (s memKeyStore) GetVersion(_ context.Context, name string, version uint64) ([]byte, error) {
+	if version == 0 || version > uint64(len(s[name])) {
+		return nil, sql.ErrNoRows
+	}
+	return s[name][version-1], nil
+}
+
+func (s memKeyStore) LatestVersion(_ context.Context, name string) (uint64, error) {
+	if len(s[name]) == 0 {
+		return 0, sql.ErrNoRows
+	}
+	return uint64(len(s[name])), nil
+}
+
+func (s memKeyStore) Rotate(_ context.Context, name string, data []byte) (uint64, error) {
+	s[name] = append(s[name], data)
+	return uint64(len(s[name])), nil
+}
+
+type memClient map[string][]byte
+
+func (c memClient) Create(_ context.Context, _ Metadata, data []byte) (ID, error) {
+	id := Hash(data)
+	c[string(id)] = append([]byte{}, data...)
+	return id, nil
+}
+
+func (c memClient) Get(_ context.Context, id ID, cb kv.ValueCallback) error {
+	data, ok := c[string(id)]
+	if !ok {
+		return ErrChunkNotExists
+	}
+	return cb(data)
+}
+
+func (c memClient) Close() error {
+	return nil
+}
+
+func TestKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	client := memClient{}
+	keys := NewKeyRing(memKeyStore{}, "test")
+	data := textData(rand.New(rand.NewSource(0)), 1000)
+	create := func(t *testing.T, opts CreateOptions) *Ref {
+		opts.Secret = []byte("secret")
+		ref, err := Create(ctx, opts, data, func(ctx context.Context, data []byte) (ID, error) {
+			return client.Create(ctx, Metadata{}, data)
+		})
+		require.NoError(t, err)
+		return ref
+	}
+	get := func(t *testing.T, ref *Ref) {
+		require.NoError(t, Get(ctx, client, kv.NewMemCache(10), keys, ref, func(actual []byte) error {
+			require.True(t, bytes.Equal(data, actual))
+			return nil
+		}))
+	}
+
+	version, key, err := keys.Latest(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), version)
+	ref := create(t, CreateOptions{MasterKey: key, KeyVersion: version})
+	require.Equal(t, EncryptionAlgo_AES_256_GCM, ref.EncryptionAlgo)
+	require.Equal(t, uint64(1), ref.KeyVersion)
+	get(t, ref)
+	// Refs without a wrapped key can only be read without a key ring.
+	legacy := create(t, CreateOptions{})
+	require.Equal(t, uint64(0), legacy.KeyVersion)
+	require.True(t, bytes.Equal(ref.Id, legacy.Id))
+	require.NoError(t, Get(ctx, client, kv.NewMemCache(10), nil, legacy, func([]byte) error { return nil }))
+	require.YesError(t, Get(ctx, client, kv.NewMemCache(10), nil, ref, func([]byte) error { return nil }))
+
+	version, err = keys.Rotate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), version)
+	// Refs wrapped by an older version are still readable.
+	get(t, ref)
+	for _, ref := range []*Ref{ref, legacy} {
+		rewrapped, err := keys.Rewrap(ctx, ref)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), rewrapped.KeyVersion)
+		require.True(t, bytes.Equal(ref.Id, rewrapped.Id))
+		require.False(t, bytes.Equal(ref.Dek, rewrapped.Dek))
+		get(t, rewrapped)
+		// Rewrapping is a no-op at the latest version.
+		again, err := keys.Rewrap(ctx, rewrapped)
+		require.NoError(t, err)
+		require.Equal(t, rewrapped, again)
+	}
+}
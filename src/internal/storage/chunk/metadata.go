@@ -89,10 +89,48 @@ func SetupPostgresStoreV0(tx *sqlx.Tx) error {
 	return errors.EnsureStack(err)
 }
 
-// KeyStore is a store for named secret keys
+// SetupPostgresStoreV1 versions the keys in the key store, so that they can be
+// rotated.
+func SetupPostgresStoreV1(tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+	ALTER TABLE storage.keys ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+	ALTER TABLE storage.keys ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+	ALTER TABLE storage.keys DROP CONSTRAINT keys_pkey;
+	ALTER TABLE storage.keys ADD PRIMARY KEY(name, version);
+	`)
+	return errors.EnsureStack(err)
+}
+
+// SetupPostgresStoreV2 sets up the table for the chunk objects which failed
+// verification by the scrubber.
+func SetupPostgresStoreV2(tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+	CREATE TABLE storage.chunk_scrub_failures (
+		chunk_id BYTEA NOT NULL,
+		gen BIGINT NOT NULL,
+		reason TEXT NOT NULL,
+		detected_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+		PRIMARY KEY(chunk_id, gen),
+		FOREIGN KEY(chunk_id, gen) REFERENCES storage.chunk_objects(chunk_id, gen) ON DELETE CASCADE
+	);
+	`)
+	return errors.EnsureStack(err)
+}
+
+// KeyStore is a store for named secret keys.
+// Each name can have multiple versions of a key, starting at version 1.
 type KeyStore interface {
+	// Create creates version 1 of the key with name.
 	Create(ctx context.Context, name string, data []byte) error
+	// Get returns the latest version of the key with name.
 	Get(ctx context.Context, name string) ([]byte, error)
+	// GetVersion returns a version of the key with name.
+	GetVersion(ctx context.Context, name string, version uint64) ([]byte, error)
+	// LatestVersion returns the latest version of the key with name.
+	LatestVersion(ctx context.Context, name string) (uint64, error)
+	// Rotate creates a new latest version of the key with name, and returns its version.
+	Rotate(ctx context.Context, name string, data []byte) (uint64, error)
 }
 
 type postgresKeyStore struct {
@@ -107,15 +145,43 @@ func NewPostgresKeyStore(db *sqlx.DB) *postgresKeyStore {
 
 func (s *postgresKeyStore) Create(ctx context.Context, name string, data []byte) error {
 	_, err := s.db.ExecContext(ctx, `
-	INSERT INTO storage.keys (name, data) VALUES ($1, $2)
+	INSERT INTO storage.keys (name, version, data) VALUES ($1, 1, $2)
 	`, name, data)
 	return err
 }
 
 func (s *postgresKeyStore) Get(ctx context.Context, name string) ([]byte, error) {
 	var data []byte
-	if err := s.db.GetContext(ctx, &data, `SELECT data FROM storage.keys WHERE name = $1 LIMIT 1`, name); err != nil {
+	if err := s.db.GetContext(ctx, &data, `SELECT data FROM storage.keys WHERE name = $1 ORDER BY version DESC LIMIT 1`, name); err != nil {
 		return nil, err
 	}
 	return data, nil
 }
+
+func (s *postgresKeyStore) GetVersion(ctx context.Context, name string, version uint64) ([]byte, error) {
+	var data []byte
+	if err := s.db.GetContext(ctx, &data, `SELECT data FROM storage.keys WHERE name = $1 AND version = $2`, name, version); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *postgresKeyStore) LatestVersion(ctx context.Context, name string) (uint64, error) {
+	var version uint64
+	if err := s.db.GetContext(ctx, &version, `SELECT version FROM storage.keys WHERE name = $1 ORDER BY version DESC LIMIT 1`, name); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (s *postgresKeyStore) Rotate(ctx context.Context, name string, data []byte) (uint64, error) {
+	var version uint64
+	if err := s.db.GetContext(ctx, &version, `
+	INSERT INTO storage.keys (name, version, data)
+		SELECT $1, COALESCE(MAX(version), 0) + 1, $2 FROM storage.keys WHERE name = $1
+	RETURNING version
+	`, name, data); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
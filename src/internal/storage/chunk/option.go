@@ -4,10 +4,14 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/chmduquesne/rollinghash/buzhash64"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 )
 
@@ -29,6 +33,14 @@ func WithObjectCache(fastLayer obj.Client, size int) StorageOption {
 	}
 }
 
+// WithDiskCache adds a cache in front of the object storage, which must never
+// change the value of a key.
+func WithDiskCache(cache kv.GetPut) StorageOption {
+	return func(s *Storage) {
+		s.diskCache = cache
+	}
+}
+
 // WithSecret sets the secret used to generate chunk encryption keys
 func WithSecret(secret []byte) StorageOption {
 	return func(s *Storage) {
@@ -36,6 +48,14 @@ func WithSecret(secret []byte) StorageOption {
 	}
 }
 
+// WithKeyStore sets the key store and the name of the master key used to wrap
+// chunk encryption keys.
+func WithKeyStore(store KeyStore, name string) StorageOption {
+	return func(s *Storage) {
+		s.keys = NewKeyRing(store, name)
+	}
+}
+
 // WithCompression sets the compression algorithm used to compress chunks
 func WithCompression(algo CompressionAlgo) StorageOption {
 	return func(s *Storage) {
@@ -43,6 +63,13 @@ func WithCompression(algo CompressionAlgo) StorageOption {
 	}
 }
 
+// WithCompressionLevel sets the zstd compression level used to compress chunks
+func WithCompressionLevel(level int) StorageOption {
+	return func(s *Storage) {
+		s.createOpts.CompressionLevel = level
+	}
+}
+
 // WriterOption configures a chunk writer.
 type WriterOption func(w *Writer)
 
@@ -70,13 +97,62 @@ func WithNoUpload() WriterOption {
 	}
 }
 
+// WithWriterCompression sets the compression algorithm and zstd compression
+// level used to compress the chunks created by the writer, overriding the
+// storage's.
+func WithWriterCompression(algo CompressionAlgo, level int) WriterOption {
+	return func(w *Writer) {
+		w.createOpts.Compression = algo
+		w.createOpts.CompressionLevel = level
+	}
+}
+
+// ParseCompression parses a compression algorithm name (e.g. "zstd" or
+// "gzip_best_speed"), optionally followed by a colon and a compression level
+// (e.g. "zstd:9").
+func ParseCompression(s string) (CompressionAlgo, int, error) {
+	name, levelStr := s, ""
+	if i := strings.Index(s, ":"); i >= 0 {
+		name, levelStr = s[:i], s[i+1:]
+	}
+	algo, ok := CompressionAlgo_value[strings.ToUpper(strings.ReplaceAll(name, "-", "_"))]
+	if !ok {
+		return 0, 0, errors.Errorf("unrecognized compression algorithm: %q", name)
+	}
+	var level int
+	if levelStr != "" {
+		var err error
+		if level, err = strconv.Atoi(levelStr); err != nil {
+			return 0, 0, errors.Wrapf(err, "invalid compression level %q", levelStr)
+		}
+	}
+	return CompressionAlgo(algo), level, nil
+}
+
 // StorageOptions returns the chunk storage options for the config.
 func StorageOptions(conf *serviceenv.Configuration) ([]StorageOption, error) {
 	var opts []StorageOption
 	if conf.StorageUploadConcurrencyLimit > 0 {
 		opts = append(opts, WithMaxConcurrentObjects(0, conf.StorageUploadConcurrencyLimit))
 	}
-	if conf.StorageDiskCacheSize > 0 {
+	if conf.StorageCompression != "" {
+		algo, level, err := ParseCompression(conf.StorageCompression)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithCompression(algo), WithCompressionLevel(level))
+	}
+	if conf.StorageDiskCacheBytes > 0 {
+		policy, err := kv.ParseCachePolicy(conf.StorageDiskCachePolicy)
+		if err != nil {
+			return nil, err
+		}
+		diskCache, err := kv.NewDiskCache(conf.StorageDiskCachePath, conf.StorageDiskCacheBytes, policy)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithDiskCache(diskCache))
+	} else if conf.StorageDiskCacheSize > 0 {
 		diskCache, err := obj.NewLocalClient(filepath.Join(os.TempDir(), "pfs-cache", uuid.NewWithoutDashes()))
 		if err != nil {
 			return nil, err
@@ -14,14 +14,16 @@ type Reader struct {
 	ctx      context.Context
 	client   Client
 	memCache kv.GetPut
+	keys     *KeyRing
 	dataRefs []*DataRef
 }
 
-func newReader(ctx context.Context, client Client, memCache kv.GetPut, dataRefs []*DataRef) *Reader {
+func newReader(ctx context.Context, client Client, memCache kv.GetPut, keys *KeyRing, dataRefs []*DataRef) *Reader {
 	return &Reader{
 		ctx:      ctx,
 		client:   client,
 		memCache: memCache,
+		keys:     keys,
 		dataRefs: dataRefs,
 	}
 }
@@ -29,7 +31,7 @@ func newReader(ctx context.Context, client Client, memCache kv.GetPut, dataRefs
 // Iterate iterates over the data readers for the data references.
 func (r *Reader) Iterate(cb func(*DataReader) error) error {
 	for _, dataRef := range r.dataRefs {
-		dr := newDataReader(r.ctx, r.client, r.memCache, dataRef)
+		dr := newDataReader(r.ctx, r.client, r.memCache, r.keys, dataRef)
 		if err := cb(dr); err != nil {
 			if errors.Is(err, errutil.ErrBreak) {
 				return nil
@@ -52,14 +54,16 @@ type DataReader struct {
 	ctx      context.Context
 	client   Client
 	memCache kv.GetPut
+	keys     *KeyRing
 	dataRef  *DataRef
 }
 
-func newDataReader(ctx context.Context, client Client, memCache kv.GetPut, dataRef *DataRef) *DataReader {
+func newDataReader(ctx context.Context, client Client, memCache kv.GetPut, keys *KeyRing, dataRef *DataRef) *DataReader {
 	return &DataReader{
 		ctx:      ctx,
 		client:   client,
 		memCache: memCache,
+		keys:     keys,
 		dataRef:  dataRef,
 	}
 }
@@ -71,7 +75,7 @@ func (dr *DataReader) DataRef() *DataRef {
 
 // Get writes the data referenced by the data reference.
 func (dr *DataReader) Get(w io.Writer) error {
-	return Get(dr.ctx, dr.client, dr.memCache, dr.dataRef.Ref, func(chunk []byte) error {
+	return Get(dr.ctx, dr.client, dr.memCache, dr.keys, dr.dataRef.Ref, func(chunk []byte) error {
 		data := chunk[dr.dataRef.OffsetBytes : dr.dataRef.OffsetBytes+dr.dataRef.SizeBytes]
 		_, err := w.Write(data)
 		return err
@@ -0,0 +1,210 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/sirupsen/logrus"
+)
+
+const scrubBatchSize = 100
+
+// ScrubFailure is a chunk object which failed verification by the scrubber.
+type ScrubFailure struct {
+	ChunkID    ID        `db:"chunk_id"`
+	Gen        uint64    `db:"gen"`
+	Reason     string    `db:"reason"`
+	DetectedAt time.Time `db:"detected_at"`
+}
+
+// Scrubber verifies that the chunk objects in object storage match their IDs,
+// and records the objects which do not.
+// If a secondary object store is configured, broken objects are repaired by
+// copying them from the secondary object store.
+type Scrubber struct {
+	s         *Storage
+	rate      int64
+	secondary kv.Store
+	log       *logrus.Logger
+}
+
+// NewScrubber returns a new scrubber operating on s, which reads at most rate
+// bytes per second from object storage.
+// secondary may be nil, in which case broken objects are not repaired.
+func NewScrubber(s *Storage, rate int64, secondary obj.Client) *Scrubber {
+	sc := &Scrubber{s: s, rate: rate, log: logrus.StandardLogger()}
+	if secondary != nil {
+		sc.secondary = kv.NewFromObjectClient(secondary)
+	}
+	return sc
+}
+
+// RunForever calls RunOnce until the context is cancelled, logging any errors.
+func (sc *Scrubber) RunForever(ctx context.Context) error {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		if err := sc.RunOnce(ctx); err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			sc.log.Errorf("during chunk scrub: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce runs 1 pass of the scrubber over the uploaded chunk objects.
+func (sc *Scrubber) RunOnce(ctx context.Context) error {
+	var last *Entry
+	for {
+		var ents []Entry
+		var err error
+		if last == nil {
+			err = sc.s.db.SelectContext(ctx, &ents, `
+			SELECT chunk_id, gen FROM storage.chunk_objects
+			WHERE uploaded = TRUE AND tombstone = FALSE
+			ORDER BY chunk_id, gen
+			LIMIT $1
+			`, scrubBatchSize)
+		} else {
+			err = sc.s.db.SelectContext(ctx, &ents, `
+			SELECT chunk_id, gen FROM storage.chunk_objects
+			WHERE uploaded = TRUE AND tombstone = FALSE AND (chunk_id, gen) > ($1, $2)
+			ORDER BY chunk_id, gen
+			LIMIT $3
+			`, last.ChunkID, last.Gen, scrubBatchSize)
+		}
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		for _, ent := range ents {
+			if err := sc.scrubOne(ctx, ent); err != nil {
+				return err
+			}
+		}
+		if len(ents) < scrubBatchSize {
+			return nil
+		}
+		last = &ents[len(ents)-1]
+	}
+}
+
+func (sc *Scrubber) scrubOne(ctx context.Context, ent Entry) error {
+	key := chunkKey(ent.ChunkID, ent.Gen)
+	reason, err := sc.verify(ctx, sc.s.backing, ent.ChunkID, key)
+	if err != nil {
+		return err
+	}
+	if reason == "" {
+		return sc.clearFailure(ctx, ent)
+	}
+	fields := logrus.Fields{
+		"chunk_id": ent.ChunkID,
+		"gen":      ent.Gen,
+	}
+	if sc.secondary != nil {
+		repairErr := sc.repair(ctx, ent.ChunkID, key)
+		if repairErr == nil {
+			sc.log.WithFields(fields).Infof("repaired %s chunk object from secondary object store", reason)
+			return sc.clearFailure(ctx, ent)
+		}
+		reason = fmt.Sprintf("%s (repair failed: %v)", reason, repairErr)
+	}
+	sc.log.WithFields(fields).Errorf("chunk object failed verification: %s", reason)
+	return sc.recordFailure(ctx, ent, reason)
+}
+
+// verify reads the object at key from store, pacing the reads to the
+// scrubber's rate, and returns the reason it does not match chunkID, or the
+// empty string if it does.
+func (sc *Scrubber) verify(ctx context.Context, store kv.Store, chunkID ID, key []byte) (string, error) {
+	var size int
+	var reason string
+	if err := store.Get(ctx, key, func(data []byte) error {
+		size = len(data)
+		if !bytes.Equal(Hash(data), chunkID) {
+			reason = "corrupted"
+		}
+		return nil
+	}); err != nil {
+		if !pacherr.IsNotExist(err) {
+			return "", err
+		}
+		reason = "missing"
+	}
+	return reason, sc.pace(ctx, size)
+}
+
+func (sc *Scrubber) pace(ctx context.Context, size int) error {
+	if sc.rate <= 0 || size == 0 {
+		return nil
+	}
+	timer := time.NewTimer(time.Duration(float64(size) / float64(sc.rate) * float64(time.Second)))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (sc *Scrubber) repair(ctx context.Context, chunkID ID, key []byte) error {
+	var data []byte
+	if err := sc.secondary.Get(ctx, key, func(value []byte) error {
+		data = append([]byte{}, value...)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if !bytes.Equal(Hash(data), chunkID) {
+		return errors.Errorf("secondary object is also corrupted")
+	}
+	return sc.s.backing.Put(ctx, key, data)
+}
+
+func (sc *Scrubber) recordFailure(ctx context.Context, ent Entry, reason string) error {
+	// The entry may have been tombstoned and its object deleted since it was
+	// listed, in which case the failure is not recorded.
+	_, err := sc.s.db.ExecContext(ctx, `
+	INSERT INTO storage.chunk_scrub_failures (chunk_id, gen, reason)
+	SELECT chunk_id, gen, $3 FROM storage.chunk_objects
+	WHERE chunk_id = $1 AND gen = $2 AND tombstone = FALSE
+	ON CONFLICT (chunk_id, gen) DO UPDATE SET reason = EXCLUDED.reason, detected_at = CURRENT_TIMESTAMP
+	`, ent.ChunkID, ent.Gen, reason)
+	return errors.EnsureStack(err)
+}
+
+func (sc *Scrubber) clearFailure(ctx context.Context, ent Entry) error {
+	_, err := sc.s.db.ExecContext(ctx, `
+	DELETE FROM storage.chunk_scrub_failures
+	WHERE chunk_id = $1 AND gen = $2
+	`, ent.ChunkID, ent.Gen)
+	return errors.EnsureStack(err)
+}
+
+// ScrubFailures returns the chunk objects which failed verification by the
+// scrubber, and have not since been repaired.
+func (s *Storage) ScrubFailures(ctx context.Context) ([]ScrubFailure, error) {
+	var failures []ScrubFailure
+	if err := s.db.SelectContext(ctx, &failures, `
+	SELECT chunk_id, gen, reason, detected_at FROM storage.chunk_scrub_failures
+	ORDER BY chunk_id, gen
+	`); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return failures, nil
+}
@@ -0,0 +1,80 @@
+package chunk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+)
+
+func TestScrub(t *testing.T) {
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	oc, s := NewTestStorage(t, db, tracker)
+
+	writeRandom(ctx, t, s)
+	// replicate the objects to a secondary object store
+	secondary, _ := obj.NewTestClient(t)
+	var paths []string
+	require.NoError(t, oc.Walk(ctx, "", func(p string) error {
+		paths = append(paths, p)
+		return obj.Copy(ctx, oc, secondary, p, p)
+	}))
+	require.True(t, len(paths) >= 2)
+
+	// corrupt one object and delete another
+	require.NoError(t, oc.Put(ctx, paths[0], strings.NewReader("corrupted")))
+	require.NoError(t, oc.Delete(ctx, paths[1]))
+
+	require.NoError(t, NewScrubber(s, 0, nil).RunOnce(ctx))
+	failures, err := s.ScrubFailures(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(failures))
+	reasons := make(map[string]string)
+	for _, failure := range failures {
+		reasons[chunkPath(failure.ChunkID, failure.Gen)] = failure.Reason
+	}
+	require.Equal(t, "corrupted", reasons[paths[0]])
+	require.Equal(t, "missing", reasons[paths[1]])
+
+	// repair the objects from the secondary object store
+	require.NoError(t, NewScrubber(s, 0, secondary).RunOnce(ctx))
+	failures, err = s.ScrubFailures(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(failures))
+	require.NoError(t, NewScrubber(s, 0, nil).RunOnce(ctx))
+	failures, err = s.ScrubFailures(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(failures))
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	oc, s := NewTestStorage(t, db, tracker)
+
+	writeRandom(ctx, t, s)
+	var ents []Entry
+	require.NoError(t, db.Select(&ents, `SELECT chunk_id, gen FROM storage.chunk_objects`))
+	require.True(t, len(ents) >= 2)
+	var ids []ID
+	for _, ent := range ents {
+		ids = append(ids, ent.ChunkID)
+		require.NoError(t, s.Check(ctx, ent.ChunkID))
+	}
+	require.NoError(t, oc.Delete(ctx, chunkPath(ents[0].ChunkID, ents[0].Gen)))
+	var failed int
+	for _, id := range ids {
+		if s.Check(ctx, id) != nil {
+			failed++
+		}
+	}
+	require.Equal(t, 1, failed)
+	require.YesError(t, s.Check(ctx, ID("missing")))
+}
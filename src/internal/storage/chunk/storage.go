@@ -1,10 +1,12 @@
 package chunk
 
 import (
+	"bytes"
 	"context"
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
@@ -21,9 +23,12 @@ const (
 type Storage struct {
 	objClient obj.Client
 	store     kv.Store
+	backing   kv.Store
 	memCache  kv.GetPut
 	tracker   track.Tracker
 	db        *sqlx.DB
+	keys      *KeyRing
+	diskCache kv.GetPut
 
 	createOpts CreateOptions
 }
@@ -32,17 +37,21 @@ type Storage struct {
 func NewStorage(objC obj.Client, memCache kv.GetPut, db *sqlx.DB, tracker track.Tracker, opts ...StorageOption) *Storage {
 	s := &Storage{
 		objClient: objC,
+		backing:   kv.NewFromObjectClient(objC),
 		memCache:  memCache,
 		db:        db,
 		tracker:   tracker,
 		createOpts: CreateOptions{
-			Compression: CompressionAlgo_GZIP_BEST_SPEED,
+			Compression: CompressionAlgo_NONE,
 		},
 	}
 	for _, opt := range opts {
 		opt(s)
 	}
 	s.store = kv.NewFromObjectClient(s.objClient)
+	if s.diskCache != nil {
+		s.store = kv.NewCachedStore(s.store, s.diskCache)
+	}
 	s.objClient = nil
 	return s
 }
@@ -51,7 +60,7 @@ func NewStorage(objC obj.Client, memCache kv.GetPut, db *sqlx.DB, tracker track.
 func (s *Storage) NewReader(ctx context.Context, dataRefs []*DataRef) *Reader {
 	// using the empty string for the tmp id to disable the renewer
 	client := NewClient(s.store, s.db, s.tracker, "")
-	return newReader(ctx, client, s.memCache, dataRefs)
+	return newReader(ctx, client, s.memCache, s.keys, dataRefs)
 }
 
 // NewWriter creates a new Writer for a stream of bytes to be chunked.
@@ -62,7 +71,7 @@ func (s *Storage) NewWriter(ctx context.Context, name string, cb WriterCallback,
 		panic("name must not be empty")
 	}
 	client := NewClient(s.store, s.db, s.tracker, name)
-	return newWriter(ctx, client, s.memCache, s.createOpts, cb, opts...)
+	return newWriter(ctx, client, s.memCache, s.keys, s.createOpts, cb, opts...)
 }
 
 // List lists all of the chunks in object storage.
@@ -72,7 +81,136 @@ func (s *Storage) List(ctx context.Context, cb func(id ID) error) error {
 	})
 }
 
+// Check verifies that the chunk with id is tracked, and that it has an
+// uploaded object which exists in the object store.
+func (s *Storage) Check(ctx context.Context, id ID) error {
+	exists, err := s.tracker.Exists(ctx, id.TrackerID())
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Errorf("chunk %v is not tracked", id)
+	}
+	var gens []uint64
+	if err := s.db.SelectContext(ctx, &gens, `
+	SELECT gen FROM storage.chunk_objects
+	WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1
+	`, id); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if len(gens) == 0 {
+		return errors.Errorf("no objects for chunk %v", id)
+	}
+	for _, gen := range gens {
+		exists, err := s.backing.Exists(ctx, chunkKey(id, gen))
+		if err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+	}
+	return errors.Errorf("the objects for chunk %v are missing from the object store", id)
+}
+
+// Export calls cb with the stored object of the chunk with id, which is
+// compressed and encrypted. Chunks exported from one cluster can be stored in
+// another with an Importer, and read with data references unwrapped by Unwrap.
+func (s *Storage) Export(ctx context.Context, id ID, cb kv.ValueCallback) error {
+	client := NewClient(s.store, s.db, s.tracker, "")
+	return client.Get(ctx, id, cb)
+}
+
+// Unwrap returns dataRef with an unwrapped chunk encryption key, so that it can
+// be read without the master key.
+func (s *Storage) Unwrap(ctx context.Context, dataRef *DataRef) (*DataRef, error) {
+	if dataRef.Ref.KeyVersion == 0 {
+		return dataRef, nil
+	}
+	dek, err := s.keys.unwrap(ctx, dataRef.Ref)
+	if err != nil {
+		return nil, err
+	}
+	ref := *dataRef.Ref
+	ref.Dek = dek
+	ref.KeyVersion = 0
+	return &DataRef{
+		Ref:         &ref,
+		Hash:        dataRef.Hash,
+		OffsetBytes: dataRef.OffsetBytes,
+		SizeBytes:   dataRef.SizeBytes,
+	}, nil
+}
+
+// Importer stores chunks exported from another cluster.
+type Importer struct {
+	s      *Storage
+	client Client
+}
+
+// NewImporter creates a new Importer. The chunks it imports are kept alive
+// under name until the importer is closed, so they should be referenced by a
+// fileset before then.
+func (s *Storage) NewImporter(name string) *Importer {
+	return &Importer{
+		s:      s,
+		client: NewClient(s.store, s.db, s.tracker, name),
+	}
+}
+
+// Import stores data, the object of the chunk with id returned by Export. The
+// upload is skipped if the chunk already exists, in which case Import returns
+// false.
+func (i *Importer) Import(ctx context.Context, id ID, data []byte) (bool, error) {
+	if !bytes.Equal(Hash(data), id) {
+		return false, errors.Errorf("the data for chunk %v does not match its ID", id)
+	}
+	var count int
+	if err := i.s.db.GetContext(ctx, &count, `
+	SELECT COUNT(*) FROM storage.chunk_objects
+	WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1
+	`, id); err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	// The chunk is created even if it exists, to keep it alive until the
+	// importer is closed.
+	if _, err := i.client.Create(ctx, Metadata{Size: len(data)}, data); err != nil {
+		return false, err
+	}
+	return count == 0, nil
+}
+
+// Close closes the importer.
+func (i *Importer) Close() error {
+	return i.client.Close()
+}
+
 // NewDeleter creates a deleter for use with a tracker.GC
 func (s *Storage) NewDeleter() track.Deleter {
 	return &deleter{}
 }
+
+// KeyVersion returns the latest version of the master key used to wrap chunk
+// encryption keys, or 0 if chunk encryption keys are not wrapped.
+func (s *Storage) KeyVersion(ctx context.Context) (uint64, error) {
+	if s.keys == nil {
+		return 0, nil
+	}
+	version, _, err := s.keys.Latest(ctx)
+	return version, err
+}
+
+// RotateKey creates a new version of the master key used to wrap chunk
+// encryption keys, and returns its version.
+func (s *Storage) RotateKey(ctx context.Context) (uint64, error) {
+	if s.keys == nil {
+		return 0, errors.Errorf("chunk storage has no key store")
+	}
+	return s.keys.Rotate(ctx)
+}
+
+// Rewrap returns dataRef with its chunk encryption key wrapped by the latest
+// version of the master key.
+func (s *Storage) Rewrap(ctx context.Context, dataRef *DataRef) (*DataRef, error) {
+	return RewrapDataRef(ctx, s.keys, dataRef)
+}
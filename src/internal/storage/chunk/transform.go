@@ -4,64 +4,100 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/aes"
 	"crypto/cipher"
 	io "io"
 	"io/ioutil"
+	"sync"
 
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pierrec/lz4/v4"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/crypto/chacha20"
 )
 
 // CreateOptions affect how chunks are created.
 type CreateOptions struct {
-	Secret      []byte
+	Secret []byte
+	// MasterKey wraps the data encryption key stored in the chunk reference,
+	// and KeyVersion is its version.
+	// The data encryption key is not wrapped if MasterKey is nil.
+	MasterKey   []byte
+	KeyVersion  uint64
 	Compression CompressionAlgo
+	// CompressionLevel is the zstd compression level (1 - 22), or 0 for the
+	// default level. It is ignored by the other algorithms.
+	CompressionLevel int
 }
 
 // Create calls createFunc to create a new chunk, but first compresses, and encrypts ptext.
 // ptext will not be modified.
 func Create(ctx context.Context, opts CreateOptions, ptext []byte, createFunc func(ctx context.Context, data []byte) (ID, error)) (*Ref, error) {
-	buf := make([]byte, len(ptext))
-	compressAlgo, n, err := compress(opts.Compression, buf, ptext)
+	buf := make([]byte, len(ptext), len(ptext)+aes.BlockSize)
+	compressAlgo, n, err := compress(opts.Compression, opts.CompressionLevel, buf, ptext)
 	if err != nil {
 		return nil, err
 	}
 	buf = buf[:n]
 	// encrypt in place; compress will always make a copy of the data.
-	dek := encrypt(opts.Secret, buf, buf)
+	dek := deriveKey(opts.Secret, buf)
+	if buf, err = seal(dek, buf[:0], buf); err != nil {
+		return nil, err
+	}
+	if opts.MasterKey != nil {
+		if dek, err = wrapKey(opts.MasterKey, dek); err != nil {
+			return nil, err
+		}
+	}
 	id, err := createFunc(ctx, buf)
 	if err != nil {
 		return nil, err
 	}
 	return &Ref{
 		Id:              id,
-		SizeBytes:       int64(len(buf)),
+		SizeBytes:       int64(len(ptext)),
 		Dek:             dek,
 		CompressionAlgo: compressAlgo,
-		EncryptionAlgo:  EncryptionAlgo_CHACHA20,
+		EncryptionAlgo:  EncryptionAlgo_AES_256_GCM,
+		KeyVersion:      opts.KeyVersion,
 	}, nil
 }
 
 // Get calls getFunc to retrieve a chunk, then verifies, decrypts, and decompresses the data.
+// The data encryption key is unwrapped with keys, which can be nil if it is not wrapped.
 // Uncompressed plaintext is written to w.
-func Get(ctx context.Context, client Client, cache kv.GetPut, ref *Ref, cb kv.ValueCallback) error {
+func Get(ctx context.Context, client Client, cache kv.GetPut, keys *KeyRing, ref *Ref, cb kv.ValueCallback) error {
 	if err := getFromCache(ctx, cache, ref, cb); err == nil {
 		return nil
 	}
-	if ref.EncryptionAlgo != EncryptionAlgo_CHACHA20 {
+	if ref.EncryptionAlgo != EncryptionAlgo_CHACHA20 && ref.EncryptionAlgo != EncryptionAlgo_AES_256_GCM {
 		return errors.Errorf("unknown encryption algorithm %d", ref.EncryptionAlgo)
 	}
+	dek, err := keys.unwrap(ctx, ref)
+	if err != nil {
+		return err
+	}
 	return client.Get(ctx, ref.Id, func(ctext []byte) error {
 		if err := verifyData(ref.Id, ctext); err != nil {
 			return err
 		}
-		var r io.Reader = bytes.NewReader(ctext)
+		var r io.Reader
 		var err error
-		if r, err = decrypt(ref.Dek, r); err != nil {
-			return err
+		switch ref.EncryptionAlgo {
+		case EncryptionAlgo_CHACHA20:
+			if r, err = decrypt(dek, bytes.NewReader(ctext)); err != nil {
+				return err
+			}
+		case EncryptionAlgo_AES_256_GCM:
+			ptext, err := open(dek, ctext)
+			if err != nil {
+				return err
+			}
+			r = bytes.NewReader(ptext)
 		}
 		if r, err = decompress(ref.CompressionAlgo, r); err != nil {
 			return err
@@ -81,36 +117,90 @@ func Get(ctx context.Context, client Client, cache kv.GetPut, ref *Ref, cb kv.Va
 // then no compression is used.
 // compress returns the compression algorithm used (algo or NONE), the number of bytes written to dst
 // or an error
-func compress(algo CompressionAlgo, dst, src []byte) (CompressionAlgo, int, error) {
+func compress(algo CompressionAlgo, level int, dst, src []byte) (CompressionAlgo, int, error) {
+	var n int
+	var err error
 	switch algo {
 	case CompressionAlgo_NONE:
 		copy(dst, src)
 		return CompressionAlgo_NONE, len(src), nil
 	case CompressionAlgo_GZIP_BEST_SPEED:
-		lw := newLimitWriter(dst)
-		err := func() (retErr error) {
-			gw, err := gzip.NewWriterLevel(lw, gzip.BestSpeed)
-			if err != nil {
-				return err
-			}
-			defer func() {
-				if err := gw.Close(); retErr == nil {
-					retErr = err
-				}
-			}()
-			_, err = gw.Write(src)
-			if err != nil {
-				return err
+		n, err = compressStream(dst, src, func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriterLevel(w, gzip.BestSpeed)
+		})
+	case CompressionAlgo_ZSTD:
+		// The zstd encoder compresses whole buffers much faster than streams.
+		var enc *zstd.Encoder
+		enc, err = zstdEncoder(level)
+		if err == nil {
+			out := enc.EncodeAll(src, dst[:0])
+			if len(out) > len(dst) {
+				err = io.ErrShortWrite
 			}
-			return gw.Close()
-		}()
-		if err == io.ErrShortWrite {
-			return compress(CompressionAlgo_NONE, dst, src)
+			n = copy(dst, out)
 		}
-		return CompressionAlgo_GZIP_BEST_SPEED, lw.pos, err
+	case CompressionAlgo_LZ4:
+		n, err = compressStream(dst, src, func(w io.Writer) (io.WriteCloser, error) {
+			return lz4.NewWriter(w), nil
+		})
+	case CompressionAlgo_SNAPPY:
+		n, err = compressStream(dst, src, func(w io.Writer) (io.WriteCloser, error) {
+			return snappy.NewBufferedWriter(w), nil
+		})
 	default:
 		return 0, 0, errors.Errorf("unrecognized compression: %v", algo)
 	}
+	if errors.Is(err, io.ErrShortWrite) {
+		return compress(CompressionAlgo_NONE, 0, dst, src)
+	}
+	return algo, n, err
+}
+
+// compressStream compresses src into dst with the writer returned by
+// newWriter. It returns io.ErrShortWrite if the compressed data doesn't fit in
+// dst.
+func compressStream(dst, src []byte, newWriter func(io.Writer) (io.WriteCloser, error)) (int, error) {
+	lw := newLimitWriter(dst)
+	w, err := newWriter(lw)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := w.Write(src); err != nil {
+		w.Close()
+		return 0, err
+	}
+	if err := w.Close(); err != nil {
+		return 0, err
+	}
+	return lw.pos, nil
+}
+
+var (
+	zstdEncoders    = make(map[zstd.EncoderLevel]*zstd.Encoder)
+	zstdEncodersMu  sync.Mutex
+	zstdDecoder     *zstd.Decoder
+	zstdDecoderErr  error
+	zstdDecoderOnce sync.Once
+)
+
+// zstdEncoder returns a shared zstd encoder for the zstd compression level
+// (1 - 22). Level 0 selects the default level.
+func zstdEncoder(level int) (*zstd.Encoder, error) {
+	encLevel := zstd.SpeedDefault
+	if level != 0 {
+		encLevel = zstd.EncoderLevelFromZstd(level)
+	}
+	zstdEncodersMu.Lock()
+	defer zstdEncodersMu.Unlock()
+	if enc, ok := zstdEncoders[encLevel]; ok {
+		return enc, nil
+	}
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(encLevel))
+	if err != nil {
+		return nil, err
+	}
+	zstdEncoders[encLevel] = enc
+	return enc, nil
 }
 
 func decompress(algo CompressionAlgo, r io.Reader) (io.Reader, error) {
@@ -123,6 +213,26 @@ func decompress(algo CompressionAlgo, r io.Reader) (io.Reader, error) {
 			return nil, err
 		}
 		return gr, nil
+	case CompressionAlgo_ZSTD:
+		zstdDecoderOnce.Do(func() {
+			zstdDecoder, zstdDecoderErr = zstd.NewReader(nil)
+		})
+		if zstdDecoderErr != nil {
+			return nil, zstdDecoderErr
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		data, err = zstdDecoder.DecodeAll(data, nil)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.NewReader(data), nil
+	case CompressionAlgo_LZ4:
+		return lz4.NewReader(r), nil
+	case CompressionAlgo_SNAPPY:
+		return snappy.NewReader(r), nil
 	default:
 		return nil, errors.Errorf("unrecognized compression: %v", algo)
 	}
@@ -149,14 +259,7 @@ func (w *limitWriter) Write(p []byte) (int, error) {
 	return n, nil
 }
 
-// encrypt generates a key using secret and src, and encrypts src, writing the output to dst
-func encrypt(secret []byte, dst, src []byte) (dek []byte) {
-	dek = deriveKey(secret, src)
-	cryptoXOR(dek[:32], dst, src)
-	return dek
-}
-
-// decrypt returns an io.Reader containing r decrypted using dek
+// decrypt returns an io.Reader containing r decrypted with ChaCha20 using dek
 func decrypt(dek []byte, r io.Reader) (io.Reader, error) {
 	if len(dek) != 32 {
 		return nil, errors.Errorf("data encryption key is wrong length")
@@ -177,20 +280,10 @@ func deriveKey(secret, ptext []byte) []byte {
 	return Hash(x)[:32]
 }
 
-// cryptoXOR setups up a stream cipher using key, and writes (src XOR keystream) to dst
-func cryptoXOR(key, dst, src []byte) {
-	nonce := [chacha20.NonceSize]byte{}
-	ciph, err := chacha20.NewUnauthenticatedCipher(key, nonce[:])
-	if err != nil {
-		panic(err) // this only happens if you pass in an invalid key/nonce size, which we shouldn't do
-	}
-	ciph.XORKeyStream(dst, src)
-}
-
 func verifyData(id ID, x []byte) error {
 	actualHash := Hash(x)
 	if !bytes.Equal(actualHash[:], id) {
-		return errors.Errorf("bad chunk. HAVE: %x WANT: %x", actualHash, id)
+		return errors.Wrapf(kv.ErrInvalidValue, "bad chunk. HAVE: %x WANT: %x", actualHash, id)
 	}
 	return nil
 }
@@ -18,6 +18,8 @@ func NewTestStorage(t testing.TB, db *sqlx.DB, tr track.Tracker, opts ...Storage
 	objC, _ := obj.NewTestClient(t)
 	db.MustExec(`CREATE SCHEMA IF NOT EXISTS storage`)
 	require.NoError(t, dbutil.WithTx(context.Background(), db, SetupPostgresStoreV0))
+	require.NoError(t, dbutil.WithTx(context.Background(), db, SetupPostgresStoreV1))
+	require.NoError(t, dbutil.WithTx(context.Background(), db, SetupPostgresStoreV2))
 	return objC, NewStorage(objC, kv.NewMemCache(10), db, tr, opts...)
 }
 
@@ -28,3 +30,40 @@ func Reference(dataRef *DataRef) *DataRef {
 	chunkDataRef.SizeBytes = dataRef.Ref.SizeBytes
 	return chunkDataRef
 }
+
+// RangeDataRefs returns the data references for the size bytes of the data
+// referenced by dataRefs that start at offset, or all of the bytes after
+// offset if size is 0. Data references that don't overlap the range are
+// dropped, so only the chunks holding the range are read.
+func RangeDataRefs(dataRefs []*DataRef, offset, size int64) []*DataRef {
+	var result []*DataRef
+	var start int64
+	for _, dataRef := range dataRefs {
+		end := start + dataRef.SizeBytes
+		if end <= offset {
+			start = end
+			continue
+		}
+		if size > 0 && start >= offset+size {
+			break
+		}
+		rangeDataRef := &DataRef{
+			Ref:         dataRef.Ref,
+			OffsetBytes: dataRef.OffsetBytes,
+			SizeBytes:   dataRef.SizeBytes,
+		}
+		if start < offset {
+			rangeDataRef.OffsetBytes += offset - start
+			rangeDataRef.SizeBytes -= offset - start
+		}
+		if size > 0 && end > offset+size {
+			rangeDataRef.SizeBytes -= end - (offset + size)
+		}
+		if rangeDataRef.SizeBytes == dataRef.SizeBytes {
+			rangeDataRef.Hash = dataRef.Hash
+		}
+		result = append(result, rangeDataRef)
+		start = end
+	}
+	return result
+}
@@ -57,6 +57,7 @@ type Writer struct {
 	splitMask  uint64
 	noUpload   bool
 	createOpts CreateOptions
+	keys       *KeyRing
 
 	ctx                     context.Context
 	cancel                  context.CancelFunc
@@ -71,13 +72,14 @@ type Writer struct {
 	first, last             bool
 }
 
-func newWriter(ctx context.Context, client Client, memCache kv.GetPut, createOpts CreateOptions, cb WriterCallback, opts ...WriterOption) *Writer {
+func newWriter(ctx context.Context, client Client, memCache kv.GetPut, keys *KeyRing, createOpts CreateOptions, cb WriterCallback, opts ...WriterOption) *Writer {
 	cancelCtx, cancel := context.WithCancel(ctx)
 	w := &Writer{
 		cb:         cb,
 		client:     client,
 		memCache:   memCache,
 		createOpts: createOpts,
+		keys:       keys,
 		ctx:        cancelCtx,
 		cancel:     cancel,
 		chunkSize: &chunkSize{
@@ -268,7 +270,16 @@ func (w *Writer) maybeUpload(ctx context.Context, chunkBytes []byte, pointsTo []
 			return w.client.Create(ctx, md, data)
 		}
 	}
-	return Create(ctx, CreateOptions{}, chunkBytes, createFunc)
+	opts := w.createOpts
+	if w.keys != nil {
+		version, key, err := w.keys.Latest(ctx)
+		if err != nil {
+			return nil, err
+		}
+		opts.MasterKey = key
+		opts.KeyVersion = version
+	}
+	return Create(ctx, opts, chunkBytes, createFunc)
 }
 
 func (w *Writer) getPointsTo(annotations []*Annotation) (pointsTo []ID) {
@@ -312,8 +323,13 @@ func newDataRef(chunkRef *DataRef, chunkBytes []byte, offset, size int64) *DataR
 }
 
 // Copy copies a data reference to the writer.
+// The chunk reference is rewrapped with the latest master key if necessary.
 func (w *Writer) Copy(dataRef *DataRef) error {
 	return w.maybeDone(func() error {
+		dataRef, err := RewrapDataRef(w.ctx, w.keys, dataRef)
+		if err != nil {
+			return err
+		}
 		if err := w.maybeBufferDataRef(dataRef); err != nil {
 			return err
 		}
@@ -393,7 +409,7 @@ func (w *Writer) flushBuffer() error {
 
 func (w *Writer) flushDataRef(dataRef *DataRef) error {
 	buf := &bytes.Buffer{}
-	r := newDataReader(w.ctx, w.client, w.memCache, dataRef)
+	r := newDataReader(w.ctx, w.client, w.memCache, w.keys, dataRef)
 	if err := r.Get(buf); err != nil {
 		return err
 	}
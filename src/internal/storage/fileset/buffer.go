@@ -5,6 +5,8 @@ import (
 	"io"
 	"sort"
 	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 )
 
 type Buffer struct {
@@ -13,9 +15,10 @@ type Buffer struct {
 }
 
 type file struct {
-	path string
-	tag  string
-	buf  *bytes.Buffer
+	path       string
+	tag        string
+	attributes map[string]string
+	buf        *bytes.Buffer
 }
 
 func NewBuffer() *Buffer {
@@ -25,7 +28,7 @@ func NewBuffer() *Buffer {
 	}
 }
 
-func (b *Buffer) Add(path, tag string) io.Writer {
+func (b *Buffer) Add(path, tag string, opts ...FileOption) io.Writer {
 	path = Clean(path, false)
 	if _, ok := b.additive[path]; !ok {
 		b.additive[path] = make(map[string]*file)
@@ -39,6 +42,13 @@ func (b *Buffer) Add(path, tag string) io.Writer {
 		}
 	}
 	f := taggedFiles[tag]
+	if len(opts) > 0 {
+		idxFile := &index.File{Attributes: f.attributes}
+		for _, opt := range opts {
+			opt(idxFile)
+		}
+		f.attributes = idxFile.Attributes
+	}
 	return f.buf
 }
 
@@ -67,9 +77,9 @@ func (b *Buffer) Delete(path, tag string) {
 	}
 }
 
-func (b *Buffer) WalkAdditive(cb func(path, tag string, r io.Reader) error) error {
+func (b *Buffer) WalkAdditive(cb func(path, tag string, attributes map[string]string, r io.Reader) error) error {
 	for _, file := range sortFiles(b.additive) {
-		if err := cb(file.path, file.tag, bytes.NewReader(file.buf.Bytes())); err != nil {
+		if err := cb(file.path, file.tag, file.attributes, bytes.NewReader(file.buf.Bytes())); err != nil {
 			return err
 		}
 	}
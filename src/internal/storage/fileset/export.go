@@ -0,0 +1,69 @@
+package fileset
+
+import (
+	"context"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+)
+
+// ExportIndexes writes the indexes of the files in fs to w as length-delimited
+// index.Index messages, or the indexes of the deleted files if deletive is
+// true. The chunk encryption keys in the indexes are unwrapped, so they can be
+// imported by another cluster with ImportIndexes. cb is called with the ID of
+// each chunk referenced by the indexes, so that the chunks can be exported with
+// them.
+func (s *Storage) ExportIndexes(ctx context.Context, fs FileSet, w io.Writer, deletive bool, cb func(chunk.ID) error) error {
+	pw := pbutil.NewWriter(w)
+	return fs.Iterate(ctx, func(f File) error {
+		idx := proto.Clone(f.Index()).(*index.Index)
+		idx.Range = nil
+		if deletive {
+			idx.File.DataRefs = nil
+		}
+		for i, dataRef := range idx.File.DataRefs {
+			dataRef, err := s.chunks.Unwrap(ctx, dataRef)
+			if err != nil {
+				return err
+			}
+			if err := cb(dataRef.Ref.Id); err != nil {
+				return err
+			}
+			idx.File.DataRefs[i] = dataRef
+		}
+		_, err := pw.Write(idx)
+		return err
+	}, deletive)
+}
+
+// ImportIndexes writes the files with the indexes read from r, which were
+// written by ExportIndexes, to w. The chunks referenced by the indexes must
+// have been imported by a chunk.Importer that is still open.
+func (s *Storage) ImportIndexes(ctx context.Context, r io.Reader, w *Writer, deletive bool) error {
+	pr := pbutil.NewReader(r)
+	for {
+		idx := &index.Index{}
+		if err := pr.Read(idx); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if idx.File == nil {
+			return errors.Errorf("index for %s has no file", idx.Path)
+		}
+		if deletive {
+			if err := w.Delete(idx.Path, idx.File.Tag); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := w.Copy(newFileReader(ctx, s.chunks, idx), idx.File.Tag); err != nil {
+			return err
+		}
+	}
+}
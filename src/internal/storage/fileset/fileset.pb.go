@@ -159,12 +159,15 @@ func (m *Composite) GetLayers() []string {
 }
 
 type Primitive struct {
-	Deletive             *index.Index `protobuf:"bytes,1,opt,name=deletive,proto3" json:"deletive,omitempty"`
-	Additive             *index.Index `protobuf:"bytes,2,opt,name=additive,proto3" json:"additive,omitempty"`
-	SizeBytes            int64        `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Deletive  *index.Index `protobuf:"bytes,1,opt,name=deletive,proto3" json:"deletive,omitempty"`
+	Additive  *index.Index `protobuf:"bytes,2,opt,name=additive,proto3" json:"additive,omitempty"`
+	SizeBytes int64        `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// The lowest version of the chunk master key that wraps the chunk keys
+	// referenced by the fileset, or 0 if it is unknown.
+	KeyVersion           uint64   `protobuf:"varint,4,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Primitive) Reset()         { *m = Primitive{} }
@@ -221,6 +224,13 @@ func (m *Primitive) GetSizeBytes() int64 {
 	return 0
 }
 
+func (m *Primitive) GetKeyVersion() uint64 {
+	if m != nil {
+		return m.KeyVersion
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Metadata)(nil), "fileset.Metadata")
 	proto.RegisterType((*Composite)(nil), "fileset.Composite")
@@ -232,26 +242,27 @@ func init() {
 }
 
 var fileDescriptor_22dc3e2e3017d669 = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4a, 0xf4, 0x30,
-	0x10, 0xc7, 0xbf, 0x6c, 0xf9, 0x76, 0xb7, 0xd1, 0x53, 0x0e, 0x52, 0x04, 0x4b, 0xa9, 0x20, 0xc5,
-	0x43, 0x0b, 0xf5, 0xee, 0xa1, 0x5e, 0x14, 0x14, 0xa4, 0x47, 0x2f, 0x92, 0x36, 0xe3, 0x6e, 0xa0,
-	0x6d, 0x4a, 0x92, 0x2d, 0x56, 0xc1, 0xe7, 0xf3, 0xe8, 0x23, 0x48, 0x9f, 0x44, 0x62, 0x37, 0x75,
-	0x11, 0xf7, 0x32, 0xc9, 0xcc, 0xff, 0xff, 0x63, 0x32, 0x19, 0x7c, 0xc6, 0x1b, 0x0d, 0xb2, 0xa1,
-	0x55, 0xa2, 0xb4, 0x90, 0x74, 0x05, 0xc9, 0x13, 0xaf, 0x40, 0x81, 0xb6, 0x67, 0xdc, 0x4a, 0xa1,
-	0x05, 0x59, 0x6c, 0xd3, 0xe3, 0xf3, 0xbd, 0x00, 0x6f, 0x18, 0x3c, 0x8f, 0x71, 0x84, 0xc2, 0x57,
-	0xbc, 0xbc, 0x03, 0x4d, 0x19, 0xd5, 0x94, 0xa4, 0xd8, 0x6d, 0x25, 0xaf, 0xb9, 0xe6, 0x1d, 0x78,
-	0x28, 0x40, 0xd1, 0x41, 0x4a, 0x62, 0xdb, 0xe3, 0xde, 0x2a, 0xd7, 0xff, 0xf2, 0x1f, 0x9b, 0x61,
-	0x4a, 0x51, 0xb7, 0x42, 0x71, 0x0d, 0xde, 0xec, 0x17, 0x73, 0x65, 0x15, 0xc3, 0x4c, 0xb6, 0x6c,
-	0x81, 0xff, 0x77, 0xb4, 0xda, 0x40, 0x78, 0x8a, 0xdd, 0xc9, 0x42, 0x8e, 0xf0, 0xbc, 0xa2, 0x3d,
-	0x48, 0xe5, 0xa1, 0xc0, 0x89, 0xdc, 0x7c, 0x9b, 0x85, 0x6f, 0xd8, 0x9d, 0x7a, 0x93, 0x08, 0x2f,
-	0x19, 0x54, 0xb0, 0xf3, 0xc2, 0xc3, 0x78, 0x1c, 0xe7, 0xc6, 0xc4, 0x7c, 0x52, 0x8d, 0x93, 0x32,
-	0x36, 0xce, 0x32, 0xfb, 0xcb, 0x69, 0x55, 0x72, 0x82, 0xb1, 0xe2, 0x2f, 0xf0, 0x58, 0xf4, 0x1a,
-	0x94, 0xe7, 0x04, 0x28, 0x72, 0x72, 0xd7, 0x54, 0x32, 0x53, 0xc8, 0x6e, 0xdf, 0x07, 0x1f, 0x7d,
-	0x0c, 0x3e, 0xfa, 0x1c, 0x7c, 0xf4, 0x70, 0xb9, 0xe2, 0x7a, 0xbd, 0x29, 0xe2, 0x52, 0xd4, 0x49,
-	0x4b, 0xcb, 0x75, 0xcf, 0x40, 0xee, 0xde, 0xba, 0x34, 0x51, 0xb2, 0x4c, 0xf6, 0x6d, 0xa0, 0x98,
-	0x7f, 0x7f, 0xfb, 0xc5, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x29, 0x4f, 0xa3, 0xd5, 0x01,
-	0x00, 0x00,
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4a, 0xfb, 0x40,
+	0x10, 0xc6, 0xff, 0xdb, 0xf6, 0xdf, 0x36, 0x53, 0x4f, 0x7b, 0x90, 0x20, 0x58, 0x43, 0x05, 0x09,
+	0x1e, 0x12, 0xa8, 0x77, 0x0f, 0xf5, 0xa2, 0xa0, 0x20, 0x39, 0x78, 0xf0, 0x52, 0xb6, 0xd9, 0xb1,
+	0x5d, 0x9a, 0x64, 0xc3, 0xee, 0x36, 0x18, 0x7d, 0x18, 0x5f, 0xc7, 0xa3, 0x8f, 0x20, 0x79, 0x12,
+	0x59, 0xd3, 0xc4, 0x22, 0xf6, 0x32, 0xc9, 0x7c, 0xdf, 0xf7, 0x63, 0x76, 0x18, 0x38, 0x13, 0x99,
+	0x41, 0x95, 0xb1, 0x24, 0xd4, 0x46, 0x2a, 0xb6, 0xc4, 0xf0, 0x49, 0x24, 0xa8, 0xd1, 0x34, 0xdf,
+	0x20, 0x57, 0xd2, 0x48, 0x3a, 0xd8, 0xb6, 0x47, 0xe7, 0x7b, 0x01, 0x91, 0x71, 0x7c, 0xae, 0x6b,
+	0x0d, 0x4d, 0x5e, 0x61, 0x78, 0x87, 0x86, 0x71, 0x66, 0x18, 0x9d, 0x82, 0x93, 0x2b, 0x91, 0x0a,
+	0x23, 0x0a, 0x74, 0x89, 0x47, 0xfc, 0xd1, 0x94, 0x06, 0xcd, 0x8c, 0xfb, 0xc6, 0xb9, 0xfe, 0x17,
+	0xfd, 0xc4, 0x2c, 0x13, 0xcb, 0x34, 0x97, 0x5a, 0x18, 0x74, 0x3b, 0xbf, 0x98, 0xab, 0xc6, 0xb1,
+	0x4c, 0x1b, 0x9b, 0x0d, 0xe0, 0x7f, 0xc1, 0x92, 0x0d, 0x4e, 0x4e, 0xc1, 0x69, 0x23, 0xf4, 0x10,
+	0xfa, 0x09, 0x2b, 0x51, 0x69, 0x97, 0x78, 0x5d, 0xdf, 0x89, 0xb6, 0xdd, 0xe4, 0x8d, 0x80, 0xd3,
+	0x0e, 0xa7, 0x3e, 0x0c, 0x39, 0x26, 0xb8, 0xf3, 0xc4, 0x83, 0xa0, 0xde, 0xe7, 0xc6, 0xd6, 0xa8,
+	0x75, 0x6d, 0x92, 0x71, 0x5e, 0x2f, 0xd3, 0xf9, 0x2b, 0xd9, 0xb8, 0xf4, 0x18, 0x40, 0x8b, 0x17,
+	0x9c, 0x2f, 0x4a, 0x83, 0xda, 0xed, 0x7a, 0xc4, 0xef, 0x46, 0x8e, 0x55, 0x66, 0x56, 0xa0, 0x27,
+	0x30, 0x5a, 0x63, 0x39, 0x2f, 0x50, 0x69, 0x21, 0x33, 0xb7, 0xe7, 0x11, 0xbf, 0x17, 0xc1, 0x1a,
+	0xcb, 0x87, 0x5a, 0x99, 0xdd, 0xbe, 0x57, 0x63, 0xf2, 0x51, 0x8d, 0xc9, 0x67, 0x35, 0x26, 0x8f,
+	0x97, 0x4b, 0x61, 0x56, 0x9b, 0x45, 0x10, 0xcb, 0x34, 0xcc, 0x59, 0xbc, 0x2a, 0x39, 0xaa, 0xdd,
+	0xbf, 0x62, 0x1a, 0x6a, 0x15, 0x87, 0xfb, 0x6e, 0xb4, 0xe8, 0x7f, 0x1f, 0xe6, 0xe2, 0x6b, 0x00,
+	0x69, 0x7e, 0xf9, 0x74, 0xf7, 0x01, 0x00, 0x00,
 }
 
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
@@ -392,6 +403,11 @@ func (m *Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.KeyVersion != 0 {
+		i = encodeVarintFileset(dAtA, i, uint64(m.KeyVersion))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.SizeBytes != 0 {
 		i = encodeVarintFileset(dAtA, i, uint64(m.SizeBytes))
 		i--
@@ -509,6 +525,9 @@ func (m *Primitive) Size() (n int) {
 	if m.SizeBytes != 0 {
 		n += 1 + sovFileset(uint64(m.SizeBytes))
 	}
+	if m.KeyVersion != 0 {
+		n += 1 + sovFileset(uint64(m.KeyVersion))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -845,6 +864,25 @@ func (m *Primitive) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyVersion", wireType)
+			}
+			m.KeyVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFileset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFileset(dAtA[iNdEx:])
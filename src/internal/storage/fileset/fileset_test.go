@@ -7,6 +7,7 @@ import (
 	"io"
 	"math/rand"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -259,3 +260,137 @@ func TestStableHash(t *testing.T) {
 	}
 	require.True(t, bytes.Equal(stableHash, getHash()), msg)
 }
+
+func TestAttributes(t *testing.T) {
+	ctx := context.Background()
+	storage := newTestStorage(t)
+	write := func(cb func(uw *UnorderedWriter)) ID {
+		uw, err := storage.NewUnorderedWriter(ctx)
+		require.NoError(t, err)
+		cb(uw)
+		id, err := uw.Close()
+		require.NoError(t, err)
+		return *id
+	}
+	readAttributes := func(ids ...ID) map[string]map[string]string {
+		fs, err := storage.Open(ctx, ids)
+		require.NoError(t, err)
+		attributes := make(map[string]map[string]string)
+		require.NoError(t, fs.Iterate(ctx, func(f File) error {
+			attributes[f.Index().Path] = f.Index().File.Attributes
+			return nil
+		}))
+		return attributes
+	}
+	id1 := write(func(uw *UnorderedWriter) {
+		require.NoError(t, uw.Put("a", "", true, strings.NewReader("a"), WithAttributes(map[string]string{"x": "1", "y": "1"})))
+		require.NoError(t, uw.Put("b", "", true, strings.NewReader("b"), WithAttributes(map[string]string{"x": "1"})))
+		require.NoError(t, uw.Put("c", "", true, strings.NewReader("c")))
+	})
+	id2 := write(func(uw *UnorderedWriter) {
+		// Appends merge attributes, overwrites replace them.
+		require.NoError(t, uw.Put("a", "", true, strings.NewReader("a"), WithAttributes(map[string]string{"y": "2"})))
+		require.NoError(t, uw.Put("b", "", false, strings.NewReader("b")))
+		require.NoError(t, uw.Put("c", "", true, strings.NewReader("c"), WithAttributes(map[string]string{"z": "2"})))
+	})
+	expected := map[string]map[string]string{
+		"/a": {"x": "1", "y": "2"},
+		"/b": nil,
+		"/c": {"z": "2"},
+	}
+	require.Equal(t, expected, readAttributes(id1, id2))
+	id, err := storage.Compact(ctx, []ID{id1, id2}, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, expected, readAttributes(*id))
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	storage := NewTestStorage(t, db, tr)
+	check := func(id ID) []error {
+		var problems []error
+		require.NoError(t, storage.NewChecker().Check(ctx, id, func(err error) error {
+			problems = append(problems, err)
+			return nil
+		}))
+		return problems
+	}
+	id1 := writeFileSet(t, storage, []*testFile{{path: "/a", data: []byte("a")}})
+	id2 := writeFileSet(t, storage, []*testFile{{path: "/b", data: []byte("b")}})
+	id, err := storage.Compose(ctx, []ID{id1, id2}, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(check(*id)))
+	require.Equal(t, 1, len(check(newID())))
+	// Losing the chunk objects breaks the file sets that reference them.
+	db.MustExec(`DELETE FROM storage.chunk_objects`)
+	require.True(t, len(check(*id)) > 0)
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	newStorage := func() *Storage {
+		db := testutil.NewTestDB(t)
+		tr := track.NewTestTracker(t, db)
+		return NewTestStorage(t, db, tr, chunk.WithKeyStore(chunk.NewPostgresKeyStore(db), "test"))
+	}
+	src, dst := newStorage(), newStorage()
+	uw, err := src.NewUnorderedWriter(ctx)
+	require.NoError(t, err)
+	require.NoError(t, uw.Put("a", "", true, strings.NewReader("a")))
+	require.NoError(t, uw.Put("b", "", true, strings.NewReader("b"), WithAttributes(map[string]string{"x": "1"})))
+	require.NoError(t, uw.Delete("c", ""))
+	id, err := uw.Close()
+	require.NoError(t, err)
+	fs, err := src.Open(ctx, []ID{*id})
+	require.NoError(t, err)
+	// Export the indexes and the chunks they reference.
+	var additive, deletive bytes.Buffer
+	chunks := make(map[string][]byte)
+	exportChunk := func(chunkID chunk.ID) error {
+		return src.ChunkStorage().Export(ctx, chunkID, func(data []byte) error {
+			chunks[string(chunkID)] = append([]byte{}, data...)
+			return nil
+		})
+	}
+	require.NoError(t, src.ExportIndexes(ctx, fs, &additive, false, exportChunk))
+	require.NoError(t, src.ExportIndexes(ctx, fs, &deletive, true, exportChunk))
+	// Import them into storage with a different master key.
+	importer := dst.ChunkStorage().NewImporter("test")
+	defer importer.Close()
+	for chunkID, data := range chunks {
+		uploaded, err := importer.Import(ctx, chunk.ID(chunkID), data)
+		require.NoError(t, err)
+		require.True(t, uploaded)
+	}
+	w := dst.NewWriter(ctx)
+	require.NoError(t, dst.ImportIndexes(ctx, &deletive, w, true))
+	require.NoError(t, dst.ImportIndexes(ctx, &additive, w, false))
+	importedID, err := w.Close()
+	require.NoError(t, err)
+	// Importing an existing chunk skips the upload.
+	for chunkID, data := range chunks {
+		uploaded, err := importer.Import(ctx, chunk.ID(chunkID), data)
+		require.NoError(t, err)
+		require.False(t, uploaded)
+	}
+	fs, err = dst.Open(ctx, []ID{*importedID})
+	require.NoError(t, err)
+	contents := make(map[string]string)
+	require.NoError(t, fs.Iterate(ctx, func(f File) error {
+		buf := &bytes.Buffer{}
+		if err := f.Content(buf); err != nil {
+			return err
+		}
+		contents[f.Index().Path] = buf.String()
+		return nil
+	}))
+	require.Equal(t, map[string]string{"/a": "a", "/b": "b"}, contents)
+	var deleted []string
+	require.NoError(t, fs.Iterate(ctx, func(f File) error {
+		deleted = append(deleted, f.Index().Path)
+		return nil
+	}, true))
+	require.Equal(t, []string{"/c"}, deleted)
+}
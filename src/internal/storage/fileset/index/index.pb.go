@@ -151,11 +151,15 @@ func (m *Range) GetChunkRef() *chunk.DataRef {
 }
 
 type File struct {
-	Tag                  string           `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	DataRefs             []*chunk.DataRef `protobuf:"bytes,2,rep,name=data_refs,json=dataRefs,proto3" json:"data_refs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Tag      string           `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	DataRefs []*chunk.DataRef `protobuf:"bytes,2,rep,name=data_refs,json=dataRefs,proto3" json:"data_refs,omitempty"`
+	// attributes are user defined key/value pairs describing the file, such as
+	// its content type. The attributes of a file merged from several layers are
+	// the union of the layers' attributes, with later layers taking precedence.
+	Attributes           map[string]string `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *File) Reset()         { *m = File{} }
@@ -205,10 +209,18 @@ func (m *File) GetDataRefs() []*chunk.DataRef {
 	return nil
 }
 
+func (m *File) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Index)(nil), "index.Index")
 	proto.RegisterType((*Range)(nil), "index.Range")
 	proto.RegisterType((*File)(nil), "index.File")
+	proto.RegisterMapType((map[string]string)(nil), "index.File.AttributesEntry")
 }
 
 func init() {
@@ -216,26 +228,30 @@ func init() {
 }
 
 var fileDescriptor_dfa1b84c403551af = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xcd, 0x4a, 0xc4, 0x30,
-	0x10, 0x26, 0xfd, 0x59, 0xb6, 0x59, 0x11, 0xc9, 0x41, 0x8a, 0x42, 0x2d, 0x3d, 0x2d, 0x0a, 0x0d,
-	0xac, 0x6f, 0x20, 0xab, 0xe0, 0x4d, 0x72, 0xf4, 0xb2, 0x66, 0xdb, 0x49, 0x1b, 0xac, 0x6d, 0x49,
-	0xb2, 0xa2, 0x6f, 0xe8, 0xd1, 0x47, 0x90, 0x3e, 0x89, 0x24, 0xe9, 0x41, 0x50, 0xbc, 0x0c, 0xdf,
-	0xcc, 0x7c, 0x33, 0xdf, 0x37, 0x09, 0xbe, 0x94, 0xbd, 0x01, 0xd5, 0xf3, 0x8e, 0x6a, 0x33, 0x28,
-	0xde, 0x00, 0x15, 0xb2, 0x03, 0x0d, 0x86, 0xca, 0xbe, 0x86, 0x37, 0x1f, 0xcb, 0x51, 0x0d, 0x66,
-	0x20, 0xb1, 0x4b, 0xce, 0x8a, 0x5f, 0x23, 0x55, 0x7b, 0xe8, 0x9f, 0x7d, 0xf4, 0xd4, 0xe2, 0x09,
-	0xc7, 0xf7, 0x96, 0x4c, 0x08, 0x8e, 0x46, 0x6e, 0xda, 0x14, 0xe5, 0x68, 0x9d, 0x30, 0x87, 0x49,
-	0x81, 0x63, 0xc5, 0xfb, 0x06, 0xd2, 0x20, 0x47, 0xeb, 0xd5, 0xe6, 0xa8, 0xf4, 0x22, 0xcc, 0xd6,
-	0x98, 0x6f, 0x91, 0x0b, 0x1c, 0x59, 0x23, 0x69, 0xe8, 0x28, 0xab, 0x99, 0x72, 0x27, 0x3b, 0x60,
-	0xae, 0x51, 0x48, 0x1c, 0xbb, 0x01, 0x72, 0x8a, 0x17, 0x83, 0x10, 0x1a, 0x8c, 0xd3, 0x08, 0xd9,
-	0x9c, 0x91, 0x73, 0x9c, 0x74, 0x5c, 0x9b, 0x9d, 0x93, 0x0f, 0x9c, 0xfc, 0xd2, 0x16, 0x1e, 0xac,
-	0x85, 0x2b, 0x9c, 0x38, 0xbb, 0x3b, 0x05, 0x62, 0xd6, 0x38, 0x2e, 0xfd, 0x01, 0x5b, 0x6e, 0x38,
-	0x03, 0xc1, 0x96, 0x2e, 0x65, 0x20, 0x8a, 0x5b, 0x1c, 0x59, 0x61, 0x72, 0x82, 0x43, 0xc3, 0x9b,
-	0xf9, 0x14, 0x0b, 0xed, 0x9a, 0x9a, 0x1b, 0x6e, 0xb7, 0xe8, 0x34, 0xc8, 0xc3, 0xbf, 0xd6, 0xd4,
-	0x1e, 0xe8, 0x1b, 0xf6, 0x31, 0x65, 0xe8, 0x73, 0xca, 0xd0, 0xd7, 0x94, 0xa1, 0xc7, 0x6d, 0x23,
-	0x4d, 0x7b, 0xd8, 0x97, 0xd5, 0xf0, 0x42, 0x47, 0x5e, 0xb5, 0xef, 0x35, 0xa8, 0x9f, 0xe8, 0x75,
-	0x43, 0xb5, 0xaa, 0xe8, 0xff, 0xdf, 0xb3, 0x5f, 0xb8, 0xe7, 0xbe, 0xfe, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0xc3, 0x95, 0x66, 0xf1, 0xc7, 0x01, 0x00, 0x00,
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4d, 0x6b, 0xe3, 0x30,
+	0x14, 0x44, 0x76, 0x1c, 0x12, 0x65, 0xd9, 0x5d, 0xc4, 0xb2, 0x98, 0x04, 0xb2, 0xc1, 0xa7, 0xb0,
+	0x0b, 0x36, 0x64, 0x2f, 0xa5, 0xa5, 0x87, 0x96, 0xb4, 0xd0, 0x5b, 0xd1, 0xb1, 0x97, 0x54, 0xb1,
+	0x9f, 0x3f, 0x88, 0x6b, 0x07, 0xe9, 0x39, 0xd4, 0x3f, 0xaf, 0xb7, 0x1e, 0xfb, 0x13, 0x4a, 0x7e,
+	0x49, 0x91, 0xe4, 0x96, 0xd0, 0x96, 0x5e, 0xc4, 0x1b, 0xcd, 0xe8, 0xcd, 0x0c, 0x36, 0xfd, 0x5b,
+	0x54, 0x08, 0xb2, 0x12, 0x65, 0xa4, 0xb0, 0x96, 0x22, 0x83, 0x28, 0x2d, 0x4a, 0x50, 0x80, 0x51,
+	0x51, 0x25, 0x70, 0x6f, 0xcf, 0x70, 0x2b, 0x6b, 0xac, 0x99, 0x67, 0xc0, 0x38, 0xf8, 0xf0, 0x24,
+	0xce, 0x9b, 0x6a, 0x63, 0x4f, 0x2b, 0x0d, 0x6e, 0xa9, 0x77, 0xa5, 0xc5, 0x8c, 0xd1, 0xde, 0x56,
+	0x60, 0xee, 0x93, 0x19, 0x99, 0x0f, 0xb9, 0x99, 0x59, 0x40, 0x3d, 0x29, 0xaa, 0x0c, 0x7c, 0x67,
+	0x46, 0xe6, 0xa3, 0xc5, 0xb7, 0xd0, 0x9a, 0x70, 0x7d, 0xc7, 0x2d, 0xc5, 0xfe, 0xd0, 0x9e, 0x0e,
+	0xe2, 0xbb, 0x46, 0x32, 0xea, 0x24, 0x97, 0x45, 0x09, 0xdc, 0x10, 0x41, 0x41, 0x3d, 0xf3, 0x80,
+	0xfd, 0xa6, 0xfd, 0x3a, 0x4d, 0x15, 0xa0, 0xf1, 0x70, 0x79, 0x87, 0xd8, 0x84, 0x0e, 0x4b, 0xa1,
+	0x70, 0x65, 0xec, 0x1d, 0x63, 0x3f, 0xd0, 0x17, 0xd7, 0x3a, 0xc2, 0x3f, 0x3a, 0x34, 0x71, 0x57,
+	0x12, 0xd2, 0xce, 0xe3, 0x7b, 0x68, 0x0b, 0x2c, 0x05, 0x0a, 0x0e, 0x29, 0x1f, 0x18, 0xc8, 0x21,
+	0x0d, 0x1e, 0x08, 0xed, 0x69, 0x67, 0xf6, 0x93, 0xba, 0x28, 0xb2, 0xae, 0x8b, 0x1e, 0xf5, 0x9e,
+	0x44, 0xa0, 0xd0, 0x6b, 0x94, 0xef, 0xcc, 0xdc, 0xcf, 0xf6, 0x24, 0x76, 0x50, 0xec, 0x84, 0x52,
+	0x81, 0x28, 0x8b, 0x75, 0x83, 0xa0, 0x7c, 0xd7, 0xa8, 0x27, 0x07, 0xcd, 0xc2, 0xb3, 0x37, 0xf6,
+	0xa2, 0x42, 0xd9, 0xf2, 0x03, 0xf9, 0xf8, 0x94, 0xfe, 0x78, 0x47, 0xeb, 0x38, 0x1b, 0x68, 0x5f,
+	0xe3, 0x6c, 0xa0, 0x65, 0xbf, 0xa8, 0xb7, 0x13, 0x65, 0x03, 0x5d, 0x5f, 0x0b, 0x8e, 0x9d, 0x23,
+	0x72, 0xce, 0x1f, 0xf7, 0x53, 0xf2, 0xb4, 0x9f, 0x92, 0xe7, 0xfd, 0x94, 0xdc, 0x2c, 0xb3, 0x02,
+	0xf3, 0x66, 0x1d, 0xc6, 0xf5, 0x5d, 0xb4, 0x15, 0x71, 0xde, 0x26, 0x20, 0x0f, 0xa7, 0xdd, 0x22,
+	0x52, 0x32, 0x8e, 0xbe, 0xfe, 0x37, 0xd6, 0x7d, 0xf3, 0xad, 0xff, 0xbf, 0x0c, 0x00, 0xf9, 0x6b,
+	0x2c, 0xb2, 0x44, 0x02, 0x00, 0x00,
 }
 
 func (m *Index) Marshal() (dAtA []byte, err error) {
@@ -371,6 +387,25 @@ func (m *File) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintIndex(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintIndex(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintIndex(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DataRefs) > 0 {
 		for iNdEx := len(m.DataRefs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -469,6 +504,14 @@ func (m *File) Size() (n int) {
 			n += 1 + l + sovIndex(uint64(l))
 		}
 	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovIndex(uint64(len(k))) + 1 + len(v) + sovIndex(uint64(len(v)))
+			n += mapEntrySize + 1 + sovIndex(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -869,6 +912,133 @@ func (m *File) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIndex
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIndex
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthIndex
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthIndex
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIndex
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthIndex
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthIndex
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipIndex(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthIndex
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIndex(dAtA[iNdEx:])
@@ -112,6 +112,18 @@ func Check(t *testing.T, permString string) {
 		actual = actualFiles(t, topIdx, chunks, WithRange(pathRange(expected)))
 		require.Equal(t, expected, actual)
 	})
+	t.Run("PrefixAndRange", func(t *testing.T) {
+		lower := fileNames[len(fileNames)/2]
+		prefix := string(lower[0])
+		expected := []string{}
+		for _, fileName := range expectedFiles(fileNames, prefix) {
+			if fileName >= lower {
+				expected = append(expected, fileName)
+			}
+		}
+		actual := actualFiles(t, topIdx, chunks, WithPrefix(prefix), WithRange(&PathRange{Lower: lower}))
+		require.Equal(t, expected, actual)
+	})
 }
 
 func TestSingleLevel(t *testing.T) {
@@ -9,17 +9,25 @@ type PathRange struct {
 	Lower, Upper string
 }
 
-// WithRange sets a range filter for the read.
+// WithRange sets a range filter for the read, which may be combined with a
+// prefix filter.
 func WithRange(pathRange *PathRange) Option {
 	return func(r *Reader) {
-		r.filter = &pathFilter{pathRange: pathRange}
+		if r.filter == nil {
+			r.filter = &pathFilter{}
+		}
+		r.filter.pathRange = pathRange
 	}
 }
 
-// WithPrefix sets a prefix filter for the read.
+// WithPrefix sets a prefix filter for the read, which may be combined with a
+// range filter.
 func WithPrefix(prefix string) Option {
 	return func(r *Reader) {
-		r.filter = &pathFilter{prefix: prefix}
+		if r.filter == nil {
+			r.filter = &pathFilter{}
+		}
+		r.filter.prefix = prefix
 	}
 }
 
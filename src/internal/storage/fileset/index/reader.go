@@ -96,8 +96,8 @@ func (r *Reader) atStart(name string) bool {
 	if r.filter == nil {
 		return true
 	}
-	if r.filter.pathRange != nil && r.filter.pathRange.Lower != "" {
-		return name >= r.filter.pathRange.Lower
+	if r.filter.pathRange != nil && r.filter.pathRange.Lower != "" && name < r.filter.pathRange.Lower {
+		return false
 	}
 	return name >= r.filter.prefix
 }
@@ -109,8 +109,8 @@ func (r *Reader) atEnd(name string) bool {
 	if r.filter == nil {
 		return false
 	}
-	if r.filter.pathRange != nil && r.filter.pathRange.Upper != "" {
-		return name > r.filter.pathRange.Upper
+	if r.filter.pathRange != nil && r.filter.pathRange.Upper != "" && name > r.filter.pathRange.Upper {
+		return true
 	}
 	// Name is past a prefix when the first len(prefix) bytes are greater than the prefix
 	// (use len(name) bytes for comparison when len(name) < len(prefix)).
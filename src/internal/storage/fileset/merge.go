@@ -58,24 +58,44 @@ func (mr *MergeReader) iterate(ctx context.Context, cb func(File) error) error {
 			return cb(newFileReader(ctx, mr.chunks, fss[0].file.Index()))
 		}
 		var dataRefs []*chunk.DataRef
+		var attributes map[string]string
 		for i, fs := range fss {
 			if fs.deletive {
 				if i == len(fss)-1 {
 					return nil
 				}
 				dataRefs = nil
+				attributes = nil
 				continue
 			}
 			idx := fs.file.Index()
 			dataRefs = append(dataRefs, idx.File.DataRefs...)
+			attributes = mergeAttributes(attributes, idx.File.Attributes)
 		}
 		mergeIdx := fss[0].file.Index()
 		mergeIdx.File.DataRefs = dataRefs
+		mergeIdx.File.Attributes = attributes
 		return cb(newMergeFileReader(ctx, mr.chunks, mergeIdx))
 
 	})
 }
 
+// mergeAttributes returns the union of the attributes x and y, with y taking
+// precedence.
+func mergeAttributes(x, y map[string]string) map[string]string {
+	if len(y) == 0 {
+		return x
+	}
+	result := make(map[string]string, len(x)+len(y))
+	for key, value := range x {
+		result[key] = value
+	}
+	for key, value := range y {
+		result[key] = value
+	}
+	return result
+}
+
 func (mr *MergeReader) iterateDeletive(ctx context.Context, cb func(File) error) error {
 	var ss []stream.Stream
 	for _, fs := range mr.fileSets {
@@ -26,6 +26,8 @@ type MetadataStore interface {
 	SetTx(tx *sqlx.Tx, id ID, md *Metadata) error
 	Get(ctx context.Context, id ID) (*Metadata, error)
 	GetTx(tx *sqlx.Tx, id ID) (*Metadata, error)
+	UpdateTx(tx *sqlx.Tx, id ID, md *Metadata) error
+	Walk(ctx context.Context, cb func(ID, *Metadata) error) error
 	DeleteTx(tx *sqlx.Tx, id ID) error
 }
 
@@ -51,6 +53,30 @@ func StoreTestSuite(t *testing.T, newStore func(t testing.TB) MetadataStore) {
 		_, err := x.Get(ctx, testID)
 		require.Equal(t, ErrFileSetNotExists, err)
 	})
+	t.Run("Update", func(t *testing.T) {
+		x := newStore(t)
+		testID := newID()
+		md := &Metadata{Value: &Metadata_Primitive{Primitive: &Primitive{SizeBytes: 1}}}
+		require.Equal(t, ErrFileSetNotExists, updateMetadata(ctx, x, testID, md))
+		require.NoError(t, setMetadata(ctx, x, testID, &Metadata{}))
+		require.NoError(t, updateMetadata(ctx, x, testID, md))
+		actual, err := x.Get(ctx, testID)
+		require.NoError(t, err)
+		require.Equal(t, md, actual)
+	})
+	t.Run("Walk", func(t *testing.T) {
+		x := newStore(t)
+		ids := []ID{newID(), newID()}
+		for _, id := range ids {
+			require.NoError(t, setMetadata(ctx, x, id, &Metadata{}))
+		}
+		var actual []ID
+		require.NoError(t, x.Walk(ctx, func(id ID, _ *Metadata) error {
+			actual = append(actual, id)
+			return nil
+		}))
+		require.ElementsEqual(t, ids, actual)
+	})
 }
 
 func setMetadata(ctx context.Context, mds MetadataStore, id ID, md *Metadata) error {
@@ -59,6 +85,12 @@ func setMetadata(ctx context.Context, mds MetadataStore, id ID, md *Metadata) er
 	})
 }
 
+func updateMetadata(ctx context.Context, mds MetadataStore, id ID, md *Metadata) error {
+	return dbutil.WithTx(ctx, mds.DB(), func(tx *sqlx.Tx) error {
+		return mds.UpdateTx(tx, id, md)
+	})
+}
+
 func deleteMetadata(ctx context.Context, mds MetadataStore, id ID) error {
 	return dbutil.WithTx(ctx, mds.DB(), func(tx *sqlx.Tx) error {
 		return mds.DeleteTx(tx, id)
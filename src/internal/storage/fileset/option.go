@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
 	"golang.org/x/sync/semaphore"
@@ -71,6 +72,15 @@ func WithValidator(validator func(string) error) UnorderedWriterOption {
 	}
 }
 
+// WithCompression sets the compression algorithm and zstd compression level
+// used for the chunks written by the unordered writer, overriding the chunk
+// storage's.
+func WithCompression(algo chunk.CompressionAlgo, level int) UnorderedWriterOption {
+	return func(uw *UnorderedWriter) {
+		uw.chunkWriterOpts = append(uw.chunkWriterOpts, chunk.WithWriterCompression(algo, level))
+	}
+}
+
 // WriterOption configures a file set writer.
 type WriterOption func(w *Writer)
 
@@ -89,6 +99,20 @@ func WithTTL(ttl time.Duration) WriterOption {
 	}
 }
 
+// WithWriterCompression sets the compression algorithm and zstd compression
+// level used for the chunks written by the writer, overriding the chunk
+// storage's.
+func WithWriterCompression(algo chunk.CompressionAlgo, level int) WriterOption {
+	return withChunkWriterOptions(chunk.WithWriterCompression(algo, level))
+}
+
+// withChunkWriterOptions sets the options for the writer's chunk writer.
+func withChunkWriterOptions(opts ...chunk.WriterOption) WriterOption {
+	return func(w *Writer) {
+		w.chunkWriterOpts = append(w.chunkWriterOpts, opts...)
+	}
+}
+
 // StorageOptions returns the fileset storage options for the config.
 func StorageOptions(conf *serviceenv.Configuration) []StorageOption {
 	var opts []StorageOption
@@ -103,3 +127,22 @@ func StorageOptions(conf *serviceenv.Configuration) []StorageOption {
 	}
 	return opts
 }
+
+// FileOption configures a file written to a file set.
+type FileOption func(*index.File)
+
+// WithAttributes adds attributes to a file, replacing the values of any
+// attributes it already has with the same keys.
+func WithAttributes(attributes map[string]string) FileOption {
+	return func(f *index.File) {
+		if len(attributes) == 0 {
+			return
+		}
+		if f.Attributes == nil {
+			f.Attributes = make(map[string]string)
+		}
+		for key, value := range attributes {
+			f.Attributes[key] = value
+		}
+	}
+}
@@ -76,6 +76,52 @@ func (s *postgresStore) GetTx(tx *sqlx.Tx, id ID) (*Metadata, error) {
 	return s.get(context.Background(), tx, id)
 }
 
+func (s *postgresStore) UpdateTx(tx *sqlx.Tx, id ID, md *Metadata) error {
+	data, err := proto.Marshal(md)
+	if err != nil {
+		return err
+	}
+	res, err := tx.Exec(`UPDATE storage.filesets SET metadata_pb = $2 WHERE id = $1`, id, data)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrFileSetNotExists
+	}
+	return nil
+}
+
+func (s *postgresStore) Walk(ctx context.Context, cb func(ID, *Metadata) error) (retErr error) {
+	rows, err := s.db.QueryxContext(ctx, `SELECT id, metadata_pb FROM storage.filesets`)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rows.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	for rows.Next() {
+		var id ID
+		var mdData []byte
+		if err := rows.Scan(&id, &mdData); err != nil {
+			return err
+		}
+		md := &Metadata{}
+		if err := proto.Unmarshal(mdData, md); err != nil {
+			return err
+		}
+		if err := cb(id, md); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 func (s *postgresStore) DeleteTx(tx *sqlx.Tx, id ID) error {
 	_, err := tx.Exec(`DELETE FROM storage.filesets WHERE id = $1`, id)
 	return err
@@ -0,0 +1,188 @@
+package fileset
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/sirupsen/logrus"
+)
+
+// rewrapGracePeriod is how long the index chunks replaced by a rewrap are
+// kept, so that concurrent readers of the fileset can finish.
+const rewrapGracePeriod = 30 * time.Minute
+
+// RewrapProgress is the progress of rewrapping the filesets with the latest
+// version of the chunk master key.
+type RewrapProgress struct {
+	// KeyVersion is the latest version of the chunk master key.
+	KeyVersion uint64
+	// FileSets is the number of primitive filesets.
+	FileSets int64
+	// Rewrapped is the number of primitive filesets which only reference
+	// chunks wrapped by the latest version of the chunk master key.
+	Rewrapped int64
+}
+
+// Rewrapper moves filesets to the latest version of the chunk master key, by
+// rewriting their indexes with rewrapped chunk references.
+type Rewrapper struct {
+	s   *Storage
+	log *logrus.Logger
+}
+
+// NewRewrapper returns a new rewrapper operating on s
+func NewRewrapper(s *Storage) *Rewrapper {
+	return &Rewrapper{s: s, log: logrus.StandardLogger()}
+}
+
+// RunForever calls RunOnce until the context is cancelled, logging any errors.
+func (r *Rewrapper) RunForever(ctx context.Context) error {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		if err := r.RunOnce(ctx); err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			r.log.Errorf("during fileset rewrap: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce rewraps the filesets which reference chunks wrapped by an older
+// version of the chunk master key.
+func (r *Rewrapper) RunOnce(ctx context.Context) error {
+	version, err := r.s.chunks.KeyVersion(ctx)
+	if err != nil || version == 0 {
+		return err
+	}
+	var ids []ID
+	if err := r.s.store.Walk(ctx, func(id ID, md *Metadata) error {
+		if prim := md.GetPrimitive(); prim != nil && prim.KeyVersion < version {
+			ids = append(ids, id)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := r.s.rewrap(ctx, id); err != nil {
+			return errors.Wrapf(err, "rewrapping fileset %v", id)
+		}
+		r.log.WithFields(logrus.Fields{
+			"fileset":     id.HexString(),
+			"key_version": version,
+		}).Debugf("rewrapped fileset")
+	}
+	return nil
+}
+
+// Progress returns the progress of rewrapping the filesets with the latest
+// version of the chunk master key.
+func (r *Rewrapper) Progress(ctx context.Context) (*RewrapProgress, error) {
+	version, err := r.s.chunks.KeyVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	progress := &RewrapProgress{KeyVersion: version}
+	if err := r.s.store.Walk(ctx, func(_ ID, md *Metadata) error {
+		if prim := md.GetPrimitive(); prim != nil {
+			progress.FileSets++
+			if prim.KeyVersion >= version {
+				progress.Rewrapped++
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
+
+// rewrap rewrites the indexes of the primitive fileset with id, so that they
+// only reference chunks wrapped by the latest version of the chunk master key.
+func (s *Storage) rewrap(ctx context.Context, id ID) error {
+	version, err := s.chunks.KeyVersion(ctx)
+	if err != nil {
+		return err
+	}
+	md, err := s.store.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrFileSetNotExists) {
+			return nil
+		}
+		return err
+	}
+	prim := md.GetPrimitive()
+	if prim == nil || prim.KeyVersion >= version {
+		return nil
+	}
+	additive, err := s.rewrapIndex(ctx, prim.Additive, "additive-index-rewrapper")
+	if err != nil {
+		return err
+	}
+	deletive, err := s.rewrapIndex(ctx, prim.Deletive, "deletive-index-rewrapper")
+	if err != nil {
+		return err
+	}
+	for _, chunkID := range prim.PointsTo() {
+		if _, err := s.tracker.SetTTLPrefix(ctx, chunkID.TrackerID(), rewrapGracePeriod); err != nil {
+			return err
+		}
+	}
+	rewrapped := &Primitive{
+		Additive:   additive,
+		Deletive:   deletive,
+		SizeBytes:  prim.SizeBytes,
+		KeyVersion: version,
+	}
+	var pointsTo []string
+	for _, chunkID := range rewrapped.PointsTo() {
+		pointsTo = append(pointsTo, chunkID.TrackerID())
+	}
+	return dbutil.WithTx(ctx, s.store.DB(), func(tx *sqlx.Tx) error {
+		if err := s.store.UpdateTx(tx, id, &Metadata{
+			Value: &Metadata_Primitive{
+				Primitive: rewrapped,
+			},
+		}); err != nil {
+			return err
+		}
+		return s.tracker.ReplaceTx(tx, id.TrackerID(), pointsTo)
+	})
+}
+
+func (s *Storage) rewrapIndex(ctx context.Context, topIdx *index.Index, tmpID string) (*index.Index, error) {
+	if topIdx == nil {
+		return nil, nil
+	}
+	iw := index.NewWriter(ctx, s.chunks, tmpID)
+	ir := index.NewReader(s.chunks, topIdx)
+	if err := ir.Iterate(ctx, func(idx *index.Index) error {
+		var dataRefs []*chunk.DataRef
+		for _, dataRef := range idx.File.DataRefs {
+			dataRef, err := s.chunks.Rewrap(ctx, dataRef)
+			if err != nil {
+				return err
+			}
+			dataRefs = append(dataRefs, dataRef)
+		}
+		idx.File.DataRefs = dataRefs
+		return iw.WriteIndex(idx)
+	}); err != nil {
+		return nil, err
+	}
+	return iw.Close()
+}
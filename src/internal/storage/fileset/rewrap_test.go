@@ -0,0 +1,56 @@
+package fileset
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+)
+
+func TestRewrap(t *testing.T) {
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	s := NewTestStorage(t, db, tr, chunk.WithKeyStore(chunk.NewPostgresKeyStore(db), "test"))
+	w := s.NewWriter(ctx, WithTTL(time.Hour))
+	require.NoError(t, w.Add("a.txt", DefaultFileTag, strings.NewReader("test data")))
+	require.NoError(t, w.Delete("b.txt", DefaultFileTag))
+	id, err := w.Close()
+	require.NoError(t, err)
+	checkKeyVersion := func(version uint64) {
+		prim, err := s.getPrimitive(ctx, *id)
+		require.NoError(t, err)
+		require.Equal(t, version, prim.KeyVersion)
+		fs, err := s.Open(ctx, []ID{*id})
+		require.NoError(t, err)
+		require.NoError(t, fs.Iterate(ctx, func(f File) error {
+			for _, dataRef := range f.Index().File.DataRefs {
+				require.Equal(t, version, dataRef.Ref.KeyVersion)
+			}
+			buf := &bytes.Buffer{}
+			require.NoError(t, f.Content(buf))
+			require.Equal(t, "test data", buf.String())
+			return nil
+		}))
+	}
+	checkKeyVersion(1)
+
+	rw := NewRewrapper(s)
+	_, err = s.ChunkStorage().RotateKey(ctx)
+	require.NoError(t, err)
+	progress, err := rw.Progress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), progress.KeyVersion)
+	require.Equal(t, int64(0), progress.Rewrapped)
+	require.NoError(t, rw.RunOnce(ctx))
+	progress, err = rw.Progress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, progress.FileSets, progress.Rewrapped)
+	checkKeyVersion(2)
+}
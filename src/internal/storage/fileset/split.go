@@ -0,0 +1,280 @@
+package fileset
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+)
+
+// RecordReader reads the records that PutSplit divides into files.
+type RecordReader interface {
+	// ReadRecord returns the next record, or io.EOF when there are none left.
+	ReadRecord() ([]byte, error)
+}
+
+// headerFooterReader is a RecordReader whose format has a header and footer
+// that must be copied into every file (e.g. a pgdump file). The header is
+// available once the first record has been read, and the footer once
+// ReadRecord has returned io.EOF.
+type headerFooterReader interface {
+	RecordReader
+	Header() []byte
+	Footer() []byte
+}
+
+type lineReader struct {
+	r *bufio.Reader
+}
+
+// NewLineReader creates a RecordReader that returns each line of r,
+// including its trailing newline.
+func NewLineReader(r io.Reader) RecordReader {
+	return &lineReader{r: bufio.NewReader(r)}
+}
+
+func (lr *lineReader) ReadRecord() ([]byte, error) {
+	line, err := lr.r.ReadBytes('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) && len(line) > 0 {
+			return line, nil
+		}
+		return nil, err
+	}
+	return line, nil
+}
+
+type jsonReader struct {
+	d *json.Decoder
+}
+
+// NewJSONReader creates a RecordReader that returns each JSON value in r.
+func NewJSONReader(r io.Reader) RecordReader {
+	return &jsonReader{d: json.NewDecoder(r)}
+}
+
+func (jr *jsonReader) ReadRecord() ([]byte, error) {
+	var value json.RawMessage
+	if err := jr.d.Decode(&value); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "error parsing json record")
+	}
+	return value, nil
+}
+
+type csvReader struct {
+	r *csv.Reader
+}
+
+// NewCSVReader creates a RecordReader that returns each CSV record in r. A
+// record may span several lines if it contains quoted newlines.
+func NewCSVReader(r io.Reader) RecordReader {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	return &csvReader{r: cr}
+}
+
+func (cr *csvReader) ReadRecord() ([]byte, error) {
+	fields, err := cr.r.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "error parsing csv record")
+	}
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	if err := w.Write(fields); err != nil {
+		return nil, err
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+type sqlReader struct {
+	r *sql.PGDumpReader
+}
+
+// NewSQLReader creates a RecordReader that returns each row of the pgdump
+// file in r. The dump's header and footer are written to every file.
+func NewSQLReader(r io.Reader) RecordReader {
+	return &sqlReader{r: sql.NewPGDumpReader(bufio.NewReader(r))}
+}
+
+func (sr *sqlReader) ReadRecord() ([]byte, error) {
+	row, err := sr.r.ReadRow()
+	if err != nil {
+		if errors.Is(err, io.EOF) && len(row) > 0 {
+			return row, nil
+		}
+		return nil, err
+	}
+	return row, nil
+}
+
+func (sr *sqlReader) Header() []byte {
+	return sr.r.Header
+}
+
+func (sr *sqlReader) Footer() []byte {
+	return sr.r.Footer
+}
+
+// SplitOption configures a PutSplit call.
+type SplitOption func(*splitConfig)
+
+type splitConfig struct {
+	targetFileDatums, targetFileBytes, headerRecords int64
+	attributes                                       map[string]string
+}
+
+// WithTargetFileDatums sets the number of records written to each file.
+func WithTargetFileDatums(n int64) SplitOption {
+	return func(sc *splitConfig) {
+		sc.targetFileDatums = n
+	}
+}
+
+// WithTargetFileBytes sets the number of bytes of records after which a new
+// file is started. Records are never split across files.
+func WithTargetFileBytes(n int64) SplitOption {
+	return func(sc *splitConfig) {
+		sc.targetFileBytes = n
+	}
+}
+
+// WithHeaderRecords treats the first n records as a header, which is written
+// at the start of every file.
+func WithHeaderRecords(n int64) SplitOption {
+	return func(sc *splitConfig) {
+		sc.headerRecords = n
+	}
+}
+
+// WithSplitAttributes sets the attributes of the files that are written.
+func WithSplitAttributes(attributes map[string]string) SplitOption {
+	return func(sc *splitConfig) {
+		sc.attributes = attributes
+	}
+}
+
+// splitFileName returns the name of the ith file written by PutSplit.
+func splitFileName(p string, i int64) string {
+	return path.Join(p, fmt.Sprintf("%016x", i))
+}
+
+// PutSplit splits the records from rr into files in the directory p. Files
+// are named with a 16 digit hex index, which continues from the highest index
+// already in the directory. If neither a target number of datums nor a target
+// number of bytes is set, each record is written to its own file.
+func (uw *UnorderedWriter) PutSplit(p, tag string, rr RecordReader, opts ...SplitOption) error {
+	sc := &splitConfig{}
+	for _, opt := range opts {
+		opt(sc)
+	}
+	if sc.targetFileDatums == 0 && sc.targetFileBytes == 0 {
+		sc.targetFileDatums = 1
+	}
+	next, err := uw.nextSplitIndex(p)
+	if err != nil {
+		return err
+	}
+	var header []byte
+	for i := int64(0); i < sc.headerRecords; i++ {
+		record, err := rr.ReadRecord()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		header = append(header, record...)
+	}
+	hfr, hasHeaderFooter := rr.(headerFooterReader)
+	var files []string
+	var datums, size int64
+	for {
+		record, err := rr.ReadRecord()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		if datums == 0 {
+			name := splitFileName(p, next)
+			next++
+			files = append(files, name)
+			var prefix []byte
+			if hasHeaderFooter {
+				prefix = append(prefix, hfr.Header()...)
+			}
+			prefix = append(prefix, header...)
+			if err := uw.Put(name, tag, true, bytes.NewReader(prefix), WithAttributes(sc.attributes)); err != nil {
+				return err
+			}
+		}
+		if err := uw.Put(files[len(files)-1], tag, true, bytes.NewReader(record)); err != nil {
+			return err
+		}
+		datums++
+		size += int64(len(record))
+		if (sc.targetFileDatums > 0 && datums >= sc.targetFileDatums) || (sc.targetFileBytes > 0 && size >= sc.targetFileBytes) {
+			datums, size = 0, 0
+		}
+	}
+	if hasHeaderFooter && len(hfr.Footer()) > 0 {
+		for _, name := range files {
+			if err := uw.Put(name, tag, true, bytes.NewReader(hfr.Footer())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// nextSplitIndex returns the index after the highest split file index in the
+// directory p.
+func (uw *UnorderedWriter) nextSplitIndex(p string) (int64, error) {
+	if err := uw.serialize(); err != nil {
+		return 0, err
+	}
+	dir := Clean(p, true)
+	var ids []ID
+	if uw.parentID != nil {
+		ids = []ID{*uw.parentID}
+	}
+	fs, err := uw.storage.Open(uw.ctx, append(ids, uw.ids...), index.WithPrefix(dir))
+	if err != nil {
+		return 0, err
+	}
+	var next int64
+	if err := fs.Iterate(uw.ctx, func(f File) error {
+		name := strings.TrimPrefix(f.Index().Path, dir)
+		if len(name) != 16 {
+			return nil
+		}
+		i, err := strconv.ParseInt(name, 16, 64)
+		if err != nil {
+			return nil
+		}
+		if i >= next {
+			next = i + 1
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return next, nil
+}
@@ -0,0 +1,94 @@
+package fileset
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+)
+
+func readRecords(t *testing.T, rr RecordReader) []string {
+	var records []string
+	for {
+		record, err := rr.ReadRecord()
+		if err != nil {
+			require.True(t, errors.Is(err, io.EOF), "unexpected error: %v", err)
+			return records
+		}
+		records = append(records, string(record))
+	}
+}
+
+func TestRecordReaders(t *testing.T) {
+	t.Run("Line", func(t *testing.T) {
+		records := readRecords(t, NewLineReader(strings.NewReader("foo\nbar\nbuz")))
+		require.Equal(t, []string{"foo\n", "bar\n", "buz"}, records)
+	})
+	t.Run("JSON", func(t *testing.T) {
+		records := readRecords(t, NewJSONReader(strings.NewReader("{}{\"a\": 1}\n[1,2]\n")))
+		require.Equal(t, []string{"{}", "{\"a\": 1}", "[1,2]"}, records)
+	})
+	t.Run("CSV", func(t *testing.T) {
+		// The second record spans two lines, since "is\nonly" is quoted.
+		records := readRecords(t, NewCSVReader(strings.NewReader("this,is,a,test\n"+
+			"\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n")))
+		require.Equal(t, []string{"this,is,a,test\n", "\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n"}, records)
+	})
+	t.Run("SQL", func(t *testing.T) {
+		rr := NewSQLReader(strings.NewReader(testutil.TestPGDump))
+		records := readRecords(t, rr)
+		require.Equal(t, 5, len(records))
+		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", records[0])
+		hfr := rr.(headerFooterReader)
+		require.Matches(t, "CREATE TABLE public\\.cars", string(hfr.Header()))
+		require.True(t, strings.HasPrefix(string(hfr.Footer()), "\\.\n"))
+	})
+}
+
+func TestPutSplit(t *testing.T) {
+	ctx := context.Background()
+	storage := newTestStorage(t)
+	readFiles := func(id *ID) map[string]string {
+		fs, err := storage.Open(ctx, []ID{*id})
+		require.NoError(t, err)
+		files := make(map[string]string)
+		require.NoError(t, fs.Iterate(ctx, func(f File) error {
+			buf := &bytes.Buffer{}
+			if err := f.Content(buf); err != nil {
+				return err
+			}
+			files[f.Index().Path] = buf.String()
+			return nil
+		}))
+		return files
+	}
+
+	uw, err := storage.NewUnorderedWriter(ctx)
+	require.NoError(t, err)
+	require.NoError(t, uw.PutSplit("line", "", NewLineReader(strings.NewReader("foo\nbar\nbuz\n"))))
+	// Splits continue numbering from the files already in the directory.
+	require.NoError(t, uw.PutSplit("line", "", NewLineReader(strings.NewReader("fiz\n"))))
+	require.NoError(t, uw.PutSplit("datums", "", NewLineReader(strings.NewReader("a\nb\nc\n")), WithTargetFileDatums(2)))
+	require.NoError(t, uw.PutSplit("bytes", "", NewLineReader(strings.NewReader("foo\nbar\nbuz\nfiz\n")), WithTargetFileBytes(8)))
+	require.NoError(t, uw.PutSplit("header", "", NewCSVReader(strings.NewReader("a,b\n1,2\n3,4\n")), WithHeaderRecords(1)))
+	id, err := uw.Close()
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]string{
+		"/line/0000000000000000":   "foo\n",
+		"/line/0000000000000001":   "bar\n",
+		"/line/0000000000000002":   "buz\n",
+		"/line/0000000000000003":   "fiz\n",
+		"/datums/0000000000000000": "a\nb\n",
+		"/datums/0000000000000001": "c\n",
+		"/bytes/0000000000000000":  "foo\nbar\n",
+		"/bytes/0000000000000001":  "buz\nfiz\n",
+		"/header/0000000000000000": "a,b\n1,2\n",
+		"/header/0000000000000001": "a,b\n3,4\n",
+	}, readFiles(id))
+}
@@ -279,6 +279,90 @@ func (s *Storage) Size(ctx context.Context, id ID) (int64, error) {
 	return total, nil
 }
 
+// Checker verifies file sets, checking each chunk they reference at most once.
+type Checker struct {
+	s *Storage
+	// checked maps the chunks that have been checked to the problem found
+	// with them, if any.
+	checked map[string]error
+}
+
+// NewChecker creates a new Checker.
+func (s *Storage) NewChecker() *Checker {
+	return &Checker{
+		s:       s,
+		checked: make(map[string]error),
+	}
+}
+
+// Check verifies that the metadata of the file set with id, and of the file
+// sets it is composed of, resolves, and that the chunks referenced by their
+// indexes exist. cb is called with each problem that is found.
+func (c *Checker) Check(ctx context.Context, id ID, cb func(error) error) error {
+	s := c.s
+	md, err := s.store.Get(ctx, id)
+	if err != nil {
+		if err == ErrFileSetNotExists {
+			return cb(errors.Errorf("file set %v does not exist", id))
+		}
+		return err
+	}
+	switch x := md.Value.(type) {
+	case *Metadata_Composite:
+		ids, err := x.Composite.PointsTo()
+		if err != nil {
+			return cb(errors.Wrapf(err, "file set %v has invalid layers", id))
+		}
+		for _, layer := range ids {
+			if err := c.Check(ctx, layer, cb); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *Metadata_Primitive:
+		var problems []error
+		seen := make(map[string]bool)
+		checkChunks := func(chunkIDs []chunk.ID) {
+			for _, chunkID := range chunkIDs {
+				key := string(chunkID)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				err, ok := c.checked[key]
+				if !ok {
+					err = s.chunks.Check(ctx, chunkID)
+					c.checked[key] = err
+				}
+				if err != nil {
+					problems = append(problems, errors.Wrapf(err, "file set %v", id))
+				}
+			}
+		}
+		for _, topIdx := range []*index.Index{x.Primitive.Additive, x.Primitive.Deletive} {
+			if topIdx == nil {
+				continue
+			}
+			checkChunks(index.PointsTo(topIdx))
+			ir := index.NewReader(s.chunks, topIdx)
+			if err := ir.Iterate(ctx, func(idx *index.Index) error {
+				checkChunks(index.PointsTo(idx))
+				return nil
+			}); err != nil {
+				problems = append(problems, errors.Wrapf(err, "could not read the index of file set %v", id))
+			}
+		}
+		for _, problem := range problems {
+			if err := cb(problem); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return cb(errors.Errorf("file set %v has invalid metadata", id))
+	}
+}
+
 // WithRenewer calls cb with a Renewer, and a context which will be canceled if the renewer is unable to renew a path.
 func (s *Storage) WithRenewer(ctx context.Context, ttl time.Duration, cb func(context.Context, *renew.StringSet) error) error {
 	rf := func(ctx context.Context, idHexStr string, ttl time.Duration) error {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
 )
@@ -23,6 +24,7 @@ type UnorderedWriter struct {
 	ids                        []ID
 	parentID                   *ID
 	validator                  func(string) error
+	chunkWriterOpts            []chunk.WriterOption
 }
 
 func newUnorderedWriter(ctx context.Context, storage *Storage, memThreshold int64, opts ...UnorderedWriterOption) (*UnorderedWriter, error) {
@@ -42,7 +44,9 @@ func newUnorderedWriter(ctx context.Context, storage *Storage, memThreshold int6
 	return uw, nil
 }
 
-func (uw *UnorderedWriter) Put(p, tag string, appendFile bool, r io.Reader) (retErr error) {
+// Put writes the content of r to the file p with tag, appending to its existing
+// content if appendFile is set.
+func (uw *UnorderedWriter) Put(p, tag string, appendFile bool, r io.Reader, opts ...FileOption) (retErr error) {
 	if err := uw.validate(p); err != nil {
 		return err
 	}
@@ -52,7 +56,7 @@ func (uw *UnorderedWriter) Put(p, tag string, appendFile bool, r io.Reader) (ret
 	if !appendFile {
 		uw.buffer.Delete(p, tag)
 	}
-	w := uw.buffer.Add(p, tag)
+	w := uw.buffer.Add(p, tag, opts...)
 	for {
 		n, err := io.CopyN(w, r, uw.memAvailable)
 		uw.memAvailable -= n
@@ -66,7 +70,7 @@ func (uw *UnorderedWriter) Put(p, tag string, appendFile bool, r io.Reader) (ret
 			if err := uw.serialize(); err != nil {
 				return err
 			}
-			w = uw.buffer.Add(p, tag)
+			w = uw.buffer.Add(p, tag, opts...)
 		}
 	}
 }
@@ -85,8 +89,8 @@ func (uw *UnorderedWriter) serialize() error {
 		return nil
 	}
 	return uw.withWriter(func(w *Writer) error {
-		if err := uw.buffer.WalkAdditive(func(path, tag string, r io.Reader) error {
-			return w.Add(path, tag, r)
+		if err := uw.buffer.WalkAdditive(func(path, tag string, attributes map[string]string, r io.Reader) error {
+			return w.Add(path, tag, r, WithAttributes(attributes))
 		}); err != nil {
 			return err
 		}
@@ -102,6 +106,9 @@ func (uw *UnorderedWriter) withWriter(cb func(*Writer) error) error {
 	if uw.ttl > 0 {
 		writerOpts = append(writerOpts, WithTTL(uw.ttl))
 	}
+	if len(uw.chunkWriterOpts) > 0 {
+		writerOpts = append(writerOpts, withChunkWriterOptions(uw.chunkWriterOpts...))
+	}
 	w := uw.storage.newWriter(uw.ctx, writerOpts...)
 	if err := cb(w); err != nil {
 		return err
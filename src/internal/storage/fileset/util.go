@@ -18,8 +18,8 @@ import (
 )
 
 // NewTestStorage constructs a local storage instance scoped to the lifetime of the test
-func NewTestStorage(t testing.TB, db *sqlx.DB, tr track.Tracker) *Storage {
-	_, chunks := chunk.NewTestStorage(t, db, tr)
+func NewTestStorage(t testing.TB, db *sqlx.DB, tr track.Tracker, opts ...chunk.StorageOption) *Storage {
+	_, chunks := chunk.NewTestStorage(t, db, tr, opts...)
 	store := NewTestStore(t, db)
 	return NewStorage(store, tr, chunks)
 }
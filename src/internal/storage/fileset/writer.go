@@ -31,6 +31,8 @@ type Writer struct {
 	lastIdx            *index.Index
 	indexFunc          func(*index.Index) error
 	ttl                time.Duration
+	chunkWriterOpts    []chunk.WriterOption
+	keyVersion         uint64
 }
 
 func newWriter(ctx context.Context, storage *Storage, tracker track.Tracker, chunks *chunk.Storage, opts ...WriterOption) *Writer {
@@ -42,20 +44,27 @@ func newWriter(ctx context.Context, storage *Storage, tracker track.Tracker, chu
 	for _, opt := range opts {
 		opt(w)
 	}
-	var chunkWriterOpts []chunk.WriterOption
+	// The chunks written or copied by the writer are wrapped by this or a later
+	// version of the master key. The version is left unknown on error, so the
+	// fileset will be rewrapped.
+	w.keyVersion, _ = chunks.KeyVersion(ctx)
 	w.additive = index.NewWriter(ctx, chunks, "additive-index-writer")
 	w.deletive = index.NewWriter(ctx, chunks, "deletive-index-writer")
-	w.cw = chunks.NewWriter(ctx, "chunk-writer", w.callback, chunkWriterOpts...)
+	w.cw = chunks.NewWriter(ctx, "chunk-writer", w.callback, w.chunkWriterOpts...)
 	return w
 }
 
-func (w *Writer) Add(path, tag string, r io.Reader) error {
+// Add adds a file with the content of r to the file set.
+func (w *Writer) Add(path, tag string, r io.Reader, opts ...FileOption) error {
 	idx := &index.Index{
 		Path: path,
 		File: &index.File{
 			Tag: tag,
 		},
 	}
+	for _, opt := range opts {
+		opt(idx.File)
+	}
 	if err := w.nextIdx(idx); err != nil {
 		return err
 	}
@@ -108,7 +117,8 @@ func (w *Writer) Copy(file File, tag string) error {
 	copyIdx := &index.Index{
 		Path: idx.Path,
 		File: &index.File{
-			Tag: tag,
+			Tag:        tag,
+			Attributes: idx.File.Attributes,
 		},
 	}
 	if err := w.nextIdx(copyIdx); err != nil {
@@ -175,8 +185,9 @@ func (w *Writer) Close() (*ID, error) {
 		return nil, err
 	}
 	return w.storage.newPrimitive(w.ctx, &Primitive{
-		Additive:  additiveIdx,
-		Deletive:  deletiveIdx,
-		SizeBytes: w.sizeBytes,
+		Additive:   additiveIdx,
+		Deletive:   deletiveIdx,
+		SizeBytes:  w.sizeBytes,
+		KeyVersion: w.keyVersion,
 	}, w.ttl)
 }
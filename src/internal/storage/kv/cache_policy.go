@@ -0,0 +1,184 @@
+package kv
+
+import (
+	"container/list"
+	"hash/fnv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// CachePolicy is the admission and eviction policy of a cache.
+type CachePolicy string
+
+const (
+	// LRU admits every entry, and evicts the least recently used entries.
+	LRU CachePolicy = "lru"
+	// TinyLFU evicts the least recently used entries, but only admits an entry
+	// if it has been accessed more frequently than the entries it would evict.
+	TinyLFU CachePolicy = "tinylfu"
+)
+
+// ParseCachePolicy parses a cache policy name (e.g. "lru" or "tinylfu").
+func ParseCachePolicy(s string) (CachePolicy, error) {
+	switch p := CachePolicy(strings.ToLower(s)); p {
+	case LRU, TinyLFU:
+		return p, nil
+	default:
+		return "", errors.Errorf("unrecognized cache policy: %q", s)
+	}
+}
+
+// cachePolicy tracks the keys in a cache, to decide which keys are admitted
+// and which are evicted.
+type cachePolicy interface {
+	// add adds key to the cache.
+	add(key string)
+	// access records an access of key, which may not be in the cache.
+	access(key string)
+	// remove removes key from the cache.
+	remove(key string)
+	// walk calls cb with the keys in the cache in eviction order, until cb
+	// returns false.
+	walk(cb func(key string) bool)
+	// admit reports whether key should be added to the cache at the expense
+	// of evicting victims.
+	admit(key string, victims []string) bool
+}
+
+func newCachePolicy(p CachePolicy) (cachePolicy, error) {
+	switch p {
+	case LRU:
+		return newLRUPolicy(), nil
+	case TinyLFU:
+		return newTinyLFUPolicy(), nil
+	default:
+		return nil, errors.Errorf("unrecognized cache policy: %q", p)
+	}
+}
+
+type lruPolicy struct {
+	order *list.List
+	elems map[string]*list.Element
+}
+
+func newLRUPolicy() *lruPolicy {
+	return &lruPolicy{
+		order: list.New(),
+		elems: make(map[string]*list.Element),
+	}
+}
+
+func (p *lruPolicy) add(key string) {
+	if elem, ok := p.elems[key]; ok {
+		p.order.MoveToFront(elem)
+		return
+	}
+	p.elems[key] = p.order.PushFront(key)
+}
+
+func (p *lruPolicy) access(key string) {
+	if elem, ok := p.elems[key]; ok {
+		p.order.MoveToFront(elem)
+	}
+}
+
+func (p *lruPolicy) remove(key string) {
+	if elem, ok := p.elems[key]; ok {
+		p.order.Remove(elem)
+		delete(p.elems, key)
+	}
+}
+
+func (p *lruPolicy) walk(cb func(key string) bool) {
+	for elem := p.order.Back(); elem != nil; elem = elem.Prev() {
+		if !cb(elem.Value.(string)) {
+			return
+		}
+	}
+}
+
+func (p *lruPolicy) admit(string, []string) bool {
+	return true
+}
+
+const (
+	sketchDepth      = 4
+	sketchWidth      = 1 << 16
+	sketchMaxCount   = 15
+	sketchSampleSize = 10 * sketchWidth
+)
+
+// tinyLFUPolicy estimates the access frequency of keys with a count-min
+// sketch, whose counters are halved periodically so that the estimates favor
+// recent accesses.
+type tinyLFUPolicy struct {
+	*lruPolicy
+	counters   [sketchDepth][sketchWidth]uint8
+	increments int
+}
+
+func newTinyLFUPolicy() *tinyLFUPolicy {
+	return &tinyLFUPolicy{lruPolicy: newLRUPolicy()}
+}
+
+func (p *tinyLFUPolicy) add(key string) {
+	p.lruPolicy.add(key)
+	p.increment(key)
+}
+
+func (p *tinyLFUPolicy) access(key string) {
+	p.lruPolicy.access(key)
+	p.increment(key)
+}
+
+func (p *tinyLFUPolicy) admit(key string, victims []string) bool {
+	freq := p.frequency(key)
+	for _, victim := range victims {
+		if p.frequency(victim) >= freq {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *tinyLFUPolicy) increment(key string) {
+	for i, j := range sketchIndexes(key) {
+		if p.counters[i][j] < sketchMaxCount {
+			p.counters[i][j]++
+		}
+	}
+	p.increments++
+	if p.increments >= sketchSampleSize {
+		for i := range p.counters {
+			for j := range p.counters[i] {
+				p.counters[i][j] /= 2
+			}
+		}
+		p.increments /= 2
+	}
+}
+
+func (p *tinyLFUPolicy) frequency(key string) uint8 {
+	freq := uint8(sketchMaxCount)
+	for i, j := range sketchIndexes(key) {
+		if p.counters[i][j] < freq {
+			freq = p.counters[i][j]
+		}
+	}
+	return freq
+}
+
+// sketchIndexes returns the counter index of key in each row of the sketch,
+// using double hashing.
+func sketchIndexes(key string) [sketchDepth]uint32 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	sum := h.Sum64()
+	h1, h2 := uint32(sum), uint32(sum>>32)|1
+	var idxs [sketchDepth]uint32
+	for i := range idxs {
+		idxs[i] = (h1 + uint32(i)*h2) % sketchWidth
+	}
+	return idxs
+}
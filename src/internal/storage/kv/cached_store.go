@@ -0,0 +1,36 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	log "github.com/sirupsen/logrus"
+)
+
+type cachedStore struct {
+	Store
+	cache GetPut
+}
+
+// NewCachedStore returns store with gets served from cache when possible.
+// The values of gets which miss the cache are put in the cache, so store must
+// never change the value of a key. Cached values which cb rejects with
+// ErrInvalidValue are read from store again.
+func NewCachedStore(store Store, cache GetPut) Store {
+	return &cachedStore{Store: store, cache: cache}
+}
+
+func (cs *cachedStore) Get(ctx context.Context, key []byte, cb ValueCallback) error {
+	if err := cs.cache.Get(ctx, key, cb); err == nil || !(pacherr.IsNotExist(err) || errors.Is(err, ErrInvalidValue)) {
+		return err
+	} else if errors.Is(err, ErrInvalidValue) {
+		log.Warnf("invalid value in cache, reading it from the store: %v", err)
+	}
+	return cs.Store.Get(ctx, key, func(value []byte) error {
+		if err := cs.cache.Put(ctx, key, value); err != nil {
+			log.Errorf("could not put in cache: %v", err)
+		}
+		return cb(value)
+	})
+}
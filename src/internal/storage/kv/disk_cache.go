@@ -0,0 +1,312 @@
+package kv
+
+import (
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	diskCacheHitMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_disk_cache",
+		Name:      "hits_total",
+		Help:      "Number of gets served from the disk cache",
+	})
+	diskCacheMissMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_disk_cache",
+		Name:      "misses_total",
+		Help:      "Number of gets that were not served from the disk cache",
+	})
+	diskCacheEvictionMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_disk_cache",
+		Name:      "evictions_total",
+		Help:      "Number of entries evicted from the disk cache",
+	})
+	diskCacheRejectionMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_disk_cache",
+		Name:      "rejections_total",
+		Help:      "Number of puts not admitted to the disk cache",
+	})
+	diskCacheSizeMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_disk_cache",
+		Name:      "size_bytes",
+		Help:      "Size of the entries in the disk cache",
+	})
+)
+
+const (
+	diskCacheTmpDir = "tmp"
+	// diskCacheTmpTTL is how long a temporary file is kept before it is
+	// considered abandoned, since the cache directory may be shared with other
+	// processes writing to it.
+	diskCacheTmpTTL = time.Hour
+)
+
+type diskCache struct {
+	dir      string
+	maxBytes int64
+
+	mu     sync.Mutex
+	policy cachePolicy
+	sizes  map[string]int64
+	size   int64
+}
+
+// NewDiskCache returns a cache which stores at most maxBytes of values in
+// files under dir.
+// The cache persists across restarts, since its index is rebuilt from the
+// files in dir, and dir can be shared by the processes on a host. Each
+// process bounds the entries it knows about, which includes the entries
+// written by other processes that it has read.
+func NewDiskCache(dir string, maxBytes int64, policy CachePolicy) (GetPut, error) {
+	p, err := newCachePolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+	dc := &diskCache{
+		dir:      filepath.Clean(dir),
+		maxBytes: maxBytes,
+		policy:   p,
+		sizes:    make(map[string]int64),
+	}
+	if err := os.MkdirAll(filepath.Join(dc.dir, diskCacheTmpDir), 0755); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if err := dc.rebuild(); err != nil {
+		return nil, err
+	}
+	return dc, nil
+}
+
+func (dc *diskCache) Get(ctx context.Context, key []byte, cb ValueCallback) error {
+	k := string(key)
+	p := dc.path(k)
+	data, err := ioutil.ReadFile(p)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return errors.EnsureStack(err)
+		}
+		diskCacheMissMetric.Inc()
+		dc.mu.Lock()
+		// The entry may have been evicted by another process.
+		dc.remove(k)
+		dc.policy.access(k)
+		dc.mu.Unlock()
+		return pacherr.NewNotExist("kv.diskCache", k)
+	}
+	diskCacheHitMetric.Inc()
+	var victims []string
+	dc.mu.Lock()
+	if _, ok := dc.sizes[k]; ok {
+		dc.policy.access(k)
+	} else {
+		// The entry was written by another process.
+		victims = dc.evictFor(int64(len(data)))
+		dc.insert(k, int64(len(data)))
+	}
+	dc.mu.Unlock()
+	dc.deleteFiles(victims)
+	// The modification time orders the entries when the index is rebuilt.
+	now := time.Now()
+	if err := os.Chtimes(p, now, now); err != nil && !os.IsNotExist(err) {
+		log.Warnf("could not update disk cache entry time: %v", err)
+	}
+	if err := cb(data); err != nil {
+		if errors.Is(err, ErrInvalidValue) {
+			// The file is corrupt, so it is evicted to be replaced by the
+			// next put.
+			dc.mu.Lock()
+			if _, ok := dc.sizes[k]; ok {
+				dc.evict(k)
+			}
+			dc.mu.Unlock()
+			dc.deleteFiles([]string{k})
+		}
+		return err
+	}
+	return nil
+}
+
+func (dc *diskCache) Put(ctx context.Context, key, value []byte) error {
+	k := string(key)
+	size := int64(len(value))
+	if size > dc.maxBytes {
+		diskCacheRejectionMetric.Inc()
+		return nil
+	}
+	dc.mu.Lock()
+	if _, ok := dc.sizes[k]; ok {
+		dc.policy.access(k)
+		dc.mu.Unlock()
+		return nil
+	}
+	victims := dc.victims(size)
+	if len(victims) > 0 && !dc.policy.admit(k, victims) {
+		dc.mu.Unlock()
+		diskCacheRejectionMetric.Inc()
+		return nil
+	}
+	for _, victim := range victims {
+		dc.evict(victim)
+	}
+	dc.insert(k, size)
+	dc.mu.Unlock()
+	dc.deleteFiles(victims)
+	if err := dc.writeFile(k, value); err != nil {
+		dc.mu.Lock()
+		dc.remove(k)
+		dc.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// writeFile atomically writes the file for key, so that concurrent readers
+// never observe a partial value.
+func (dc *diskCache) writeFile(key string, value []byte) (retErr error) {
+	p := dc.path(key)
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return errors.EnsureStack(err)
+	}
+	f, err := ioutil.TempFile(filepath.Join(dc.dir, diskCacheTmpDir), "")
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if retErr != nil {
+			os.Remove(f.Name())
+		}
+	}()
+	if _, err := f.Write(value); err != nil {
+		f.Close()
+		return errors.EnsureStack(err)
+	}
+	if err := f.Close(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(os.Rename(f.Name(), p))
+}
+
+// victims returns the keys that need to be evicted to add an entry of size.
+func (dc *diskCache) victims(size int64) []string {
+	var victims []string
+	excess := dc.size + size - dc.maxBytes
+	dc.policy.walk(func(key string) bool {
+		if excess <= 0 {
+			return false
+		}
+		victims = append(victims, key)
+		excess -= dc.sizes[key]
+		return true
+	})
+	return victims
+}
+
+// evictFor evicts entries to make room for an entry of size, regardless of the
+// admission policy, and returns the evicted keys.
+func (dc *diskCache) evictFor(size int64) []string {
+	victims := dc.victims(size)
+	for _, victim := range victims {
+		dc.evict(victim)
+	}
+	return victims
+}
+
+func (dc *diskCache) insert(key string, size int64) {
+	dc.sizes[key] = size
+	dc.size += size
+	dc.policy.add(key)
+	diskCacheSizeMetric.Add(float64(size))
+}
+
+func (dc *diskCache) remove(key string) {
+	size, ok := dc.sizes[key]
+	if !ok {
+		return
+	}
+	delete(dc.sizes, key)
+	dc.size -= size
+	dc.policy.remove(key)
+	diskCacheSizeMetric.Sub(float64(size))
+}
+
+func (dc *diskCache) evict(key string) {
+	dc.remove(key)
+	diskCacheEvictionMetric.Inc()
+}
+
+func (dc *diskCache) deleteFiles(keys []string) {
+	for _, key := range keys {
+		if err := os.Remove(dc.path(key)); err != nil && !os.IsNotExist(err) {
+			log.Errorf("could not delete from disk cache: %v", err)
+		}
+	}
+}
+
+// rebuild rebuilds the index from the files in the cache directory, ordering
+// the entries by modification time, and evicts entries if the cache is over
+// its size limit.
+func (dc *diskCache) rebuild() error {
+	type entry struct {
+		key     string
+		size    int64
+		modTime time.Time
+	}
+	var ents []entry
+	tmpDir := filepath.Join(dc.dir, diskCacheTmpDir)
+	if err := filepath.Walk(dc.dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		if filepath.Dir(p) == tmpDir {
+			if time.Since(info.ModTime()) > diskCacheTmpTTL {
+				os.Remove(p)
+			}
+			return nil
+		}
+		key, err := hex.DecodeString(info.Name())
+		if err != nil {
+			log.Warnf("ignoring unrecognized file in disk cache: %v", p)
+			return nil
+		}
+		ents = append(ents, entry{key: string(key), size: info.Size(), modTime: info.ModTime()})
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	sort.Slice(ents, func(i, j int) bool {
+		return ents[i].modTime.Before(ents[j].modTime)
+	})
+	for _, ent := range ents {
+		dc.insert(ent.key, ent.size)
+	}
+	dc.deleteFiles(dc.evictFor(0))
+	return nil
+}
+
+func (dc *diskCache) path(key string) string {
+	if len(key) == 0 {
+		panic("key cannot be empty")
+	}
+	name := hex.EncodeToString([]byte(key))
+	return filepath.Join(dc.dir, name[:2], name)
+}
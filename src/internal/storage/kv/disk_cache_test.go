@@ -0,0 +1,107 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestDiskCache(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	value := bytes.Repeat([]byte{'a'}, 100)
+	get := func(t *testing.T, cache GetPut, key string) bool {
+		err := cache.Get(ctx, []byte(key), func(actual []byte) error {
+			require.True(t, bytes.Equal(value, actual))
+			return nil
+		})
+		if pacherr.IsNotExist(err) {
+			return false
+		}
+		require.NoError(t, err)
+		return true
+	}
+
+	cache, err := NewDiskCache(dir, 1000, LRU)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, cache.Put(ctx, []byte(fmt.Sprint(i)), value))
+	}
+	require.True(t, get(t, cache, "0"))
+	// the cache is full, so the least recently used entry is evicted
+	require.NoError(t, cache.Put(ctx, []byte("10"), value))
+	require.True(t, get(t, cache, "0"))
+	require.False(t, get(t, cache, "1"))
+
+	// the entries survive a restart
+	cache, err = NewDiskCache(dir, 1000, LRU)
+	require.NoError(t, err)
+	for _, key := range []string{"0", "2", "9", "10"} {
+		require.True(t, get(t, cache, key))
+	}
+	// the index is rebuilt when the size limit is lowered
+	cache, err = NewDiskCache(dir, 500, LRU)
+	require.NoError(t, err)
+	var n int
+	for i := 0; i <= 10; i++ {
+		if get(t, cache, fmt.Sprint(i)) {
+			n++
+		}
+	}
+	require.Equal(t, 5, n)
+}
+
+func TestDiskCacheTinyLFU(t *testing.T) {
+	ctx := context.Background()
+	value := bytes.Repeat([]byte{'a'}, 100)
+	cache, err := NewDiskCache(t.TempDir(), 300, TinyLFU)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		key := []byte(fmt.Sprint(i))
+		require.NoError(t, cache.Put(ctx, key, value))
+		for j := 0; j < 3; j++ {
+			require.NoError(t, cache.Get(ctx, key, func([]byte) error { return nil }))
+		}
+	}
+	// an entry accessed once is not admitted at the expense of frequently accessed entries
+	require.NoError(t, cache.Put(ctx, []byte("once"), value))
+	require.True(t, pacherr.IsNotExist(cache.Get(ctx, []byte("once"), func([]byte) error { return nil })))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, cache.Get(ctx, []byte(fmt.Sprint(i)), func([]byte) error { return nil }))
+	}
+	// an entry accessed frequently is admitted
+	for j := 0; j < 10; j++ {
+		require.YesError(t, cache.Get(ctx, []byte("often"), func([]byte) error { return nil }))
+	}
+	require.NoError(t, cache.Put(ctx, []byte("often"), value))
+	require.NoError(t, cache.Get(ctx, []byte("often"), func([]byte) error { return nil }))
+}
+
+func TestCachedStoreInvalidValue(t *testing.T) {
+	ctx := context.Background()
+	objC, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	store := NewFromObjectClient(objC)
+	cache, err := NewDiskCache(t.TempDir(), 1000, LRU)
+	require.NoError(t, err)
+	key, value := []byte("key"), []byte("value")
+	require.NoError(t, store.Put(ctx, key, value))
+	require.NoError(t, cache.Put(ctx, key, []byte("corrupt")))
+	verify := func(actual []byte) error {
+		if !bytes.Equal(value, actual) {
+			return errors.Wrapf(ErrInvalidValue, "got %q", actual)
+		}
+		return nil
+	}
+
+	// the corrupt value is evicted, and the value is read from the store
+	require.NoError(t, NewCachedStore(store, cache).Get(ctx, key, verify))
+	// and cached again
+	require.NoError(t, cache.Get(ctx, key, verify))
+}
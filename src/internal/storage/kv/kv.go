@@ -2,8 +2,14 @@ package kv
 
 import (
 	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 )
 
+// ErrInvalidValue is returned, possibly wrapped, by value callbacks which find
+// that a value is corrupt. Caches evict the values which are rejected this way.
+var ErrInvalidValue = errors.New("invalid value")
+
 // ValueCallback is the type of functions used to access values
 type ValueCallback = func([]byte) error
 
@@ -107,6 +107,26 @@ func (t *postgresTracker) addReferences(tx *sqlx.Tx, intID int, pointsTo []strin
 	return nil
 }
 
+func (t *postgresTracker) ReplaceTx(tx *sqlx.Tx, id string, pointsTo []string) error {
+	for _, dwn := range pointsTo {
+		if dwn == id {
+			return ErrSelfReference
+		}
+	}
+	pointsTo = dedupedStrings(pointsTo)
+	var intIDs []int
+	if err := tx.Select(&intIDs, `SELECT int_id FROM storage.tracker_objects WHERE str_id = $1`, id); err != nil {
+		return err
+	}
+	if len(intIDs) == 0 {
+		return ErrNotExist
+	}
+	if _, err := tx.Exec(`DELETE FROM storage.tracker_refs WHERE from_id = $1`, intIDs[0]); err != nil {
+		return err
+	}
+	return t.addReferences(tx, intIDs[0], pointsTo)
+}
+
 func (t *postgresTracker) SetTTLPrefix(ctx context.Context, prefix string, ttl time.Duration) (time.Time, error) {
 	var expiresAt time.Time
 	err := t.db.GetContext(ctx, &expiresAt,
@@ -204,6 +224,41 @@ func (t *postgresTracker) IterateDeletable(ctx context.Context, cb func(id strin
 	return rows.Err()
 }
 
+func (t *postgresTracker) Exists(ctx context.Context, id string) (bool, error) {
+	var count int
+	if err := t.db.GetContext(ctx, &count, `
+		SELECT count(*) FROM storage.tracker_objects WHERE str_id = $1
+	`, id); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (t *postgresTracker) IterateDangling(ctx context.Context, cb func(id string) error) (retErr error) {
+	rows, err := t.db.QueryxContext(ctx,
+		`SELECT DISTINCT str_id FROM storage.tracker_objects
+		JOIN storage.tracker_refs ON int_id = from_id
+		WHERE to_id NOT IN (SELECT int_id FROM storage.tracker_objects)`)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rows.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return err
+		}
+		if err := cb(id); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 func (t *postgresTracker) getDownstream(tx *sqlx.Tx, intID int) ([]string, error) {
 	dwn := []string{}
 	if err := tx.Select(&dwn, `
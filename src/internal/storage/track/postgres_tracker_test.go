@@ -1,9 +1,12 @@
 package track
 
 import (
+	"context"
+	"sort"
 	"testing"
 
 	_ "github.com/lib/pq"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
 )
 
@@ -16,3 +19,28 @@ func TestPostgresTracker(t *testing.T) {
 		return NewPostgresTracker(db)
 	})
 }
+
+func TestPostgresTrackerIterateDangling(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	db.MustExec("CREATE SCHEMA storage")
+	db.MustExec(schema)
+	tracker := NewPostgresTracker(db)
+	require.NoError(t, Create(ctx, tracker, "1", []string{}, 0))
+	require.NoError(t, Create(ctx, tracker, "2", []string{"1"}, 0))
+	require.NoError(t, Create(ctx, tracker, "3", []string{}, 0))
+	require.NoError(t, Create(ctx, tracker, "4", []string{"1", "3"}, 0))
+	require.NoError(t, Create(ctx, tracker, "5", []string{"3"}, 0))
+	// Objects can only be deleted out from under their references directly.
+	db.MustExec(`DELETE FROM storage.tracker_objects WHERE str_id = '1'`)
+	db.MustExec(`DELETE FROM storage.tracker_objects WHERE str_id = '3'`)
+	var dangling []string
+	require.NoError(t, tracker.IterateDangling(ctx, func(id string) error {
+		dangling = append(dangling, id)
+		return nil
+	}))
+	// Each object is reported once, however many of its references dangle.
+	sort.Strings(dangling)
+	require.Equal(t, []string{"2", "4", "5"}, dangling)
+}
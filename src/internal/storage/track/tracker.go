@@ -20,6 +20,8 @@ var (
 	ErrTombstone = errors.Errorf("cannot create object because it is marked as a tombstone")
 	// ErrSelfReference object cannot reference itself
 	ErrSelfReference = errors.Errorf("object cannot reference itself")
+	// ErrNotExist the object does not exist
+	ErrNotExist = errors.Errorf("object does not exist")
 )
 
 // NoTTL will cause the object to live forever
@@ -38,6 +40,11 @@ type Tracker interface {
 	// It errors with ErrDanglingRef if any of the elements in pointsTo do not exist
 	CreateTx(tx *sqlx.Tx, id string, pointsTo []string, ttl time.Duration) error
 
+	// ReplaceTx replaces the objects pointed to by the object with id with everything in pointsTo.
+	// It errors with ErrNotExist if the object does not exist.
+	// It errors with ErrDanglingRef if any of the elements in pointsTo do not exist
+	ReplaceTx(tx *sqlx.Tx, id string, pointsTo []string) error
+
 	// SetTTLPrefix sets the expiration time to current_time + ttl for all objects with ids starting with prefix
 	SetTTLPrefix(ctx context.Context, prefix string, ttl time.Duration) (time.Time, error)
 
@@ -53,6 +60,12 @@ type Tracker interface {
 
 	// IterateDeletable calls cb with all the objects objects which are no longer referenced and have expired or are tombstoned
 	IterateDeletable(ctx context.Context, cb func(id string) error) error
+
+	// Exists returns true if the object with id exists.
+	Exists(ctx context.Context, id string) (bool, error)
+
+	// IterateDangling calls cb with all the objects which point to objects that do not exist
+	IterateDangling(ctx context.Context, cb func(id string) error) error
 }
 
 // TestTracker runs a TestSuite to ensure Tracker is properly implemented
@@ -119,6 +132,46 @@ func TestTracker(t *testing.T, newTracker func(testing.TB) Tracker) {
 				require.ElementsEqual(t, []string{"3"}, ups)
 			},
 		},
+		{
+			"Exists",
+			func(t *testing.T, tracker Tracker) {
+				require.NoError(t, Create(ctx, tracker, "1", []string{}, 0))
+				exists, err := tracker.Exists(ctx, "1")
+				require.NoError(t, err)
+				require.True(t, exists)
+				exists, err = tracker.Exists(ctx, "2")
+				require.NoError(t, err)
+				require.False(t, exists)
+			},
+		},
+		{
+			"IterateDangling",
+			func(t *testing.T, tracker Tracker) {
+				require.NoError(t, Create(ctx, tracker, "1", []string{}, 0))
+				require.NoError(t, Create(ctx, tracker, "2", []string{"1"}, 0))
+				var dangling []string
+				require.NoError(t, tracker.IterateDangling(ctx, func(id string) error {
+					dangling = append(dangling, id)
+					return nil
+				}))
+				require.Equal(t, 0, len(dangling))
+			},
+		},
+		{
+			"ReplaceReferences",
+			func(t *testing.T, tracker Tracker) {
+				require.NoError(t, Create(ctx, tracker, "1", []string{}, 0))
+				require.NoError(t, Create(ctx, tracker, "2", []string{}, 0))
+				require.NoError(t, Create(ctx, tracker, "3", []string{"1"}, 0))
+
+				require.NoError(t, Replace(ctx, tracker, "3", []string{"2"}))
+				dwn, err := tracker.GetDownstream(ctx, "3")
+				require.NoError(t, err)
+				require.ElementsEqual(t, []string{"2"}, dwn)
+				require.Equal(t, ErrDanglingRef, Replace(ctx, tracker, "3", []string{"4"}))
+				require.Equal(t, ErrNotExist, Replace(ctx, tracker, "4", []string{"1"}))
+			},
+		},
 		{
 			"DeleteSingleObject",
 			func(t *testing.T, tracker Tracker) {
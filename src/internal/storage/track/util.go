@@ -15,6 +15,13 @@ func Create(ctx context.Context, tr Tracker, id string, pointsTo []string, ttl t
 	})
 }
 
+// Replace uses tracker to replace the objects pointed to by the object id.
+func Replace(ctx context.Context, tr Tracker, id string, pointsTo []string) error {
+	return dbutil.WithTx(ctx, tr.DB(), func(tx *sqlx.Tx) error {
+		return tr.ReplaceTx(tx, id, pointsTo)
+	})
+}
+
 // Delete deletes id from the tracker
 func Delete(ctx context.Context, tr Tracker, id string) error {
 	return dbutil.WithTx(ctx, tr.DB(), func(tx *sqlx.Tx) error {
@@ -411,11 +411,17 @@ type createRepoFunc func(context.Context, *pfs.CreateRepoRequest) (*types.Empty,
 type inspectRepoFunc func(context.Context, *pfs.InspectRepoRequest) (*pfs.RepoInfo, error)
 type listRepoFunc func(*pfs.ListRepoRequest, pfs.API_ListRepoServer) error
 type deleteRepoFunc func(context.Context, *pfs.DeleteRepoRequest) (*types.Empty, error)
+type renameRepoFunc func(context.Context, *pfs.RenameRepoRequest) (*types.Empty, error)
+type forkRepoFunc func(context.Context, *pfs.ForkRepoRequest) (*types.Empty, error)
+type setMetadataFunc func(context.Context, *pfs.SetMetadataRequest) (*types.Empty, error)
 type startCommitFunc func(context.Context, *pfs.StartCommitRequest) (*pfs.Commit, error)
 type finishCommitFunc func(context.Context, *pfs.FinishCommitRequest) (*types.Empty, error)
 type inspectCommitFunc func(context.Context, *pfs.InspectCommitRequest) (*pfs.CommitInfo, error)
 type listCommitFunc func(*pfs.ListCommitRequest, pfs.API_ListCommitServer) error
 type squashCommitSetFunc func(context.Context, *pfs.SquashCommitSetRequest) (*types.Empty, error)
+type revertCommitFunc func(context.Context, *pfs.RevertCommitRequest) (*pfs.Commit, error)
+type cherryPickCommitFunc func(context.Context, *pfs.CherryPickCommitRequest) (*pfs.Commit, error)
+type mergeBranchFunc func(context.Context, *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error)
 type inspectCommitSetFunc func(*pfs.InspectCommitSetRequest, pfs.API_InspectCommitSetServer) error
 type listCommitSetFunc func(*pfs.ListCommitSetRequest, pfs.API_ListCommitSetServer) error
 type subscribeCommitFunc func(*pfs.SubscribeCommitRequest, pfs.API_SubscribeCommitServer) error
@@ -424,8 +430,10 @@ type createBranchFunc func(context.Context, *pfs.CreateBranchRequest) (*types.Em
 type inspectBranchFunc func(context.Context, *pfs.InspectBranchRequest) (*pfs.BranchInfo, error)
 type listBranchFunc func(*pfs.ListBranchRequest, pfs.API_ListBranchServer) error
 type deleteBranchFunc func(context.Context, *pfs.DeleteBranchRequest) (*types.Empty, error)
+type renameBranchFunc func(context.Context, *pfs.RenameBranchRequest) (*types.Empty, error)
 type modifyFileFunc func(pfs.API_ModifyFileServer) error
 type getFileTARFunc func(*pfs.GetFileRequest, pfs.API_GetFileTARServer) error
+type getFileRangeFunc func(*pfs.GetFileRangeRequest, pfs.API_GetFileRangeServer) error
 type inspectFileFunc func(context.Context, *pfs.InspectFileRequest) (*pfs.FileInfo, error)
 type listFileFunc func(*pfs.ListFileRequest, pfs.API_ListFileServer) error
 type walkFileFunc func(*pfs.WalkFileRequest, pfs.API_WalkFileServer) error
@@ -433,22 +441,35 @@ type globFileFunc func(*pfs.GlobFileRequest, pfs.API_GlobFileServer) error
 type diffFileFunc func(*pfs.DiffFileRequest, pfs.API_DiffFileServer) error
 type deleteAllPFSFunc func(context.Context, *types.Empty) (*types.Empty, error)
 type fsckFunc func(*pfs.FsckRequest, pfs.API_FsckServer) error
+type exportRepoFunc func(*pfs.ExportRepoRequest, pfs.API_ExportRepoServer) error
+type importRepoFunc func(pfs.API_ImportRepoServer) error
 type createFileSetFunc func(pfs.API_CreateFileSetServer) error
 type addFileSetFunc func(context.Context, *pfs.AddFileSetRequest) (*types.Empty, error)
 type getFileSetFunc func(context.Context, *pfs.GetFileSetRequest) (*pfs.CreateFileSetResponse, error)
 type renewFileSetFunc func(context.Context, *pfs.RenewFileSetRequest) (*types.Empty, error)
 type runLoadTestFunc func(context.Context, *pfs.RunLoadTestRequest) (*pfs.RunLoadTestResponse, error)
+type rotateStorageKeyFunc func(context.Context, *pfs.RotateStorageKeyRequest) (*pfs.StorageKeyInfo, error)
+type inspectStorageKeyFunc func(context.Context, *pfs.InspectStorageKeyRequest) (*pfs.StorageKeyInfo, error)
+type createQuotaFunc func(context.Context, *pfs.CreateQuotaRequest) (*types.Empty, error)
+type inspectQuotaFunc func(context.Context, *pfs.InspectQuotaRequest) (*pfs.QuotaInfo, error)
+type listQuotaFunc func(*pfs.ListQuotaRequest, pfs.API_ListQuotaServer) error
 
 type mockActivateAuthPFS struct{ handler activateAuthPFSFunc }
 type mockCreateRepo struct{ handler createRepoFunc }
 type mockInspectRepo struct{ handler inspectRepoFunc }
 type mockListRepo struct{ handler listRepoFunc }
 type mockDeleteRepo struct{ handler deleteRepoFunc }
+type mockRenameRepo struct{ handler renameRepoFunc }
+type mockForkRepo struct{ handler forkRepoFunc }
+type mockSetMetadata struct{ handler setMetadataFunc }
 type mockStartCommit struct{ handler startCommitFunc }
 type mockFinishCommit struct{ handler finishCommitFunc }
 type mockInspectCommit struct{ handler inspectCommitFunc }
 type mockListCommit struct{ handler listCommitFunc }
 type mockSquashCommitSet struct{ handler squashCommitSetFunc }
+type mockRevertCommit struct{ handler revertCommitFunc }
+type mockCherryPickCommit struct{ handler cherryPickCommitFunc }
+type mockMergeBranch struct{ handler mergeBranchFunc }
 type mockInspectCommitSet struct{ handler inspectCommitSetFunc }
 type mockListCommitSet struct{ handler listCommitSetFunc }
 type mockSubscribeCommit struct{ handler subscribeCommitFunc }
@@ -457,8 +478,10 @@ type mockCreateBranch struct{ handler createBranchFunc }
 type mockInspectBranch struct{ handler inspectBranchFunc }
 type mockListBranch struct{ handler listBranchFunc }
 type mockDeleteBranch struct{ handler deleteBranchFunc }
+type mockRenameBranch struct{ handler renameBranchFunc }
 type mockModifyFile struct{ handler modifyFileFunc }
 type mockGetFileTAR struct{ handler getFileTARFunc }
+type mockGetFileRange struct{ handler getFileRangeFunc }
 type mockInspectFile struct{ handler inspectFileFunc }
 type mockListFile struct{ handler listFileFunc }
 type mockWalkFile struct{ handler walkFileFunc }
@@ -466,17 +489,27 @@ type mockGlobFile struct{ handler globFileFunc }
 type mockDiffFile struct{ handler diffFileFunc }
 type mockDeleteAllPFS struct{ handler deleteAllPFSFunc }
 type mockFsck struct{ handler fsckFunc }
+type mockExportRepo struct{ handler exportRepoFunc }
+type mockImportRepo struct{ handler importRepoFunc }
 type mockCreateFileSet struct{ handler createFileSetFunc }
 type mockAddFileSet struct{ handler addFileSetFunc }
 type mockGetFileSet struct{ handler getFileSetFunc }
 type mockRenewFileSet struct{ handler renewFileSetFunc }
 type mockRunLoadTest struct{ handler runLoadTestFunc }
+type mockRotateStorageKey struct{ handler rotateStorageKeyFunc }
+type mockInspectStorageKey struct{ handler inspectStorageKeyFunc }
+type mockCreateQuota struct{ handler createQuotaFunc }
+type mockInspectQuota struct{ handler inspectQuotaFunc }
+type mockListQuota struct{ handler listQuotaFunc }
 
 func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)   { mock.handler = cb }
 func (mock *mockCreateRepo) Use(cb createRepoFunc)             { mock.handler = cb }
 func (mock *mockInspectRepo) Use(cb inspectRepoFunc)           { mock.handler = cb }
 func (mock *mockListRepo) Use(cb listRepoFunc)                 { mock.handler = cb }
 func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)             { mock.handler = cb }
+func (mock *mockRenameRepo) Use(cb renameRepoFunc)             { mock.handler = cb }
+func (mock *mockForkRepo) Use(cb forkRepoFunc)                 { mock.handler = cb }
+func (mock *mockSetMetadata) Use(cb setMetadataFunc)           { mock.handler = cb }
 func (mock *mockStartCommit) Use(cb startCommitFunc)           { mock.handler = cb }
 func (mock *mockFinishCommit) Use(cb finishCommitFunc)         { mock.handler = cb }
 func (mock *mockInspectCommit) Use(cb inspectCommitFunc)       { mock.handler = cb }
@@ -484,14 +517,19 @@ func (mock *mockListCommit) Use(cb listCommitFunc)             { mock.handler =
 func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)   { mock.handler = cb }
 func (mock *mockClearCommit) Use(cb clearCommitFunc)           { mock.handler = cb }
 func (mock *mockSquashCommitSet) Use(cb squashCommitSetFunc)   { mock.handler = cb }
+func (mock *mockRevertCommit) Use(cb revertCommitFunc)         { mock.handler = cb }
+func (mock *mockCherryPickCommit) Use(cb cherryPickCommitFunc) { mock.handler = cb }
+func (mock *mockMergeBranch) Use(cb mergeBranchFunc)           { mock.handler = cb }
 func (mock *mockInspectCommitSet) Use(cb inspectCommitSetFunc) { mock.handler = cb }
 func (mock *mockListCommitSet) Use(cb listCommitSetFunc)       { mock.handler = cb }
 func (mock *mockCreateBranch) Use(cb createBranchFunc)         { mock.handler = cb }
 func (mock *mockInspectBranch) Use(cb inspectBranchFunc)       { mock.handler = cb }
 func (mock *mockListBranch) Use(cb listBranchFunc)             { mock.handler = cb }
 func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)         { mock.handler = cb }
+func (mock *mockRenameBranch) Use(cb renameBranchFunc)         { mock.handler = cb }
 func (mock *mockModifyFile) Use(cb modifyFileFunc)             { mock.handler = cb }
 func (mock *mockGetFileTAR) Use(cb getFileTARFunc)             { mock.handler = cb }
+func (mock *mockGetFileRange) Use(cb getFileRangeFunc)         { mock.handler = cb }
 func (mock *mockInspectFile) Use(cb inspectFileFunc)           { mock.handler = cb }
 func (mock *mockListFile) Use(cb listFileFunc)                 { mock.handler = cb }
 func (mock *mockWalkFile) Use(cb walkFileFunc)                 { mock.handler = cb }
@@ -499,12 +537,20 @@ func (mock *mockGlobFile) Use(cb globFileFunc)                 { mock.handler =
 func (mock *mockDiffFile) Use(cb diffFileFunc)                 { mock.handler = cb }
 func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)         { mock.handler = cb }
 func (mock *mockFsck) Use(cb fsckFunc)                         { mock.handler = cb }
+func (mock *mockExportRepo) Use(cb exportRepoFunc)             { mock.handler = cb }
+func (mock *mockImportRepo) Use(cb importRepoFunc)             { mock.handler = cb }
 func (mock *mockCreateFileSet) Use(cb createFileSetFunc)       { mock.handler = cb }
 func (mock *mockAddFileSet) Use(cb addFileSetFunc)             { mock.handler = cb }
 func (mock *mockGetFileSet) Use(cb getFileSetFunc)             { mock.handler = cb }
 func (mock *mockRenewFileSet) Use(cb renewFileSetFunc)         { mock.handler = cb }
 func (mock *mockRunLoadTest) Use(cb runLoadTestFunc)           { mock.handler = cb }
 
+func (mock *mockRotateStorageKey) Use(cb rotateStorageKeyFunc)   { mock.handler = cb }
+func (mock *mockInspectStorageKey) Use(cb inspectStorageKeyFunc) { mock.handler = cb }
+func (mock *mockCreateQuota) Use(cb createQuotaFunc)             { mock.handler = cb }
+func (mock *mockInspectQuota) Use(cb inspectQuotaFunc)           { mock.handler = cb }
+func (mock *mockListQuota) Use(cb listQuotaFunc)                 { mock.handler = cb }
+
 type pfsServerAPI struct {
 	mock *mockPFSServer
 }
@@ -516,6 +562,9 @@ type mockPFSServer struct {
 	InspectRepo      mockInspectRepo
 	ListRepo         mockListRepo
 	DeleteRepo       mockDeleteRepo
+	RenameRepo       mockRenameRepo
+	ForkRepo         mockForkRepo
+	SetMetadata      mockSetMetadata
 	StartCommit      mockStartCommit
 	FinishCommit     mockFinishCommit
 	InspectCommit    mockInspectCommit
@@ -523,14 +572,19 @@ type mockPFSServer struct {
 	SubscribeCommit  mockSubscribeCommit
 	ClearCommit      mockClearCommit
 	SquashCommitSet  mockSquashCommitSet
+	RevertCommit     mockRevertCommit
+	CherryPickCommit mockCherryPickCommit
+	MergeBranch      mockMergeBranch
 	InspectCommitSet mockInspectCommitSet
 	ListCommitSet    mockListCommitSet
 	CreateBranch     mockCreateBranch
 	InspectBranch    mockInspectBranch
 	ListBranch       mockListBranch
 	DeleteBranch     mockDeleteBranch
+	RenameBranch     mockRenameBranch
 	ModifyFile       mockModifyFile
 	GetFileTAR       mockGetFileTAR
+	GetFileRange     mockGetFileRange
 	InspectFile      mockInspectFile
 	ListFile         mockListFile
 	WalkFile         mockWalkFile
@@ -538,11 +592,19 @@ type mockPFSServer struct {
 	DiffFile         mockDiffFile
 	DeleteAll        mockDeleteAllPFS
 	Fsck             mockFsck
+	ExportRepo       mockExportRepo
+	ImportRepo       mockImportRepo
 	CreateFileSet    mockCreateFileSet
 	AddFileSet       mockAddFileSet
 	GetFileSet       mockGetFileSet
 	RenewFileSet     mockRenewFileSet
 	RunLoadTest      mockRunLoadTest
+
+	RotateStorageKey  mockRotateStorageKey
+	InspectStorageKey mockInspectStorageKey
+	CreateQuota       mockCreateQuota
+	InspectQuota      mockInspectQuota
+	ListQuota         mockListQuota
 }
 
 func (api *pfsServerAPI) ActivateAuth(ctx context.Context, req *pfs.ActivateAuthRequest) (*pfs.ActivateAuthResponse, error) {
@@ -575,6 +637,24 @@ func (api *pfsServerAPI) DeleteRepo(ctx context.Context, req *pfs.DeleteRepoRequ
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteRepo")
 }
+func (api *pfsServerAPI) RenameRepo(ctx context.Context, req *pfs.RenameRepoRequest) (*types.Empty, error) {
+	if api.mock.RenameRepo.handler != nil {
+		return api.mock.RenameRepo.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RenameRepo")
+}
+func (api *pfsServerAPI) ForkRepo(ctx context.Context, req *pfs.ForkRepoRequest) (*types.Empty, error) {
+	if api.mock.ForkRepo.handler != nil {
+		return api.mock.ForkRepo.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ForkRepo")
+}
+func (api *pfsServerAPI) SetMetadata(ctx context.Context, req *pfs.SetMetadataRequest) (*types.Empty, error) {
+	if api.mock.SetMetadata.handler != nil {
+		return api.mock.SetMetadata.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.SetMetadata")
+}
 func (api *pfsServerAPI) StartCommit(ctx context.Context, req *pfs.StartCommitRequest) (*pfs.Commit, error) {
 	if api.mock.StartCommit.handler != nil {
 		return api.mock.StartCommit.handler(ctx, req)
@@ -605,6 +685,24 @@ func (api *pfsServerAPI) SquashCommitSet(ctx context.Context, req *pfs.SquashCom
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.SquashCommitSet")
 }
+func (api *pfsServerAPI) RevertCommit(ctx context.Context, req *pfs.RevertCommitRequest) (*pfs.Commit, error) {
+	if api.mock.RevertCommit.handler != nil {
+		return api.mock.RevertCommit.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RevertCommit")
+}
+func (api *pfsServerAPI) CherryPickCommit(ctx context.Context, req *pfs.CherryPickCommitRequest) (*pfs.Commit, error) {
+	if api.mock.CherryPickCommit.handler != nil {
+		return api.mock.CherryPickCommit.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CherryPickCommit")
+}
+func (api *pfsServerAPI) MergeBranch(ctx context.Context, req *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error) {
+	if api.mock.MergeBranch.handler != nil {
+		return api.mock.MergeBranch.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.MergeBranch")
+}
 func (api *pfsServerAPI) InspectCommitSet(req *pfs.InspectCommitSetRequest, serv pfs.API_InspectCommitSetServer) error {
 	if api.mock.InspectCommitSet.handler != nil {
 		return api.mock.InspectCommitSet.handler(req, serv)
@@ -653,6 +751,12 @@ func (api *pfsServerAPI) DeleteBranch(ctx context.Context, req *pfs.DeleteBranch
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteBranch")
 }
+func (api *pfsServerAPI) RenameBranch(ctx context.Context, req *pfs.RenameBranchRequest) (*types.Empty, error) {
+	if api.mock.RenameBranch.handler != nil {
+		return api.mock.RenameBranch.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RenameBranch")
+}
 func (api *pfsServerAPI) ModifyFile(serv pfs.API_ModifyFileServer) error {
 	if api.mock.ModifyFile.handler != nil {
 		return api.mock.ModifyFile.handler(serv)
@@ -665,6 +769,12 @@ func (api *pfsServerAPI) GetFileTAR(req *pfs.GetFileRequest, serv pfs.API_GetFil
 	}
 	return errors.Errorf("unhandled pachd mock pfs.GetFileTAR")
 }
+func (api *pfsServerAPI) GetFileRange(req *pfs.GetFileRangeRequest, serv pfs.API_GetFileRangeServer) error {
+	if api.mock.GetFileRange.handler != nil {
+		return api.mock.GetFileRange.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.GetFileRange")
+}
 func (api *pfsServerAPI) InspectFile(ctx context.Context, req *pfs.InspectFileRequest) (*pfs.FileInfo, error) {
 	if api.mock.InspectFile.handler != nil {
 		return api.mock.InspectFile.handler(ctx, req)
@@ -707,6 +817,48 @@ func (api *pfsServerAPI) Fsck(req *pfs.FsckRequest, serv pfs.API_FsckServer) err
 	}
 	return errors.Errorf("unhandled pachd mock pfs.Fsck")
 }
+func (api *pfsServerAPI) ExportRepo(req *pfs.ExportRepoRequest, serv pfs.API_ExportRepoServer) error {
+	if api.mock.ExportRepo.handler != nil {
+		return api.mock.ExportRepo.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ExportRepo")
+}
+func (api *pfsServerAPI) ImportRepo(serv pfs.API_ImportRepoServer) error {
+	if api.mock.ImportRepo.handler != nil {
+		return api.mock.ImportRepo.handler(serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ImportRepo")
+}
+func (api *pfsServerAPI) RotateStorageKey(ctx context.Context, req *pfs.RotateStorageKeyRequest) (*pfs.StorageKeyInfo, error) {
+	if api.mock.RotateStorageKey.handler != nil {
+		return api.mock.RotateStorageKey.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RotateStorageKey")
+}
+func (api *pfsServerAPI) InspectStorageKey(ctx context.Context, req *pfs.InspectStorageKeyRequest) (*pfs.StorageKeyInfo, error) {
+	if api.mock.InspectStorageKey.handler != nil {
+		return api.mock.InspectStorageKey.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectStorageKey")
+}
+func (api *pfsServerAPI) CreateQuota(ctx context.Context, req *pfs.CreateQuotaRequest) (*types.Empty, error) {
+	if api.mock.CreateQuota.handler != nil {
+		return api.mock.CreateQuota.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateQuota")
+}
+func (api *pfsServerAPI) InspectQuota(ctx context.Context, req *pfs.InspectQuotaRequest) (*pfs.QuotaInfo, error) {
+	if api.mock.InspectQuota.handler != nil {
+		return api.mock.InspectQuota.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectQuota")
+}
+func (api *pfsServerAPI) ListQuota(req *pfs.ListQuotaRequest, serv pfs.API_ListQuotaServer) error {
+	if api.mock.ListQuota.handler != nil {
+		return api.mock.ListQuota.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ListQuota")
+}
 func (api *pfsServerAPI) CreateFileSet(srv pfs.API_CreateFileSetServer) error {
 	if api.mock.CreateFileSet.handler != nil {
 		return api.mock.CreateFileSet.handler(srv)
@@ -762,6 +914,10 @@ type runCronFunc func(context.Context, *pps.RunCronRequest) (*types.Empty, error
 type createSecretFunc func(context.Context, *pps.CreateSecretRequest) (*types.Empty, error)
 type deleteSecretFunc func(context.Context, *pps.DeleteSecretRequest) (*types.Empty, error)
 type inspectSecretFunc func(context.Context, *pps.InspectSecretRequest) (*pps.SecretInfo, error)
+type createWebhookFunc func(context.Context, *pps.CreateWebhookRequest) (*pps.WebhookInfo, error)
+type listWebhookFunc func(*pps.ListWebhookRequest, pps.API_ListWebhookServer) error
+type deleteWebhookFunc func(context.Context, *pps.DeleteWebhookRequest) (*types.Empty, error)
+type listWebhookDeliveryFunc func(*pps.ListWebhookDeliveryRequest, pps.API_ListWebhookDeliveryServer) error
 type listSecretFunc func(context.Context, *types.Empty) (*pps.SecretInfos, error)
 type deleteAllPPSFunc func(context.Context, *types.Empty) (*types.Empty, error)
 type getLogsFunc func(*pps.GetLogsRequest, pps.API_GetLogsServer) error
@@ -789,70 +945,82 @@ type mockRunCron struct{ handler runCronFunc }
 type mockCreateSecret struct{ handler createSecretFunc }
 type mockDeleteSecret struct{ handler deleteSecretFunc }
 type mockInspectSecret struct{ handler inspectSecretFunc }
+type mockCreateWebhook struct{ handler createWebhookFunc }
+type mockListWebhook struct{ handler listWebhookFunc }
+type mockDeleteWebhook struct{ handler deleteWebhookFunc }
+type mockListWebhookDelivery struct{ handler listWebhookDeliveryFunc }
 type mockListSecret struct{ handler listSecretFunc }
 type mockDeleteAllPPS struct{ handler deleteAllPPSFunc }
 type mockGetLogs struct{ handler getLogsFunc }
 type mockActivateAuthPPS struct{ handler activateAuthPPSFunc }
 
-func (mock *mockInspectJob) Use(cb inspectJobFunc)           { mock.handler = cb }
-func (mock *mockListJob) Use(cb listJobFunc)                 { mock.handler = cb }
-func (mock *mockSubscribeJob) Use(cb subscribeJobFunc)       { mock.handler = cb }
-func (mock *mockDeleteJob) Use(cb deleteJobFunc)             { mock.handler = cb }
-func (mock *mockStopJob) Use(cb stopJobFunc)                 { mock.handler = cb }
-func (mock *mockUpdateJobState) Use(cb updateJobStateFunc)   { mock.handler = cb }
-func (mock *mockInspectJobSet) Use(cb inspectJobSetFunc)     { mock.handler = cb }
-func (mock *mockListJobSet) Use(cb listJobSetFunc)           { mock.handler = cb }
-func (mock *mockInspectDatum) Use(cb inspectDatumFunc)       { mock.handler = cb }
-func (mock *mockListDatum) Use(cb listDatumFunc)             { mock.handler = cb }
-func (mock *mockRestartDatum) Use(cb restartDatumFunc)       { mock.handler = cb }
-func (mock *mockCreatePipeline) Use(cb createPipelineFunc)   { mock.handler = cb }
-func (mock *mockInspectPipeline) Use(cb inspectPipelineFunc) { mock.handler = cb }
-func (mock *mockListPipeline) Use(cb listPipelineFunc)       { mock.handler = cb }
-func (mock *mockDeletePipeline) Use(cb deletePipelineFunc)   { mock.handler = cb }
-func (mock *mockStartPipeline) Use(cb startPipelineFunc)     { mock.handler = cb }
-func (mock *mockStopPipeline) Use(cb stopPipelineFunc)       { mock.handler = cb }
-func (mock *mockRunPipeline) Use(cb runPipelineFunc)         { mock.handler = cb }
-func (mock *mockRunCron) Use(cb runCronFunc)                 { mock.handler = cb }
-func (mock *mockCreateSecret) Use(cb createSecretFunc)       { mock.handler = cb }
-func (mock *mockDeleteSecret) Use(cb deleteSecretFunc)       { mock.handler = cb }
-func (mock *mockInspectSecret) Use(cb inspectSecretFunc)     { mock.handler = cb }
-func (mock *mockListSecret) Use(cb listSecretFunc)           { mock.handler = cb }
-func (mock *mockDeleteAllPPS) Use(cb deleteAllPPSFunc)       { mock.handler = cb }
-func (mock *mockGetLogs) Use(cb getLogsFunc)                 { mock.handler = cb }
-func (mock *mockActivateAuthPPS) Use(cb activateAuthPPSFunc) { mock.handler = cb }
+func (mock *mockInspectJob) Use(cb inspectJobFunc)                   { mock.handler = cb }
+func (mock *mockListJob) Use(cb listJobFunc)                         { mock.handler = cb }
+func (mock *mockSubscribeJob) Use(cb subscribeJobFunc)               { mock.handler = cb }
+func (mock *mockDeleteJob) Use(cb deleteJobFunc)                     { mock.handler = cb }
+func (mock *mockStopJob) Use(cb stopJobFunc)                         { mock.handler = cb }
+func (mock *mockUpdateJobState) Use(cb updateJobStateFunc)           { mock.handler = cb }
+func (mock *mockInspectJobSet) Use(cb inspectJobSetFunc)             { mock.handler = cb }
+func (mock *mockListJobSet) Use(cb listJobSetFunc)                   { mock.handler = cb }
+func (mock *mockInspectDatum) Use(cb inspectDatumFunc)               { mock.handler = cb }
+func (mock *mockListDatum) Use(cb listDatumFunc)                     { mock.handler = cb }
+func (mock *mockRestartDatum) Use(cb restartDatumFunc)               { mock.handler = cb }
+func (mock *mockCreatePipeline) Use(cb createPipelineFunc)           { mock.handler = cb }
+func (mock *mockInspectPipeline) Use(cb inspectPipelineFunc)         { mock.handler = cb }
+func (mock *mockListPipeline) Use(cb listPipelineFunc)               { mock.handler = cb }
+func (mock *mockDeletePipeline) Use(cb deletePipelineFunc)           { mock.handler = cb }
+func (mock *mockStartPipeline) Use(cb startPipelineFunc)             { mock.handler = cb }
+func (mock *mockStopPipeline) Use(cb stopPipelineFunc)               { mock.handler = cb }
+func (mock *mockRunPipeline) Use(cb runPipelineFunc)                 { mock.handler = cb }
+func (mock *mockRunCron) Use(cb runCronFunc)                         { mock.handler = cb }
+func (mock *mockCreateSecret) Use(cb createSecretFunc)               { mock.handler = cb }
+func (mock *mockDeleteSecret) Use(cb deleteSecretFunc)               { mock.handler = cb }
+func (mock *mockInspectSecret) Use(cb inspectSecretFunc)             { mock.handler = cb }
+func (mock *mockCreateWebhook) Use(cb createWebhookFunc)             { mock.handler = cb }
+func (mock *mockListWebhook) Use(cb listWebhookFunc)                 { mock.handler = cb }
+func (mock *mockDeleteWebhook) Use(cb deleteWebhookFunc)             { mock.handler = cb }
+func (mock *mockListWebhookDelivery) Use(cb listWebhookDeliveryFunc) { mock.handler = cb }
+func (mock *mockListSecret) Use(cb listSecretFunc)                   { mock.handler = cb }
+func (mock *mockDeleteAllPPS) Use(cb deleteAllPPSFunc)               { mock.handler = cb }
+func (mock *mockGetLogs) Use(cb getLogsFunc)                         { mock.handler = cb }
+func (mock *mockActivateAuthPPS) Use(cb activateAuthPPSFunc)         { mock.handler = cb }
 
 type ppsServerAPI struct {
 	mock *mockPPSServer
 }
 
 type mockPPSServer struct {
-	api             ppsServerAPI
-	InspectJob      mockInspectJob
-	ListJob         mockListJob
-	SubscribeJob    mockSubscribeJob
-	DeleteJob       mockDeleteJob
-	StopJob         mockStopJob
-	UpdateJobState  mockUpdateJobState
-	InspectJobSet   mockInspectJobSet
-	ListJobSet      mockListJobSet
-	InspectDatum    mockInspectDatum
-	ListDatum       mockListDatum
-	RestartDatum    mockRestartDatum
-	CreatePipeline  mockCreatePipeline
-	InspectPipeline mockInspectPipeline
-	ListPipeline    mockListPipeline
-	DeletePipeline  mockDeletePipeline
-	StartPipeline   mockStartPipeline
-	StopPipeline    mockStopPipeline
-	RunPipeline     mockRunPipeline
-	RunCron         mockRunCron
-	CreateSecret    mockCreateSecret
-	DeleteSecret    mockDeleteSecret
-	InspectSecret   mockInspectSecret
-	ListSecret      mockListSecret
-	DeleteAll       mockDeleteAllPPS
-	GetLogs         mockGetLogs
-	ActivateAuth    mockActivateAuthPPS
+	api                 ppsServerAPI
+	InspectJob          mockInspectJob
+	ListJob             mockListJob
+	SubscribeJob        mockSubscribeJob
+	DeleteJob           mockDeleteJob
+	StopJob             mockStopJob
+	UpdateJobState      mockUpdateJobState
+	InspectJobSet       mockInspectJobSet
+	ListJobSet          mockListJobSet
+	InspectDatum        mockInspectDatum
+	ListDatum           mockListDatum
+	RestartDatum        mockRestartDatum
+	CreatePipeline      mockCreatePipeline
+	InspectPipeline     mockInspectPipeline
+	ListPipeline        mockListPipeline
+	DeletePipeline      mockDeletePipeline
+	StartPipeline       mockStartPipeline
+	StopPipeline        mockStopPipeline
+	RunPipeline         mockRunPipeline
+	RunCron             mockRunCron
+	CreateSecret        mockCreateSecret
+	DeleteSecret        mockDeleteSecret
+	InspectSecret       mockInspectSecret
+	CreateWebhook       mockCreateWebhook
+	ListWebhook         mockListWebhook
+	DeleteWebhook       mockDeleteWebhook
+	ListWebhookDelivery mockListWebhookDelivery
+	ListSecret          mockListSecret
+	DeleteAll           mockDeleteAllPPS
+	GetLogs             mockGetLogs
+	ActivateAuth        mockActivateAuthPPS
 }
 
 func (api *ppsServerAPI) InspectJob(ctx context.Context, req *pps.InspectJobRequest) (*pps.JobInfo, error) {
@@ -987,6 +1155,30 @@ func (api *ppsServerAPI) InspectSecret(ctx context.Context, req *pps.InspectSecr
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.InspectSecret")
 }
+func (api *ppsServerAPI) CreateWebhook(ctx context.Context, req *pps.CreateWebhookRequest) (*pps.WebhookInfo, error) {
+	if api.mock.CreateWebhook.handler != nil {
+		return api.mock.CreateWebhook.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.CreateWebhook")
+}
+func (api *ppsServerAPI) ListWebhook(req *pps.ListWebhookRequest, serv pps.API_ListWebhookServer) error {
+	if api.mock.ListWebhook.handler != nil {
+		return api.mock.ListWebhook.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pps.ListWebhook")
+}
+func (api *ppsServerAPI) DeleteWebhook(ctx context.Context, req *pps.DeleteWebhookRequest) (*types.Empty, error) {
+	if api.mock.DeleteWebhook.handler != nil {
+		return api.mock.DeleteWebhook.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.DeleteWebhook")
+}
+func (api *ppsServerAPI) ListWebhookDelivery(req *pps.ListWebhookDeliveryRequest, serv pps.API_ListWebhookDeliveryServer) error {
+	if api.mock.ListWebhookDelivery.handler != nil {
+		return api.mock.ListWebhookDelivery.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pps.ListWebhookDelivery")
+}
 func (api *ppsServerAPI) ListSecret(ctx context.Context, in *types.Empty) (*pps.SecretInfos, error) {
 	if api.mock.ListSecret.handler != nil {
 		return api.mock.ListSecret.handler(ctx, in)
@@ -58,6 +58,26 @@ func (mock *mockCreatePipelineInTransaction) Use(cb createPipelineInTransactionF
 	mock.handler = cb
 }
 
+type renameInputRepoInTransactionFunc func(*txncontext.TransactionContext, string, string) error
+
+type mockRenameInputRepoInTransaction struct {
+	handler renameInputRepoInTransactionFunc
+}
+
+func (mock *mockRenameInputRepoInTransaction) Use(cb renameInputRepoInTransactionFunc) {
+	mock.handler = cb
+}
+
+type renameInputBranchInTransactionFunc func(*txncontext.TransactionContext, string, string, string) error
+
+type mockRenameInputBranchInTransaction struct {
+	handler renameInputBranchInTransactionFunc
+}
+
+func (mock *mockRenameInputBranchInTransaction) Use(cb renameInputBranchInTransactionFunc) {
+	mock.handler = cb
+}
+
 type ppsTransactionAPI struct {
 	ppsServerAPI
 	mock *MockPPSTransactionServer
@@ -66,12 +86,14 @@ type ppsTransactionAPI struct {
 // MockPPSTransactionServer provides a mocking interface for overriding PPS
 // behavior inside transactions.
 type MockPPSTransactionServer struct {
-	api                         ppsTransactionAPI
-	NewPropagater               mockNewPropagater
-	NewJobStopper               mockNewJobStopper
-	StopJobInTransaction        mockStopJobInTransaction
-	UpdateJobStateInTransaction mockUpdateJobStateInTransaction
-	CreatePipelineInTransaction mockCreatePipelineInTransaction
+	api                            ppsTransactionAPI
+	NewPropagater                  mockNewPropagater
+	NewJobStopper                  mockNewJobStopper
+	StopJobInTransaction           mockStopJobInTransaction
+	UpdateJobStateInTransaction    mockUpdateJobStateInTransaction
+	CreatePipelineInTransaction    mockCreatePipelineInTransaction
+	RenameInputRepoInTransaction   mockRenameInputRepoInTransaction
+	RenameInputBranchInTransaction mockRenameInputBranchInTransaction
 }
 
 type MockPPSPropagater struct{}
@@ -119,6 +141,24 @@ func (api *ppsTransactionAPI) CreatePipelineInTransaction(txnCtx *txncontext.Tra
 	return fmt.Errorf("unhandled pachd mock: pps.CreatePipelineInTransaction")
 }
 
+// RenameInputRepoInTransaction does nothing unless mocked, as there are no
+// pipelines whose inputs need to be renamed.
+func (api *ppsTransactionAPI) RenameInputRepoInTransaction(txnCtx *txncontext.TransactionContext, oldName, newName string) error {
+	if api.mock.RenameInputRepoInTransaction.handler != nil {
+		return api.mock.RenameInputRepoInTransaction.handler(txnCtx, oldName, newName)
+	}
+	return nil
+}
+
+// RenameInputBranchInTransaction does nothing unless mocked, as there are no
+// pipelines whose inputs need to be renamed.
+func (api *ppsTransactionAPI) RenameInputBranchInTransaction(txnCtx *txncontext.TransactionContext, repo, oldName, newName string) error {
+	if api.mock.RenameInputBranchInTransaction.handler != nil {
+		return api.mock.RenameInputBranchInTransaction.handler(txnCtx, repo, oldName, newName)
+	}
+	return nil
+}
+
 // NewMockPPSTransactionServer instantiates a MockPPSTransactionServer
 func NewMockPPSTransactionServer() *MockPPSTransactionServer {
 	result := &MockPPSTransactionServer{}
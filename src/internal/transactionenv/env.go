@@ -27,6 +27,7 @@ import (
 type PfsWrites interface {
 	CreateRepo(*pfs.CreateRepoRequest) error
 	DeleteRepo(*pfs.DeleteRepoRequest) error
+	RenameRepo(*pfs.RenameRepoRequest) error
 
 	StartCommit(*pfs.StartCommitRequest) (*pfs.Commit, error)
 	FinishCommit(*pfs.FinishCommitRequest) error
@@ -34,6 +35,7 @@ type PfsWrites interface {
 
 	CreateBranch(*pfs.CreateBranchRequest) error
 	DeleteBranch(*pfs.DeleteBranchRequest) error
+	RenameBranch(*pfs.RenameBranchRequest) error
 }
 
 // PpsWrites is an interface providing a wrapper for each operation that
@@ -126,6 +128,11 @@ func (t *directTransaction) DeleteRepo(original *pfs.DeleteRepoRequest) error {
 	return t.txnEnv.serviceEnv.PfsServer().DeleteRepoInTransaction(t.txnCtx, req)
 }
 
+func (t *directTransaction) RenameRepo(original *pfs.RenameRepoRequest) error {
+	req := proto.Clone(original).(*pfs.RenameRepoRequest)
+	return t.txnEnv.serviceEnv.PfsServer().RenameRepoInTransaction(t.txnCtx, req)
+}
+
 func (t *directTransaction) StartCommit(original *pfs.StartCommitRequest) (*pfs.Commit, error) {
 	req := proto.Clone(original).(*pfs.StartCommitRequest)
 	return t.txnEnv.serviceEnv.PfsServer().StartCommitInTransaction(t.txnCtx, req)
@@ -151,6 +158,11 @@ func (t *directTransaction) DeleteBranch(original *pfs.DeleteBranchRequest) erro
 	return t.txnEnv.serviceEnv.PfsServer().DeleteBranchInTransaction(t.txnCtx, req)
 }
 
+func (t *directTransaction) RenameBranch(original *pfs.RenameBranchRequest) error {
+	req := proto.Clone(original).(*pfs.RenameBranchRequest)
+	return t.txnEnv.serviceEnv.PfsServer().RenameBranchInTransaction(t.txnCtx, req)
+}
+
 func (t *directTransaction) StopJob(original *pps.StopJobRequest) error {
 	req := proto.Clone(original).(*pps.StopJobRequest)
 	return t.txnEnv.serviceEnv.PpsServer().StopJobInTransaction(t.txnCtx, req)
@@ -200,6 +212,11 @@ func (t *appendTransaction) DeleteRepo(req *pfs.DeleteRepoRequest) error {
 	return err
 }
 
+func (t *appendTransaction) RenameRepo(req *pfs.RenameRepoRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{RenameRepo: req})
+	return err
+}
+
 func (t *appendTransaction) StartCommit(req *pfs.StartCommitRequest) (*pfs.Commit, error) {
 	res, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StartCommit: req})
 	if err != nil {
@@ -228,6 +245,11 @@ func (t *appendTransaction) DeleteBranch(req *pfs.DeleteBranchRequest) error {
 	return err
 }
 
+func (t *appendTransaction) RenameBranch(req *pfs.RenameBranchRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{RenameBranch: req})
+	return err
+}
+
 func (t *appendTransaction) StopJob(req *pps.StopJobRequest) error {
 	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StopJob: req})
 	return err
@@ -1,11 +1,14 @@
 package pfs
 
 import (
+	"encoding/base64"
 	"encoding/hex"
 	"hash"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
 )
 
@@ -17,6 +20,11 @@ const (
 	UserRepoType = "user"
 	MetaRepoType = "meta"
 	SpecRepoType = "spec"
+
+	// kinds of continuation tokens
+	FileContinuation   = "file"
+	CommitContinuation = "commit"
+	RepoContinuation   = "repo"
 )
 
 // NewHash returns a hash that PFS uses internally to compute checksums.
@@ -34,6 +42,30 @@ func DecodeHash(hash string) ([]byte, error) {
 	return hex.DecodeString(hash)
 }
 
+// EncodeContinuation encodes the position that a paginated list of the given
+// kind resumes at into an opaque continuation token.
+func EncodeContinuation(kind, position string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(kind + ":" + position))
+}
+
+// DecodeContinuation decodes the position from a continuation token returned
+// by a paginated list of the given kind. An empty token decodes to an empty
+// position, which is the start of the list.
+func DecodeContinuation(kind, token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", errors.Errorf("invalid continuation token %q", token)
+	}
+	position := strings.TrimPrefix(string(data), kind+":")
+	if len(position) == len(data) {
+		return "", errors.Errorf("invalid continuation token %q for a list of %ss", token, kind)
+	}
+	return position, nil
+}
+
 func (r *Repo) String() string {
 	if r.Type == UserRepoType {
 		return r.Name
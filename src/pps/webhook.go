@@ -0,0 +1,39 @@
+package pps
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// WebhookSignatureHeader is the header of webhook requests that holds
+	// "sha256=" followed by the hex encoded HMAC-SHA256 of the payload, keyed
+	// by the webhook's secret.
+	WebhookSignatureHeader = "X-Pachyderm-Signature"
+	// WebhookDeliveryHeader is the header of webhook requests that holds the
+	// ID of the delivery. A delivery may be sent more than once, so receivers
+	// can use it to deduplicate them.
+	WebhookDeliveryHeader = "X-Pachyderm-Delivery"
+	// WebhookEventHeader is the header of webhook requests that holds the type
+	// of the event, "commit" or "job".
+	WebhookEventHeader = "X-Pachyderm-Event"
+)
+
+// SignWebhookPayload returns the value of the WebhookSignatureHeader of a
+// webhook request with payload.
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature returns true if signature, the value of the
+// WebhookSignatureHeader of a webhook request, is valid for payload.
+func VerifyWebhookSignature(secret string, payload []byte, signature string) bool {
+	if !strings.HasPrefix(signature, "sha256=") {
+		return false
+	}
+	return hmac.Equal([]byte(SignWebhookPayload(secret, payload)), []byte(signature))
+}
@@ -32,6 +32,7 @@ type APIServer interface {
 	// Create and Delete are internal-only APIs used by other services when creating/destroying resources.
 	CreateRoleBindingInTransaction(*txncontext.TransactionContext, string, []string, *auth_client.Resource) error
 	DeleteRoleBindingInTransaction(*txncontext.TransactionContext, *auth_client.Resource) error
+	RenameRoleBindingInTransaction(*txncontext.TransactionContext, *auth_client.Resource, string) error
 
 	// GetPipelineAuthTokenInTransaction is an internal API used by PPS to generate tokens for pipelines
 	GetPipelineAuthTokenInTransaction(*txncontext.TransactionContext, string) (string, error)
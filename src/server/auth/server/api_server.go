@@ -653,6 +653,30 @@ func (a *apiServer) DeleteRoleBindingInTransaction(txnCtx *txncontext.Transactio
 	return nil
 }
 
+// RenameRoleBindingInTransaction is used to move the role binding of a resource
+// when it's renamed in other services. It doesn't do any auth checks itself -
+// the calling method should ensure the user is allowed to rename this resource.
+// This is not an RPC, this is only called in-process.
+func (a *apiServer) RenameRoleBindingInTransaction(txnCtx *txncontext.TransactionContext, resource *auth.Resource, newName string) error {
+	if err := a.isActive(txnCtx.ClientContext); err != nil {
+		return err
+	}
+
+	if resource.Type == auth.ResourceType_CLUSTER {
+		return fmt.Errorf("cannot rename cluster role binding")
+	}
+
+	roleBindings := a.roleBindings.ReadWrite(txnCtx.SqlTx)
+	var bindings auth.RoleBinding
+	if err := roleBindings.Get(resourceKey(resource), &bindings); err != nil {
+		return err
+	}
+	if err := roleBindings.Delete(resourceKey(resource)); err != nil {
+		return err
+	}
+	return roleBindings.Create(resourceKey(&auth.Resource{Type: resource.Type, Name: newName}), &bindings)
+}
+
 // rolesFromRoleSlice converts a slice of strings into *auth.Roles,
 // validating that each role name is valid.
 func rolesFromRoleSlice(rs []string) (*auth.Roles, error) {
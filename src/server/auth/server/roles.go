@@ -70,13 +70,15 @@ func init() {
 	})
 
 	// repoOwner has the ability to modify the role bindings for
-	// a repo and delete it, plus all the permissions of repoWriter.
+	// a repo, modify the protection of its branches and delete it,
+	// plus all the permissions of repoWriter.
 	repoOwnerRole := registerRole(&auth.Role{
 		Name:          auth.RepoOwnerRole,
 		ResourceTypes: []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_REPO},
 		Permissions: combinePermissions(repoWriterRole.Permissions, []auth.Permission{
 			auth.Permission_REPO_MODIFY_BINDINGS,
 			auth.Permission_REPO_DELETE,
+			auth.Permission_REPO_MODIFY_BRANCH_PROTECTION,
 		}),
 	})
 
@@ -207,6 +209,12 @@ func init() {
 				auth.Permission_CLUSTER_ENTERPRISE_GET_CODE,
 				auth.Permission_CLUSTER_ENTERPRISE_DEACTIVATE,
 				auth.Permission_CLUSTER_DELETE_ALL,
+				auth.Permission_CLUSTER_ROTATE_STORAGE_KEY,
+				auth.Permission_CLUSTER_INSPECT_STORAGE_KEY,
+				auth.Permission_CLUSTER_EDIT_QUOTA,
+				auth.Permission_CLUSTER_EDIT_WEBHOOKS,
+				auth.Permission_CLUSTER_LIST_WEBHOOKS,
+				auth.Permission_REPO_BYPASS_BRANCH_PROTECTION,
 			}),
 	})
 }
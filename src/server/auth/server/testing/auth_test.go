@@ -27,6 +27,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/license"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 
 	minio "github.com/minio/minio-go/v6"
 	globlib "github.com/pachyderm/ohmyglob"
@@ -357,6 +358,231 @@ func TestCreateAndUpdateRepo(t *testing.T) {
 	require.Equal(t, "1", buf.String())
 }
 
+// TestBranchProtection tests that only the principals allowed by the
+// protection of a branch can move its head, that protected branches can't be
+// deleted, and that only repo owners can change the protection.
+func TestBranchProtection(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+	rootClient := tu.GetAuthenticatedPachClient(t, auth.RootUser)
+
+	dataRepo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(dataRepo))
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(dataRepo, bob, []string{auth.RepoWriterRole}))
+	require.NoError(t, aliceClient.CreateBranch(dataRepo, "master", "", "", nil))
+
+	// bob is a writer, but can't protect the branch
+	err := bobClient.ProtectBranch(dataRepo, "master", &pfs.BranchProtection{NoDelete: true})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// only alice can move the head of master, and nobody can delete it
+	require.NoError(t, aliceClient.ProtectBranch(dataRepo, "master", &pfs.BranchProtection{
+		Principals: []string{alice},
+		NoDelete:   true,
+	}))
+	branchInfo, err := aliceClient.InspectBranch(dataRepo, "master")
+	require.NoError(t, err)
+	require.Equal(t, []string{alice}, branchInfo.Protection.Principals)
+	_, err = bobClient.StartCommit(dataRepo, "master")
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsBranchProtectedErr(err), err.Error())
+	require.NoError(t, aliceClient.PutFile(client.NewCommit(dataRepo, "master", ""), "/file", strings.NewReader("1")))
+	err = aliceClient.DeleteBranch(dataRepo, "master", false)
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsBranchProtectedErr(err), err.Error())
+	// root can bypass the protection
+	_, err = rootClient.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, rootClient.FinishCommit(dataRepo, "master", ""))
+
+	// commits must be moved to master from the staging branch
+	require.NoError(t, aliceClient.ProtectBranch(dataRepo, "master", &pfs.BranchProtection{StagingBranch: "staging"}))
+	_, err = aliceClient.StartCommit(dataRepo, "master")
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsBranchProtectedErr(err), err.Error())
+	require.NoError(t, bobClient.PutFile(client.NewCommit(dataRepo, "staging", ""), "/file", strings.NewReader("2")))
+	require.NoError(t, bobClient.CreateBranch(dataRepo, "master", "staging", "", nil))
+	buf := &bytes.Buffer{}
+	require.NoError(t, bobClient.GetFile(client.NewCommit(dataRepo, "master", ""), "/file", buf))
+	require.Equal(t, "2", buf.String())
+
+	// an empty protection removes it
+	require.NoError(t, aliceClient.ProtectBranch(dataRepo, "master", &pfs.BranchProtection{}))
+	branchInfo, err = aliceClient.InspectBranch(dataRepo, "master")
+	require.NoError(t, err)
+	require.Nil(t, branchInfo.Protection)
+	require.NoError(t, bobClient.DeleteBranch(dataRepo, "master", false))
+}
+
+// TestBranchProtectionAutomaticHead tests that only principals who can move
+// the head of a protected branch can give it a trigger or provenance, which
+// then move its head for anyone.
+func TestBranchProtectionAutomaticHead(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+
+	dataRepo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(dataRepo))
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(dataRepo, bob, []string{auth.RepoWriterRole}))
+	require.NoError(t, aliceClient.CreateBranch(dataRepo, "master", "", "", nil))
+	require.NoError(t, aliceClient.CreateBranch(dataRepo, "dev", "", "", nil))
+	upstreamRepo := tu.UniqueString(t.Name())
+	require.NoError(t, bobClient.CreateRepo(upstreamRepo))
+	require.NoError(t, bobClient.CreateBranch(upstreamRepo, "master", "", "", nil))
+	require.NoError(t, aliceClient.ProtectBranch(dataRepo, "master", &pfs.BranchProtection{Principals: []string{alice}}))
+
+	// bob can't give master a trigger or provenance
+	trigger := &pfs.Trigger{Branch: "dev", Commits: 1}
+	err := bobClient.CreateBranchTrigger(dataRepo, "master", "", "", trigger)
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsBranchProtectedErr(err), err.Error())
+	err = bobClient.CreateBranch(dataRepo, "master", "", "", []*pfs.Branch{client.NewBranch(upstreamRepo, "master")})
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsBranchProtectedErr(err), err.Error())
+
+	// once alice gives master a trigger, bob's commits move its head
+	require.NoError(t, aliceClient.CreateBranchTrigger(dataRepo, "master", "", "", trigger))
+	require.NoError(t, bobClient.PutFile(client.NewCommit(dataRepo, "dev", ""), "/file", strings.NewReader("1")))
+	buf := &bytes.Buffer{}
+	require.NoError(t, bobClient.GetFile(client.NewCommit(dataRepo, "master", ""), "/file", buf))
+	require.Equal(t, "1", buf.String())
+
+	// a branch with a staging branch can only be triggered by it
+	err = aliceClient.ProtectBranch(dataRepo, "master", &pfs.BranchProtection{StagingBranch: "staging"})
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsBranchProtectedErr(err), err.Error())
+	require.NoError(t, aliceClient.CreateBranch(dataRepo, "prod", "", "", nil))
+	require.NoError(t, aliceClient.ProtectBranch(dataRepo, "prod", &pfs.BranchProtection{StagingBranch: "dev"}))
+	err = aliceClient.CreateBranchTrigger(dataRepo, "prod", "", "", &pfs.Trigger{Branch: "master", Commits: 1})
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsBranchProtectedErr(err), err.Error())
+	require.NoError(t, aliceClient.CreateBranchTrigger(dataRepo, "prod", "", "", trigger))
+}
+
+// TestBranchProtectionNoForce tests that commits on a branch protected with
+// NoForce can't be force-finished by users, but can still be finished by the
+// pipeline outputting to it, and by stopping its jobs.
+func TestBranchProtectionNoForce(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice := robot(tu.UniqueString("alice"))
+	aliceClient := tu.GetAuthenticatedPachClient(t, alice)
+
+	repo := tu.UniqueString(t.Name())
+	commit := client.NewCommit(repo, "master", "")
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	pipeline := tu.UniqueString("alice-pipeline")
+	require.NoError(t, aliceClient.CreatePipeline(
+		pipeline,
+		"", // default image: DefaultUserImage
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("if [ -f /pfs/%s/slow ]; then sleep 600; fi", repo),
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", repo),
+		},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/"),
+		"", // default output branch: master
+		false,
+	))
+	require.NoError(t, aliceClient.ProtectBranch(pipeline, "master", &pfs.BranchProtection{NoForce: true}))
+
+	// the pipeline can still finish its output commits
+	require.NoError(t, aliceClient.PutFile(commit, "/file", strings.NewReader("1")))
+	commitInfo, err := aliceClient.InspectCommit(repo, "master", "")
+	require.NoError(t, err)
+	_, err = aliceClient.WaitCommitSetAll(commitInfo.Commit.ID)
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	require.NoError(t, aliceClient.GetFile(client.NewCommit(pipeline, "master", ""), "/file", buf))
+	require.Equal(t, "1", buf.String())
+
+	// alice can't force-finish the output commit of a running job
+	require.NoError(t, aliceClient.PutFile(commit, "/slow", strings.NewReader("2")))
+	commitInfo, err = aliceClient.InspectCommit(repo, "master", "")
+	require.NoError(t, err)
+	jobID := commitInfo.Commit.ID
+	_, err = aliceClient.PfsAPIClient.FinishCommit(aliceClient.Ctx(), &pfs.FinishCommitRequest{
+		Commit: client.NewCommit(pipeline, "master", jobID),
+		Force:  true,
+	})
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsBranchProtectedErr(err), err.Error())
+
+	// but she can stop the job
+	require.NoError(t, aliceClient.StopJob(pipeline, jobID))
+	require.NoErrorWithinTRetry(t, 30*time.Second, func() error {
+		ji, err := aliceClient.InspectJob(pipeline, jobID, false)
+		if err != nil {
+			return errors.Wrapf(err, "could not inspect job %q", jobID)
+		}
+		if ji.State != pps.JobState_JOB_KILLED {
+			return errors.Errorf("expected job %q to be in JOB_KILLED but was in %s", jobID, ji.State.String())
+		}
+		return nil
+	})
+}
+
+// TestCherryPickAndRevertCommit tests that the changes made by a commit can
+// only be cherry-picked or reverted onto a branch of another repo by a
+// principal who can read the commit's repo.
+func TestCherryPickAndRevertCommit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+
+	aliceRepo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(aliceRepo))
+	commit, err := aliceClient.StartCommit(aliceRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, aliceClient.PutFile(commit, "/file", strings.NewReader("secret")))
+	require.NoError(t, aliceClient.FinishCommit(aliceRepo, commit.Branch.Name, commit.ID))
+	bobRepo := tu.UniqueString(t.Name())
+	require.NoError(t, bobClient.CreateRepo(bobRepo))
+	bobBranch := client.NewBranch(bobRepo, "master")
+
+	// bob can't read alice's repo, so he can't copy its data into his own
+	_, err = bobClient.CherryPickCommit(commit, bobBranch, "")
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	_, err = bobClient.RevertCommit(commit, bobBranch, "")
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	_, err = bobClient.InspectCommit(bobRepo, "master", "")
+	require.YesError(t, err)
+
+	// once bob is a reader of alice's repo, he can
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(aliceRepo, bob, []string{auth.RepoReaderRole}))
+	_, err = bobClient.CherryPickCommit(commit, bobBranch, "")
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	require.NoError(t, bobClient.GetFile(bobBranch.NewCommit(""), "/file", buf))
+	require.Equal(t, "secret", buf.String())
+
+	// but he can't write the changes to alice's repo
+	_, err = bobClient.RevertCommit(commit, nil, "")
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+}
+
 // TestCreateRepoWithUpdateFlag tests that if CreateRepo(foo, update=true) is
 // called, and foo doesn't exist, then the ACL for foo will still be created and
 // initialized to the correct value
@@ -1149,6 +1375,7 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_ADD_PIPELINE_WRITER,
 			auth.Permission_REPO_INSPECT_FILE,
 			auth.Permission_PIPELINE_LIST_JOB,
+			auth.Permission_REPO_MODIFY_BRANCH_PROTECTION,
 		},
 		repoWriter: []auth.Permission{
 			auth.Permission_REPO_READ,
@@ -1352,6 +1579,46 @@ func TestCreateRepoAlreadyExistsError(t *testing.T) {
 	require.Matches(t, "already exists", err.Error())
 }
 
+// TestRenameRepo checks that only users who can delete a repo can rename it,
+// and that its role bindings move to the new name
+func TestRenameRepo(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(repo, bob, []string{auth.RepoWriterRole}))
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(repo, robot("carol"), []string{auth.RepoReaderRole}))
+
+	// bob can write to the repo, but can't rename it
+	renamed := repo + "_renamed"
+	err := bobClient.RenameRepo(repo, renamed)
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	require.NoError(t, aliceClient.RenameRepo(repo, renamed))
+	require.Equal(t,
+		buildBindings(alice, auth.RepoOwnerRole, bob, auth.RepoWriterRole, robot("carol"), auth.RepoReaderRole),
+		getRepoRoleBinding(t, aliceClient, renamed))
+	_, err = aliceClient.GetRepoRoleBinding(repo)
+	require.YesError(t, err)
+
+	// the moved bindings are enforced under the new name, and a new repo
+	// with the old name doesn't inherit them
+	require.NoError(t, bobClient.PutFile(client.NewCommit(renamed, "master", ""), "file", strings.NewReader("foo")))
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	require.Equal(t,
+		buildBindings(alice, auth.RepoOwnerRole), getRepoRoleBinding(t, aliceClient, repo))
+	err = bobClient.PutFile(client.NewCommit(repo, "master", ""), "file", strings.NewReader("foo"))
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+}
+
 // TestCreateRepoNotLoggedInError makes sure that if a user isn't logged in, and
 // they call CreateRepo(), they get an error.
 func TestCreateRepoNotLoggedInError(t *testing.T) {
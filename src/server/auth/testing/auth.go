@@ -69,6 +69,11 @@ func (a *InactiveAPIServer) DeleteRoleBindingInTransaction(*txncontext.Transacti
 	return auth.ErrNotActivated
 }
 
+// RenameRoleBindingInTransaction implements the RenameRoleBindingInTransaction internal API, but just returns NotActivatedError
+func (a *InactiveAPIServer) RenameRoleBindingInTransaction(*txncontext.TransactionContext, *auth.Resource, string) error {
+	return auth.ErrNotActivated
+}
+
 // Authenticate implements the Authenticate RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) Authenticate(context.Context, *auth.AuthenticateRequest) (*auth.AuthenticateResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -703,6 +703,54 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(squashDocs, "squash"))
 
+	revertDocs := &cobra.Command{
+		Short: "Undo the changes made by an existing Pachyderm resource.",
+		Long:  "Undo the changes made by an existing Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(revertDocs, "revert"))
+
+	mergeDocs := &cobra.Command{
+		Short: "Merge an existing Pachyderm resource into another.",
+		Long:  "Merge an existing Pachyderm resource into another.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(mergeDocs, "merge"))
+
+	cherryPickDocs := &cobra.Command{
+		Short: "Apply the changes made by an existing Pachyderm resource.",
+		Long:  "Apply the changes made by an existing Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(cherryPickDocs, "cherry-pick"))
+
+	renameDocs := &cobra.Command{
+		Short: "Rename an existing Pachyderm resource.",
+		Long:  "Rename an existing Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(renameDocs, "rename"))
+
+	forkDocs := &cobra.Command{
+		Short: "Fork an existing Pachyderm resource.",
+		Long:  "Fork an existing Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(forkDocs, "fork"))
+
+	rotateDocs := &cobra.Command{
+		Short: "Rotate the keys of a Pachyderm resource.",
+		Long:  "Rotate the keys of a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(rotateDocs, "rotate"))
+
+	exportDocs := &cobra.Command{
+		Short: "Export a Pachyderm resource.",
+		Long:  "Export a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(exportDocs, "export"))
+
+	importDocs := &cobra.Command{
+		Short: "Import a Pachyderm resource.",
+		Long:  "Import a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(importDocs, "import"))
+
 	createDocs := &cobra.Command{
 		Short: "Create a new instance of a Pachyderm resource.",
 		Long:  "Create a new instance of a Pachyderm resource.",
@@ -858,19 +906,26 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"tag":
 			// These are ignored - they will show up in the help topics section
 		case
+			"cherry-pick",
 			"copy",
 			"create",
 			"delete",
 			"diff",
 			"edit",
+			"export",
 			"finish",
+			"fork",
 			"wait",
 			"get",
 			"glob",
+			"import",
 			"inspect",
 			"list",
+			"merge",
 			"put",
+			"rename",
 			"restart",
+			"revert",
 			"squash",
 			"start",
 			"stop",
@@ -883,6 +938,7 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"extract",
 			"restore",
 			"garbage-collect",
+			"rotate",
 			"auth",
 			"enterprise",
 			"idp":
@@ -592,547 +592,236 @@ func TestMultipleInputsFromTheSameRepoDifferentBranches(t *testing.T) {
 }
 
 func TestRunPipeline(t *testing.T) {
-	// TODO(2.0 optional): Run pipeline creates a dangling commit, but uses the stats branch for stats commits.
-	// Since there is no relationship between the commits created by run pipeline, there should be no
-	// relationship between the stats commits. So, the stats commits should also be dangling commits.
-	// This might be easier to address when global IDs are implemented.
-	t.Skip("Run pipeline does not work correctly with stats enabled")
-	//if testing.Short() {
-	//	t.Skip("Skipping integration tests in short mode")
-	//}
-
-	//c := tu.GetPachClient(t)
-	//require.NoError(t, c.DeleteAll())
-
-	//// Test on cross pipeline
-	//t.Run("RunPipelineCross", func(t *testing.T) {
-	//	dataRepo := tu.UniqueString("TestRunPipeline_data")
-	//	require.NoError(t, c.CreateRepo(dataRepo))
-
-	//	branchA := "branchA"
-	//	branchB := "branchB"
-
-	//	pipeline := tu.UniqueString("pipeline")
-	//	require.NoError(t, c.CreatePipeline(
-	//		pipeline,
-	//		"",
-	//		[]string{"bash"},
-	//		[]string{
-	//			"cat /pfs/branch-a/file >> /pfs/out/file",
-	//			"cat /pfs/branch-b/file >> /pfs/out/file",
-	//			"echo ran-pipeline",
-	//		},
-	//		nil,
-	//		client.NewCrossInput(
-	//			client.NewPFSInputOpts("branch-a", dataRepo, branchA, "/*", "", "", false, false, nil),
-	//			client.NewPFSInputOpts("branch-b", dataRepo, branchB, "/*", "", "", false, false, nil),
-	//		),
-	//		"",
-	//		false,
-	//	))
-
-	//	commitA, err := c.StartCommit(dataRepo, branchA)
-	//	require.NoError(t, err)
-	//	require.NoError(t, c.PutFile(dataRepo, commitA.Branch.Name, commitA.ID, "/file", strings.NewReader("data A\n"), client.WithAppendPutFile()))
-	//	require.NoError(t, c.FinishCommit(dataRepo, commitA.Branch.Name, commitA.ID))
-
-	//	commitB, err := c.StartCommit(dataRepo, branchB)
-	//	require.NoError(t, err)
-	//	require.NoError(t, c.PutFile(dataRepo, commitB.Branch.Name, commitB.ID, "/file", strings.NewReader("data B\n"), client.WithAppendPutFile()))
-	//	require.NoError(t, c.FinishCommit(dataRepo, commitB.Branch.Name, commitB.ID))
-
-	//	iter, err := c.FlushJob([]*pfs.Commit{commitA, commitB}, nil)
-	//	require.NoError(t, err)
-	//	require.Equal(t, 2, len(commits))
-	//	buffer := bytes.Buffer{}
-	//	require.NoError(t, c.GetFile(commits[0].Commit.Repo.Name, commits[0].Commit.Branch.Name, commits[0].Commit.ID, "file", &buffer))
-	//	require.Equal(t, "data A\ndata B\n", buffer.String())
-
-	//	commitM, err := c.StartCommit(dataRepo, "master")
-	//	require.NoError(t, err)
-	//	require.NoError(t, c.FinishCommit(dataRepo, commitM.Branch.Name, commitM.ID))
-
-	//	// we should have two jobs
-	//	ji, err := c.ListJob(pipeline, nil, nil, -1, true)
-	//	require.NoError(t, err)
-	//	require.Equal(t, 2, len(ji))
-	//	// now run the pipeline
-	//	require.NoError(t, c.RunPipeline(pipeline, nil, ""))
-	//	// running the pipeline should create a new job
-	//	require.NoError(t, backoff.Retry(func() error {
-	//		jobInfos, err := c.ListJob(pipeline, nil, nil, -1, true)
-	//		require.NoError(t, err)
-	//		if len(jobInfos) != 3 {
-	//			return errors.Errorf("expected 3 jobs, got %d", len(jobInfos))
-	//		}
-	//		return nil
-	//	}, backoff.NewTestingBackOff()))
-
-	//	// now run the pipeline with non-empty provenance
-	//	require.NoError(t, backoff.Retry(func() error {
-	//		return c.RunPipeline(pipeline, []*pfs.Commit{
-	//			client.NewCommit(dataRepo, "branchA", commitA.ID),
-	//		}, "")
-	//	}, backoff.NewTestingBackOff()))
-
-	//	// running the pipeline should create a new job
-	//	require.NoError(t, backoff.Retry(func() error {
-	//		jobInfos, err := c.ListJob(pipeline, nil, nil, -1, true)
-	//		require.NoError(t, err)
-	//		if len(jobInfos) != 4 {
-	//			return errors.Errorf("expected 4 jobs, got %d", len(jobInfos))
-	//		}
-	//		return nil
-	//	}, backoff.NewTestingBackOff()))
-
-	//	// add some new commits with some new info
-	//	commitA2, err := c.StartCommit(dataRepo, branchA)
-	//	require.NoError(t, err)
-	//	require.NoError(t, c.PutFile(dataRepo, commitA2.Branch.Name, commitA2.ID, "/file", strings.NewReader("data A2\n"), client.WithAppendPutFile()))
-	//	require.NoError(t, c.FinishCommit(dataRepo, commitA2.Branch.Name, commitA2.ID))
-
-	//	commitB2, err := c.StartCommit(dataRepo, branchB)
-	//	require.NoError(t, err)
-	//	require.NoError(t, c.PutFile(dataRepo, commitB2.Branch.Name, commitB2.ID, "/file", strings.NewReader("data B2\n"), client.WithAppendPutFile()))
-	//	require.NoError(t, c.FinishCommit(dataRepo, commitB2.Branch.Name, commitB2.ID))
-
-	//	// and make sure the output file is updated appropriately
-	//	iter, err = c.FlushJob([]*pfs.Commit{commitA2, commitB2}, nil)
-	//	require.NoError(t, err)
-	//	require.Equal(t, 2, len(commits))
-	//	buffer = bytes.Buffer{}
-	//	require.NoError(t, c.GetFile(commits[0].Commit.Repo.Name, commits[0].Commit.Branch.Name, commits[0].Commit.ID, "file", &buffer))
-	//	require.Equal(t, "data A\ndata A2\ndata B\ndata B2\n", buffer.String())
-
-	//	// now run the pipeline provenant on the old commits
-	//	require.NoError(t, c.RunPipeline(pipeline, []*pfs.Commit{
-	//		client.NewCommit(dataRepo, "branchA", commitA.ID),
-	//		client.NewCommit(dataRepo, "branchB", commitB2.ID),
-	//	}, ""))
-
-	//	// and ensure that the file now has the info from the correct versions of the commits
-	//	iter, err = c.FlushJob([]*pfs.Commit{commitA, commitB2}, nil)
-	//	require.NoError(t, err)
-	//	require.Equal(t, 2, len(commits))
-	//	buffer = bytes.Buffer{}
-	//	require.NoError(t, c.GetFile(commits[0].Commit.Repo.Name, commits[0].Commit.Branch.Name, commits[0].Commit.ID, "file", &buffer))
-	//	require.Equal(t, "data A\ndata B\ndata B2\n", buffer.String())
-
-	//	// make sure no commits with this provenance combination exist
-	//	iter, err = c.FlushJob([]*pfs.Commit{commitA2, commitB}, nil)
-	//	require.NoError(t, err)
-	//	require.Equal(t, 0, len(commits))
-	//})
-
-	//// Test on pipeline with no commits
-	//t.Run("RunPipelineEmpty", func(t *testing.T) {
-	//	dataRepo := tu.UniqueString("TestRunPipeline_data")
-	//	require.NoError(t, c.CreateRepo(dataRepo))
-
-	//	pipeline := tu.UniqueString("empty-pipeline")
-	//	require.NoError(t, c.CreatePipeline(
-	//		pipeline,
-	//		"",
-	//		nil,
-	//		nil,
-	//		nil,
-	//		nil,
-	//		"",
-	//		false,
-	//	))
-
-	//	// we should have two jobs
-	//	ji, err := c.ListJob(pipeline, nil, nil, -1, true)
-	//	require.NoError(t, err)
-	//	require.Equal(t, 0, len(ji))
-	//	// now run the pipeline
-	//	require.YesError(t, c.RunPipeline(pipeline, nil, ""))
-	//})
-
-	//// Test on unrelated branch
-	//t.Run("RunPipelineUnrelated", func(t *testing.T) {
-	//	dataRepo := tu.UniqueString("TestRunPipeline_data")
-	//	require.NoError(t, c.CreateRepo(dataRepo))
-
-	//	branchA := "branchA"
-	//	branchB := "branchB"
-
-	//	pipeline := tu.UniqueString("unrelated-pipeline")
-	//	require.NoError(t, c.CreatePipeline(
-	//		pipeline,
-	//		"",
-	//		[]string{"bash"},
-	//		[]string{
-	//			"cat /pfs/branch-a/file >> /pfs/out/file",
-	//			"cat /pfs/branch-b/file >> /pfs/out/file",
-	//			"echo ran-pipeline",
-	//		},
-	//		nil,
-	//		client.NewCrossInput(
-	//			client.NewPFSInputOpts("branch-a", dataRepo, branchA, "/*", "", "", false, false, nil),
-	//			client.NewPFSInputOpts("branch-b", dataRepo, branchB, "/*", "", "", false, false, nil),
-	//		),
-	//		"",
-	//		false,
-	//	))
-	//	commitA, err := c.StartCommit(dataRepo, branchA)
-	//	require.NoError(t, err)
-	//	c.PutFile(dataRepo, commitA.Branch.Name, commitA.ID, "/file", strings.NewReader("data A\n", client.WithAppendPutFile()))
-	//	c.FinishCommit(dataRepo, commitA.Branch.Name, commitA.ID)
-
-	//	commitM, err := c.StartCommit(dataRepo, "master")
-	//	require.NoError(t, err)
-	//	err = c.FinishCommit(dataRepo, commitM.Branch.Name, commitM.ID)
-	//	require.NoError(t, err)
-
-	//	require.NoError(t, c.CreateBranch(dataRepo, "unrelated", "", nil))
-	//	commitU, err := c.StartCommit(dataRepo, "unrelated")
-	//	require.NoError(t, err)
-	//	err = c.FinishCommit(dataRepo, commitU.Branch.Name, commitU.ID)
-	//	require.NoError(t, err)
-
-	//	_, err = c.FlushJob([]*pfs.Commit{commitA, commitM, commitU}, nil)
-	//	require.NoError(t, err)
-
-	//	// now run the pipeline with unrelated provenance
-	//	require.YesError(t, c.RunPipeline(pipeline, []*pfs.Commit{
-	//		client.NewCommit(dataRepo, "unrelated", commitU.ID)}, ""))
-	//})
-
-	//// Test with downstream pipeline
-	//t.Run("RunPipelineDownstream", func(t *testing.T) {
-	//	dataRepo := tu.UniqueString("TestRunPipeline_data")
-	//	require.NoError(t, c.CreateRepo(dataRepo))
-
-	//	branchA := "branchA"
-	//	branchB := "branchB"
-
-	//	pipeline := tu.UniqueString("original-pipeline")
-	//	require.NoError(t, c.CreatePipeline(
-	//		pipeline,
-	//		"",
-	//		[]string{"bash"},
-	//		[]string{
-	//			"cat /pfs/branch-a/file >> /pfs/out/file",
-	//			"cat /pfs/branch-b/file >> /pfs/out/file",
-	//			"echo ran-pipeline",
-	//		},
-	//		nil,
-	//		client.NewCrossInput(
-	//			client.NewPFSInputOpts("branch-a", dataRepo, branchA, "/*", "", "", false, false, nil),
-	//			client.NewPFSInputOpts("branch-b", dataRepo, branchB, "/*", "", "", false, false, nil),
-	//		),
-	//		"",
-	//		false,
-	//	))
-
-	//	commitA, err := c.StartCommit(dataRepo, branchA)
-	//	require.NoError(t, err)
-	//	c.PutFile(dataRepo, commitA.Branch.Name, commitA.ID, "/file", strings.NewReader("data A\n", client.WithAppendPutFile()))
-	//	c.FinishCommit(dataRepo, commitA.Branch.Name, commitA.ID)
-
-	//	commitB, err := c.StartCommit(dataRepo, branchB)
-	//	require.NoError(t, err)
-	//	c.PutFile(dataRepo, commitB.Branch.Name, commitB.ID, "/file", strings.NewReader("data B\n", client.WithAppendPutFile()))
-	//	c.FinishCommit(dataRepo, commitB.Branch.Name, commitB.ID)
-
-	//	iter, err := c.FlushJob([]*pfs.Commit{commitA, commitB}, nil)
-	//	require.NoError(t, err)
-	//	require.Equal(t, 2, len(commits))
-	//	buffer := bytes.Buffer{}
-	//	require.NoError(t, c.GetFile(commits[0].Commit.Repo.Name, commits[0].Commit.Branch.Name, commits[0].Commit.ID, "file", &buffer))
-	//	require.Equal(t, "data A\ndata B\n", buffer.String())
-
-	//	// and make sure we can attatch a downstream pipeline
-	//	downstreamPipeline := tu.UniqueString("downstream-pipeline")
-	//	require.NoError(t, c.CreatePipeline(
-	//		downstreamPipeline,
-	//		"",
-	//		[]string{"/bin/bash"},
-	//		[]string{"cp " + fmt.Sprintf("/pfs/%s/*", pipeline) + " /pfs/out/"},
-	//		nil,
-	//		client.NewPFSInput(pipeline, "/*"),
-	//		"",
-	//		false,
-	//	))
-
-	//	commitA2, err := c.StartCommit(dataRepo, branchA)
-	//	require.NoError(t, err)
-	//	err = c.FinishCommit(dataRepo, commitA2.Branch.Name, commitA2.ID)
-	//	require.NoError(t, err)
-
-	//	// there should be one job on the old commit for downstreamPipeline
-	//	jobInfos, err := c.FlushJobAll([]*pfs.Commit{commitA}, []string{downstreamPipeline})
-	//	require.NoError(t, err)
-	//	require.Equal(t, 1, len(jobInfos))
-
-	//	// now run the pipeline
-	//	require.NoError(t, backoff.Retry(func() error {
-	//		return c.RunPipeline(pipeline, []*pfs.Commit{
-	//			client.NewCommit(dataRepo, branchA, commitA.ID),
-	//		}, "")
-	//	}, backoff.NewTestingBackOff()))
-
-	//	// the downstream pipeline shouldn't have any new jobs, since runpipeline jobs don't propagate
-	//	jobInfos, err = c.FlushJobAll([]*pfs.Commit{commitA}, []string{downstreamPipeline})
-	//	require.NoError(t, err)
-	//	require.Equal(t, 1, len(jobInfos))
-
-	//	// now rerun the one job that we saw
-	//	require.NoError(t, backoff.Retry(func() error {
-	//		return c.RunPipeline(downstreamPipeline, nil, jobInfos[0].Job.ID)
-	//	}, backoff.NewTestingBackOff()))
-
-	//	// we should now have two jobs
-	//	jobInfos, err = c.FlushJobAll([]*pfs.Commit{commitA}, []string{downstreamPipeline})
-	//	require.NoError(t, err)
-	//	require.Equal(t, 2, len(jobInfos))
-	//})
-
-	//// Test with a downstream pipeline who's upstream has no datum, but where the downstream still needs to succeed
-	//t.Run("RunPipelineEmptyUpstream", func(t *testing.T) {
-	//	dataRepo := tu.UniqueString("TestRunPipeline_data")
-	//	require.NoError(t, c.CreateRepo(dataRepo))
-
-	//	branchA := "branchA"
-	//	branchB := "branchB"
-
-	//	pipeline := tu.UniqueString("pipeline-downstream")
-	//	require.NoError(t, c.CreatePipeline(
-	//		pipeline,
-	//		"",
-	//		[]string{"bash"},
-	//		[]string{
-	//			"cat /pfs/branch-a/file >> /pfs/out/file",
-	//			"cat /pfs/branch-b/file >> /pfs/out/file",
-	//			"echo ran-pipeline",
-	//		},
-	//		nil,
-	//		client.NewCrossInput(
-	//			client.NewPFSInputOpts("branch-a", dataRepo, branchA, "/*", "", "", false, false, nil),
-	//			client.NewPFSInputOpts("branch-b", dataRepo, branchB, "/*", "", "", false, false, nil),
-	//		),
-	//		"",
-	//		false,
-	//	))
-
-	//	commitA, err := c.StartCommit(dataRepo, branchA)
-	//	require.NoError(t, err)
-	//	c.PutFile(dataRepo, commitA.Branch.Name, commitA.ID, "/file", strings.NewReader("data A\n", client.WithAppendPutFile()))
-	//	c.FinishCommit(dataRepo, commitA.Branch.Name, commitA.ID)
-
-	//	iter, err := c.FlushJob([]*pfs.Commit{commitA}, nil)
-	//	require.NoError(t, err)
-	//	require.Equal(t, 2, len(commits))
-
-	//	// no commit to branch-b so "file" should not exist
-	//	buffer := bytes.Buffer{}
-	//	require.YesError(t, c.GetFile(commits[0].Commit.Repo.Name, commits[0].Commit.Branch.Name, commits[0].Commit.ID, "file", &buffer))
-
-	//	// and make sure we can attatch a downstream pipeline
-	//	downstreamPipeline := tu.UniqueString("pipelinedownstream")
-	//	require.NoError(t, c.CreatePipeline(
-	//		downstreamPipeline,
-	//		"",
-	//		[]string{"/bin/bash"},
-	//		[]string{
-	//			"cat /pfs/branch-a/file >> /pfs/out/file",
-	//			fmt.Sprintf("cat /pfs/%s/file >> /pfs/out/file", pipeline),
-	//			"echo ran-pipeline",
-	//		},
-	//		nil,
-	//		client.NewUnionInput(
-	//			client.NewPFSInputOpts("branch-a", dataRepo, branchA, "/*", "", "", false, false, nil),
-	//			client.NewPFSInput(pipeline, "/*"),
-	//		),
-	//		"",
-	//		false,
-	//	))
-
-	//	commitA2, err := c.StartCommit(dataRepo, branchA)
-	//	require.NoError(t, err)
-	//	err = c.FinishCommit(dataRepo, commitA2.Branch.Name, commitA2.ID)
-	//	require.NoError(t, err)
-
-	//	// there should be one job on the old commit for downstreamPipeline
-	//	jobInfos, err := c.FlushJobAll([]*pfs.Commit{commitA}, []string{downstreamPipeline})
-	//	require.NoError(t, err)
-	//	require.Equal(t, 1, len(jobInfos))
-
-	//	// now run the pipeline
-	//	require.NoError(t, backoff.Retry(func() error {
-	//		return c.RunPipeline(pipeline, []*pfs.Commit{
-	//			client.NewCommit(dataRepo, branchA, commitA.ID),
-	//		}, "")
-	//	}, backoff.NewTestingBackOff()))
-
-	//	buffer2 := bytes.Buffer{}
-	//	require.NoError(t, c.GetFile(jobInfos[0].OutputCommit.Repo.Name, jobInfos[0].OutputCommit.Branch.Name, jobInfos[0].OutputCommit.ID, "file", &buffer2))
-	//	// the union of an empty output and datA should only return a file with "data A" in it.
-	//	require.Equal(t, "data A\n", buffer2.String())
-
-	//	// add another commit to see that we can successfully do the cross and union together
-	//	commitB, err := c.StartCommit(dataRepo, branchB)
-	//	require.NoError(t, err)
-	//	c.PutFile(dataRepo, commitB.Branch.Name, commitB.ID, "/file", strings.NewReader("data B\n", client.WithAppendPutFile()))
-	//	c.FinishCommit(dataRepo, commitB.Branch.Name, commitB.ID)
-
-	//	_, err = c.FlushJob([]*pfs.Commit{commitA, commitB}, nil)
-	//	require.NoError(t, err)
-
-	//	jobInfos, err = c.FlushJobAll([]*pfs.Commit{commitB}, []string{downstreamPipeline})
-	//	require.NoError(t, err)
-	//	require.Equal(t, 1, len(jobInfos))
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
 
-	//	buffer3 := bytes.Buffer{}
-	//	require.NoError(t, c.GetFile(jobInfos[0].OutputCommit.Repo.Name, jobInfos[0].OutputCommit.Branch.Name, jobInfos[0].OutputCommit.ID, "file", &buffer3))
-	//	// now that we've added data to the other branch of the cross, we should see the union of data A along with the the crossed data.
-	//	require.Equal(t, "data A\ndata A\ndata B\n", buffer3.String())
-	//})
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
 
-	//// Test on commits from the same branch
-	//t.Run("RunPipelineSameBranch", func(t *testing.T) {
-	//	dataRepo := tu.UniqueString("TestRunPipeline_data")
-	//	require.NoError(t, c.CreateRepo(dataRepo))
+	// newJob waits for a job of 'pipeline' which isn't in 'prevJobs' to appear,
+	// waits for it to finish, and returns it
+	newJob := func(t *testing.T, pipeline string, prevJobs []*pps.JobInfo) *pps.JobInfo {
+		var jobInfo *pps.JobInfo
+		require.NoErrorWithinTRetry(t, 60*time.Second, func() error {
+			jobInfos, err := c.ListJob(pipeline, nil, -1, false)
+			if err != nil {
+				return err
+			}
+		Jobs:
+			for _, ji := range jobInfos {
+				for _, prev := range prevJobs {
+					if ji.Job.ID == prev.Job.ID {
+						continue Jobs
+					}
+				}
+				jobInfo = ji
+				return nil
+			}
+			return errors.Errorf("no new job for pipeline %s", pipeline)
+		})
+		jobInfo, err := c.WaitJob(pipeline, jobInfo.Job.ID, false)
+		require.NoError(t, err)
+		return jobInfo
+	}
 
-	//	branchA := "branchA"
-	//	branchB := "branchB"
+	makeCrossPipeline := func(t *testing.T, dataRepo, pipeline string) {
+		require.NoError(t, c.CreateRepo(dataRepo))
+		require.NoError(t, c.CreatePipeline(
+			pipeline,
+			"",
+			[]string{"bash"},
+			[]string{
+				"cat /pfs/branch-a/file >> /pfs/out/file",
+				"cat /pfs/branch-b/file >> /pfs/out/file",
+			},
+			nil,
+			client.NewCrossInput(
+				client.NewPFSInputOpts("branch-a", dataRepo, "branchA", "/*", "", "", false, false, nil),
+				client.NewPFSInputOpts("branch-b", dataRepo, "branchB", "/*", "", "", false, false, nil),
+			),
+			"",
+			false,
+		))
+	}
 
-	//	pipeline := tu.UniqueString("sameBranch-pipeline")
-	//	require.NoError(t, c.CreatePipeline(
-	//		pipeline,
-	//		"",
-	//		[]string{"bash"},
-	//		[]string{
-	//			"cat /pfs/branch-a/file >> /pfs/out/file",
-	//			"cat /pfs/branch-b/file >> /pfs/out/file",
-	//			"echo ran-pipeline",
-	//		},
-	//		nil,
-	//		client.NewCrossInput(
-	//			client.NewPFSInputOpts("branch-a", dataRepo, branchA, "/*", "", "", false, false, nil),
-	//			client.NewPFSInputOpts("branch-b", dataRepo, branchB, "/*", "", "", false, false, nil),
-	//		),
-	//		"",
-	//		false,
-	//	))
-	//	commitA1, err := c.StartCommit(dataRepo, branchA)
-	//	require.NoError(t, err)
-	//	c.PutFile(dataRepo, commitA1.Branch.Name, commitA1.ID, "/file", strings.NewReader("data A1\n", client.WithAppendPutFile()))
-	//	c.FinishCommit(dataRepo, commitA1.Branch.Name, commitA1.ID)
+	putCommit := func(t *testing.T, repo, branch, data string) *pfs.Commit {
+		commit, err := c.StartCommit(repo, branch)
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, "/file", strings.NewReader(data), client.WithAppendPutFile()))
+		require.NoError(t, c.FinishCommit(repo, commit.Branch.Name, commit.ID))
+		_, err = c.WaitCommitSetAll(commit.ID)
+		require.NoError(t, err)
+		return commit
+	}
 
-	//	commitA2, err := c.StartCommit(dataRepo, branchA)
-	//	require.NoError(t, err)
-	//	c.PutFile(dataRepo, commitA2.Branch.Name, commitA2.ID, "/file", strings.NewReader("data A2\n", client.WithAppendPutFile()))
-	//	c.FinishCommit(dataRepo, commitA2.Branch.Name, commitA2.ID)
+	t.Run("RunPipelineCross", func(t *testing.T) {
+		dataRepo := tu.UniqueString("TestRunPipeline_data")
+		pipeline := tu.UniqueString("pipeline")
+		makeCrossPipeline(t, dataRepo, pipeline)
 
-	//	_, err = c.FlushJob([]*pfs.Commit{commitA1, commitA2}, nil)
-	//	require.NoError(t, err)
+		commitA := putCommit(t, dataRepo, "branchA", "data A\n")
+		commitB := putCommit(t, dataRepo, "branchB", "data B\n")
+		buffer := bytes.Buffer{}
+		require.NoError(t, c.GetFile(client.NewCommit(pipeline, "master", commitB.ID), "file", &buffer))
+		require.Equal(t, "data A\ndata B\n", buffer.String())
 
-	//	// now run the pipeline with provenance from the same branch
-	//	require.YesError(t, c.RunPipeline(pipeline, []*pfs.Commit{
-	//		client.NewCommit(dataRepo, branchA, commitA1.ID),
-	//		client.NewCommit(dataRepo, branchA, commitA2.ID),
-	//	}, ""))
-	//})
-	//// Test on pipeline that should always fail
-	//t.Run("RerunPipeline", func(t *testing.T) {
-	//	dataRepo := tu.UniqueString("TestRerunPipeline_data")
-	//	require.NoError(t, c.CreateRepo(dataRepo))
+		// running the pipeline with no provenance should create a new job on the
+		// current heads
+		jobInfos, err := c.ListJob(pipeline, nil, -1, false)
+		require.NoError(t, err)
+		require.NoError(t, c.RunPipeline(pipeline, nil, ""))
+		jobInfo := newJob(t, pipeline, jobInfos)
+		require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+		buffer.Reset()
+		require.NoError(t, c.GetFile(jobInfo.OutputCommit, "file", &buffer))
+		require.Equal(t, "data A\ndata B\n", buffer.String())
+
+		// add some new commits with some new info
+		commitA2 := putCommit(t, dataRepo, "branchA", "data A2\n")
+		commitB2 := putCommit(t, dataRepo, "branchB", "data B2\n")
+		buffer.Reset()
+		require.NoError(t, c.GetFile(client.NewCommit(pipeline, "master", commitB2.ID), "file", &buffer))
+		require.Equal(t, "data A\ndata A2\ndata B\ndata B2\n", buffer.String())
+
+		// now run the pipeline provenant on an old commit
+		jobInfos, err = c.ListJob(pipeline, nil, -1, false)
+		require.NoError(t, err)
+		require.NoError(t, c.RunPipeline(pipeline, []*pfs.Commit{
+			client.NewCommit(dataRepo, "branchA", commitA.ID),
+		}, ""))
+		jobInfo = newJob(t, pipeline, jobInfos)
+		require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+		buffer.Reset()
+		require.NoError(t, c.GetFile(jobInfo.OutputCommit, "file", &buffer))
+		require.Equal(t, "data A\ndata B\ndata B2\n", buffer.String())
 
-	//	// jobs on this pipeline should always fail
-	//	pipeline := tu.UniqueString("rerun-pipeline")
-	//	require.NoError(t, c.CreatePipeline(
-	//		pipeline,
-	//		"",
-	//		[]string{"bash"},
-	//		[]string{"false"},
-	//		nil,
-	//		client.NewPFSInputOpts("branch-a", dataRepo, "branchA", "/*", "", "", false, false, nil),
-	//		"",
-	//		false,
-	//	))
+		// the input branch that was given an old commit should not have moved
+		commitInfo, err := c.InspectCommit(dataRepo, "branchA", "")
+		require.NoError(t, err)
+		require.Equal(t, commitA2.ID, commitInfo.Commit.ID)
 
-	//	commitA1, err := c.StartCommit(dataRepo, "branchA")
-	//	require.NoError(t, err)
-	//	require.NoError(t, c.PutFile(dataRepo, commitA1.Branch.Name, commitA1.ID, "/file", strings.NewReader("data A1\n"), client.WithAppendPutFile()))
-	//	require.NoError(t, c.FinishCommit(dataRepo, commitA1.Branch.Name, commitA1.ID))
+		// rerunning the job should reuse its inputs
+		jobInfos, err = c.ListJob(pipeline, nil, -1, false)
+		require.NoError(t, err)
+		require.NoError(t, c.RunPipeline(pipeline, nil, jobInfo.Job.ID))
+		rerunJobInfo := newJob(t, pipeline, jobInfos)
+		require.Equal(t, pps.JobState_JOB_SUCCESS, rerunJobInfo.State)
+		buffer.Reset()
+		require.NoError(t, c.GetFile(rerunJobInfo.OutputCommit, "file", &buffer))
+		require.Equal(t, "data A\ndata B\ndata B2\n", buffer.String())
+	})
 
-	//	iter, err := c.FlushJob([]*pfs.Commit{commitA1}, nil)
-	//	require.NoError(t, err)
-	//	require.Equal(t, 2, len(commits))
-	//	// now run the pipeline
-	//	require.NoError(t, c.RunPipeline(pipeline, nil, ""))
+	t.Run("RunPipelineEmpty", func(t *testing.T) {
+		require.YesError(t, c.RunPipeline(tu.UniqueString("no-such-pipeline"), nil, ""))
+	})
 
-	//	// running the pipeline should create a new job
-	//	require.NoError(t, backoff.Retry(func() error {
-	//		jobInfos, err := c.ListJob(pipeline, nil, nil, -1, true)
-	//		require.NoError(t, err)
-	//		if len(jobInfos) != 2 {
-	//			return errors.Errorf("expected 2 jobs, got %d", len(jobInfos))
-	//		}
+	t.Run("RunPipelineUnrelated", func(t *testing.T) {
+		dataRepo := tu.UniqueString("TestRunPipeline_data")
+		pipeline := tu.UniqueString("unrelated-pipeline")
+		makeCrossPipeline(t, dataRepo, pipeline)
+		putCommit(t, dataRepo, "branchA", "data A\n")
+		commitU := putCommit(t, dataRepo, "unrelated", "data U\n")
+
+		// now run the pipeline with unrelated provenance
+		require.YesError(t, c.RunPipeline(pipeline, []*pfs.Commit{
+			client.NewCommit(dataRepo, "unrelated", commitU.ID),
+		}, ""))
+	})
 
-	//		// but both of these jobs should fail
-	//		for i, job := range jobInfos {
-	//			if job.State.String() != "JOB_FAILURE" {
-	//				return errors.Errorf("expected job %v to fail, but got %v", i, job.State.String())
-	//			}
-	//		}
-	//		return nil
-	//	}, backoff.NewTestingBackOff()))
+	t.Run("RunPipelineSameBranch", func(t *testing.T) {
+		dataRepo := tu.UniqueString("TestRunPipeline_data")
+		pipeline := tu.UniqueString("sameBranch-pipeline")
+		makeCrossPipeline(t, dataRepo, pipeline)
+		commitA1 := putCommit(t, dataRepo, "branchA", "data A1\n")
+		commitA2 := putCommit(t, dataRepo, "branchA", "data A2\n")
+
+		// now run the pipeline with provenance from the same branch
+		require.YesError(t, c.RunPipeline(pipeline, []*pfs.Commit{
+			client.NewCommit(dataRepo, "branchA", commitA1.ID),
+			client.NewCommit(dataRepo, "branchA", commitA2.ID),
+		}, ""))
+	})
 
-	//	// Shouldn't error if you try to delete an already deleted pipeline
-	//	require.NoError(t, c.DeletePipeline(pipeline, false))
-	//	require.NoError(t, c.DeletePipeline(pipeline, false))
-	//})
-	//t.Run("RunPipelineStats", func(t *testing.T) {
-	//	dataRepo := tu.UniqueString("TestRunPipeline_data")
-	//	require.NoError(t, c.CreateRepo(dataRepo))
+	t.Run("RunPipelineDownstream", func(t *testing.T) {
+		dataRepo := tu.UniqueString("TestRunPipeline_data")
+		pipeline := tu.UniqueString("original-pipeline")
+		makeCrossPipeline(t, dataRepo, pipeline)
+		commitA := putCommit(t, dataRepo, "branchA", "data A\n")
+		putCommit(t, dataRepo, "branchB", "data B\n")
 
-	//	branchA := "branchA"
+		downstreamPipeline := tu.UniqueString("downstream-pipeline")
+		require.NoError(t, c.CreatePipeline(
+			downstreamPipeline,
+			"",
+			[]string{"bash"},
+			[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", pipeline)},
+			nil,
+			client.NewPFSInput(pipeline, "/*"),
+			"",
+			false,
+		))
+		putCommit(t, dataRepo, "branchA", "data A2\n")
 
-	//	pipeline := tu.UniqueString("stats-pipeline")
-	//	_, err := c.PpsAPIClient.CreatePipeline(
-	//		context.Background(),
-	//		&pps.CreatePipelineRequest{
-	//			Pipeline: client.NewPipeline(pipeline),
-	//			Transform: &pps.Transform{
-	//				Cmd: []string{"bash"},
-	//				Stdin: []string{
-	//					"cat /pfs/branch-a/file >> /pfs/out/file",
-
-	//					"echo ran-pipeline",
-	//				},
-	//			},
-	//			Input:       client.NewPFSInputOpts("branch-a", dataRepo, branchA, "/*", "", "", false, false, nil),
-	//		})
-	//	require.NoError(t, err)
+		// running the upstream pipeline should propagate to the downstream pipeline
+		jobInfos, err := c.ListJob(pipeline, nil, -1, false)
+		require.NoError(t, err)
+		require.NoError(t, c.RunPipeline(pipeline, []*pfs.Commit{
+			client.NewCommit(dataRepo, "branchA", commitA.ID),
+		}, ""))
+		jobInfo := newJob(t, pipeline, jobInfos)
+		downstreamJobInfo, err := c.WaitJob(downstreamPipeline, jobInfo.Job.ID, false)
+		require.NoError(t, err)
+		require.Equal(t, pps.JobState_JOB_SUCCESS, downstreamJobInfo.State)
+		buffer := bytes.Buffer{}
+		require.NoError(t, c.GetFile(downstreamJobInfo.OutputCommit, "file", &buffer))
+		require.Equal(t, "data A\ndata B\n", buffer.String())
 
-	//	commitA, err := c.StartCommit(dataRepo, branchA)
-	//	require.NoError(t, err)
-	//	c.PutFile(dataRepo, commitA.Branch.Name, commitA.ID, "/file", strings.NewReader("data A\n", client.WithAppendPutFile()))
-	//	c.FinishCommit(dataRepo, commitA.Branch.Name, commitA.ID)
+		// now rerun the downstream job
+		jobInfos, err = c.ListJob(downstreamPipeline, nil, -1, false)
+		require.NoError(t, err)
+		require.NoError(t, c.RunPipeline(downstreamPipeline, nil, downstreamJobInfo.Job.ID))
+		downstreamJobInfo = newJob(t, downstreamPipeline, jobInfos)
+		require.Equal(t, pps.JobState_JOB_SUCCESS, downstreamJobInfo.State)
+		buffer.Reset()
+		require.NoError(t, c.GetFile(downstreamJobInfo.OutputCommit, "file", &buffer))
+		require.Equal(t, "data A\ndata B\n", buffer.String())
+	})
 
-	//	// wait for the commit to finish before calling RunPipeline
-	//	_, err = c.WaitCommitSetAll([]*pfs.Commit{client.NewCommit(dataRepo, commitA.ID)}, nil)
-	//	require.NoError(t, err)
+	t.Run("RerunPipeline", func(t *testing.T) {
+		dataRepo := tu.UniqueString("TestRerunPipeline_data")
+		require.NoError(t, c.CreateRepo(dataRepo))
 
-	//	// now run the pipeline
-	//	require.NoError(t, backoff.Retry(func() error {
-	//		return c.RunPipeline(pipeline, []*pfs.Commit{
-	//			client.NewCommit(dataRepo, branchA, commitA.ID),
-	//		}, "")
-	//	}, backoff.NewTestingBackOff()))
+		// jobs on this pipeline should always fail
+		pipeline := tu.UniqueString("rerun-pipeline")
+		require.NoError(t, c.CreatePipeline(
+			pipeline,
+			"",
+			[]string{"bash"},
+			[]string{"false"},
+			nil,
+			client.NewPFSInputOpts("branch-a", dataRepo, "branchA", "/*", "", "", false, false, nil),
+			"",
+			false,
+		))
+		putCommit(t, dataRepo, "branchA", "data A1\n")
 
-	//	// make sure the pipeline didn't crash
-	//	commitInfos, err := c.WaitCommitSetAll([]*pfs.Commit{client.NewCommit(dataRepo, commitA.ID)}, nil)
-	//	require.NoError(t, err)
+		// running the pipeline should create a new job, which also fails
+		jobInfos, err := c.ListJob(pipeline, nil, -1, false)
+		require.NoError(t, err)
+		require.NoError(t, c.RunPipeline(pipeline, nil, ""))
+		jobInfo := newJob(t, pipeline, jobInfos)
+		require.Equal(t, pps.JobState_JOB_FAILURE, jobInfo.State)
+	})
 
-	//	// we'll know it crashed if this causes it to hang
-	//	require.NoErrorWithinTRetry(t, 80*time.Second, func() error {
-	//		return nil
-	//	})
-	//})
+	t.Run("RunStoppedPipeline", func(t *testing.T) {
+		dataRepo := tu.UniqueString("TestRunPipeline_data")
+		pipeline := tu.UniqueString("stopped-pipeline")
+		makeCrossPipeline(t, dataRepo, pipeline)
+		putCommit(t, dataRepo, "branchA", "data A\n")
+		require.NoError(t, c.StopPipeline(pipeline))
+		require.YesError(t, c.RunPipeline(pipeline, nil, ""))
+	})
 }
 
 func TestPipelineFailure(t *testing.T) {
@@ -3469,6 +3158,154 @@ func TestPipelineWithFullObjects(t *testing.T) {
 	require.Equal(t, "foo\nbar\n", buffer.String())
 }
 
+func TestPipelineInputAtTime(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestPipelineInputAtTime_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	modelRepo := tu.UniqueString("TestPipelineInputAtTime_model")
+	require.NoError(t, c.CreateRepo(modelRepo))
+
+	require.NoError(t, c.PutFile(client.NewCommit(modelRepo, "master", ""), "file", strings.NewReader("v1\n")))
+	modelInfo, err := c.InspectCommit(modelRepo, "master", "")
+	require.NoError(t, err)
+	finished, err := types.TimestampFromProto(modelInfo.Finished)
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(client.NewCommit(modelRepo, "master", ""), "file", strings.NewReader("v2\n")))
+
+	// The model input is pinned to the model as of its first commit.
+	modelInput := client.NewPFSInput(modelRepo, "/")
+	modelInput.Pfs.Commit = "@" + finished.Format(time.RFC3339Nano)
+	pipelineName := tu.UniqueString("pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipelineName,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/file /pfs/out/model", modelRepo)},
+		nil,
+		client.NewCrossInput(client.NewPFSInput(dataRepo, "/*"), modelInput),
+		"",
+		false,
+	))
+	pipelineInfo, err := c.InspectPipeline(pipelineName, true)
+	require.NoError(t, err)
+	require.Equal(t, modelInfo.Commit.ID, pipelineInfo.Details.Input.Cross[1].Pfs.PinnedCommit)
+
+	checkModel := func(commitSetID string) {
+		_, err := c.WaitCommitSetAll(commitSetID)
+		require.NoError(t, err)
+		var buffer bytes.Buffer
+		require.NoError(t, c.GetFile(client.NewCommit(pipelineName, "master", commitSetID), "model", &buffer))
+		require.Equal(t, "v1\n", buffer.String())
+	}
+	dataCommit := client.NewCommit(dataRepo, "master", "")
+	require.NoError(t, c.PutFile(dataCommit, "file", strings.NewReader("foo\n")))
+	dataInfo, err := c.InspectCommit(dataRepo, "master", "")
+	require.NoError(t, err)
+	checkModel(dataInfo.Commit.ID)
+	// New model commits don't change the model the pipeline reads.
+	require.NoError(t, c.PutFile(client.NewCommit(modelRepo, "master", ""), "file", strings.NewReader("v3\n")))
+	modelInfo, err = c.InspectCommit(modelRepo, "master", "")
+	require.NoError(t, err)
+	checkModel(modelInfo.Commit.ID)
+
+	// A commit ID in the spec doesn't pin the input, jobs read the commit in
+	// their commit set.
+	modelInput.Pfs.Commit = modelInfo.Commit.ID
+	require.NoError(t, c.CreatePipeline(
+		pipelineName,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/file /pfs/out/model", modelRepo)},
+		nil,
+		client.NewCrossInput(client.NewPFSInput(dataRepo, "/*"), modelInput),
+		"",
+		true,
+	))
+	pipelineInfo, err = c.InspectPipeline(pipelineName, true)
+	require.NoError(t, err)
+	require.Equal(t, "", pipelineInfo.Details.Input.Cross[1].Pfs.PinnedCommit)
+	require.NoError(t, c.PutFile(client.NewCommit(modelRepo, "master", ""), "file", strings.NewReader("v4\n")))
+	modelInfo, err = c.InspectCommit(modelRepo, "master", "")
+	require.NoError(t, err)
+	_, err = c.WaitCommitSetAll(modelInfo.Commit.ID)
+	require.NoError(t, err)
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(client.NewCommit(pipelineName, "master", modelInfo.Commit.ID), "model", &buffer))
+	require.Equal(t, "v4\n", buffer.String())
+}
+
+func TestRenamePipelineInputs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestRenamePipelineInputs_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	modelRepo := tu.UniqueString("TestRenamePipelineInputs_model")
+	require.NoError(t, c.CreateRepo(modelRepo))
+	require.NoError(t, c.PutFile(client.NewCommit(modelRepo, "master", ""), "file", strings.NewReader("model\n")))
+
+	// The model input reads from staging, which is triggered by master.
+	modelInput := client.NewPFSInput(modelRepo, "/")
+	modelInput.Pfs.Branch = "staging"
+	modelInput.Pfs.Trigger = &pfs.Trigger{Branch: "master", Commits: 1}
+	pipelineName := tu.UniqueString("pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipelineName,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/file /pfs/out/data", dataRepo)},
+		nil,
+		client.NewCrossInput(client.NewPFSInput(dataRepo, "/*"), modelInput),
+		"",
+		false,
+	))
+
+	renamedData := dataRepo + "_renamed"
+	require.NoError(t, c.RenameRepo(dataRepo, renamedData))
+	require.NoError(t, c.RenameBranch(modelRepo, "master", "main"))
+	pipelineInfo, err := c.InspectPipeline(pipelineName, true)
+	require.NoError(t, err)
+	require.Equal(t, renamedData, pipelineInfo.Details.Input.Cross[0].Pfs.Repo)
+	require.Equal(t, "master", pipelineInfo.Details.Input.Cross[0].Pfs.Branch)
+	require.Equal(t, modelRepo, pipelineInfo.Details.Input.Cross[1].Pfs.Repo)
+	require.Equal(t, "staging", pipelineInfo.Details.Input.Cross[1].Pfs.Branch)
+	require.Equal(t, "main", pipelineInfo.Details.Input.Cross[1].Pfs.Trigger.Branch)
+	// Renaming the inputs creates a new version of the pipeline.
+	require.Equal(t, uint64(3), pipelineInfo.Version)
+
+	// The pipeline keeps processing the renamed inputs.
+	require.NoError(t, c.PutFile(client.NewCommit(renamedData, "master", ""), "file", strings.NewReader("foo\n")))
+	dataInfo, err := c.InspectCommit(renamedData, "master", "")
+	require.NoError(t, err)
+	_, err = c.WaitCommitSetAll(dataInfo.Commit.ID)
+	require.NoError(t, err)
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(client.NewCommit(pipelineName, "master", dataInfo.Commit.ID), "data", &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+
+	// The input branch of a cron pipeline can't be renamed.
+	cronPipeline := tu.UniqueString("cron")
+	require.NoError(t, c.CreatePipeline(
+		cronPipeline,
+		"",
+		[]string{"true"},
+		nil,
+		nil,
+		client.NewCronInput("tick", "@every 1h"),
+		"",
+		false,
+	))
+	require.YesError(t, c.RenameBranch(cronPipeline+"_tick", "master", "main"))
+}
+
 func TestPipelineWithExistingInputCommits(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -8987,10 +8824,20 @@ func TestTrigger(t *testing.T) {
 	for i := 0; i < numFiles; i++ {
 		require.NoError(t, c.PutFile(dataCommit, fmt.Sprintf("file%d", i), strings.NewReader(strings.Repeat("a", fileBytes)), client.WithAppendPutFile()))
 	}
-	// This should have given us a job, flush to let it complete.
-	commitInfos, err := c.WaitCommitSetAll(dataCommit.ID)
-	require.NoError(t, err)
-	require.Equal(t, 2, len(commitInfos))
+	// This should have given us a job, flush to let it complete. Size
+	// triggers fire in the background, so the job may not exist yet.
+	var commitInfos []*pfs.CommitInfo
+	var err error
+	require.NoErrorWithinTRetry(t, time.Minute, func() error {
+		commitInfos, err = c.WaitCommitSetAll(dataCommit.ID)
+		if err != nil {
+			return err
+		}
+		if len(commitInfos) != 2 {
+			return errors.Errorf("expected 2 commits, got %d", len(commitInfos))
+		}
+		return nil
+	})
 	for i := 0; i < numFiles; i++ {
 		var buf bytes.Buffer
 		require.NoError(t, c.GetFile(pipelineCommit1, fmt.Sprintf("file%d", i), &buf))
@@ -9005,9 +8852,16 @@ func TestTrigger(t *testing.T) {
 	}
 	commitInfo, err := c.InspectCommit(dataRepo, "master", "")
 	require.NoError(t, err)
-	commitInfos, err = c.WaitCommitSetAll(commitInfo.Commit.ID)
-	require.NoError(t, err)
-	require.Equal(t, 4, len(commitInfos))
+	require.NoErrorWithinTRetry(t, time.Minute, func() error {
+		commitInfos, err = c.WaitCommitSetAll(commitInfo.Commit.ID)
+		if err != nil {
+			return err
+		}
+		if len(commitInfos) != 4 {
+			return errors.Errorf("expected 4 commits, got %d", len(commitInfos))
+		}
+		return nil
+	})
 	for i := 0; i < numFiles*2; i++ {
 		var buf bytes.Buffer
 		require.NoError(t, c.GetFile(pipelineCommit1, fmt.Sprintf("file%d", i), &buf))
@@ -9055,9 +8909,16 @@ func TestTrigger(t *testing.T) {
 		require.NoError(t, c.PutFile(dataCommit, fmt.Sprintf("file%d", i), strings.NewReader(strings.Repeat("a", fileBytes)), client.WithAppendPutFile()))
 	}
 
-	commitInfos, err = c.WaitCommitSetAll(dataCommit.ID)
-	require.NoError(t, err)
-	require.Equal(t, 4, len(commitInfos))
+	require.NoErrorWithinTRetry(t, time.Minute, func() error {
+		commitInfos, err = c.WaitCommitSetAll(dataCommit.ID)
+		if err != nil {
+			return err
+		}
+		if len(commitInfos) != 4 {
+			return errors.Errorf("expected 4 commits, got %d", len(commitInfos))
+		}
+		return nil
+	})
 
 	commitInfos, err = c.ListCommit(client.NewRepo(pipeline2), client.NewCommit(pipeline2, "master", ""), nil, 0)
 	require.NoError(t, err)
@@ -10,10 +10,12 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
 	prompt "github.com/c-bata/go-prompt"
+	units "github.com/docker/go-units"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/mattn/go-isatty"
@@ -64,11 +66,17 @@ or type (e.g. csv, binary, images, etc).`,
 	commands = append(commands, cmdutil.CreateDocsAlias(repoDocs, "repo", " repo$"))
 
 	var description string
+	var metadataPairs []string
+	var removeMetadata []string
 	createRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Create a new repo.",
 		Long:  "Create a new repo.",
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			metadata, err := cmdutil.ParseKeyValues(metadataPairs)
+			if err != nil {
+				return err
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
@@ -81,6 +89,7 @@ or type (e.g. csv, binary, images, etc).`,
 					&pfs.CreateRepoRequest{
 						Repo:        client.NewRepo(args[0]),
 						Description: description,
+						Metadata:    metadata,
 					},
 				)
 				return err
@@ -89,6 +98,7 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	createRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	createRepo.Flags().StringArrayVar(&metadataPairs, "metadata", nil, "A key=value pair of metadata for the repo; may be repeated.")
 	commands = append(commands, cmdutil.CreateAlias(createRepo, "create repo"))
 
 	updateRepo := &cobra.Command{
@@ -96,6 +106,10 @@ or type (e.g. csv, binary, images, etc).`,
 		Short: "Update a repo.",
 		Long:  "Update a repo.",
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			metadata, err := cmdutil.ParseKeyValues(metadataPairs)
+			if err != nil {
+				return err
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
@@ -109,14 +123,23 @@ or type (e.g. csv, binary, images, etc).`,
 						Repo:        cmdutil.ParseRepo(args[0]),
 						Description: description,
 						Update:      true,
+						Metadata:    metadata,
 					},
 				)
 				return err
 			})
+			if err == nil && len(removeMetadata) > 0 {
+				_, err = c.PfsAPIClient.SetMetadata(c.Ctx(), &pfs.SetMetadataRequest{
+					Repo:       cmdutil.ParseRepo(args[0]),
+					RemoveKeys: removeMetadata,
+				})
+			}
 			return grpcutil.ScrubGRPC(err)
 		}),
 	}
 	updateRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	updateRepo.Flags().StringArrayVar(&metadataPairs, "metadata", nil, "A key=value pair of metadata to set on the repo; may be repeated.")
+	updateRepo.Flags().StringArrayVar(&removeMetadata, "remove-metadata", nil, "A metadata key to remove from the repo; may be repeated.")
 	shell.RegisterCompletionFunc(updateRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(updateRepo, "update repo"))
 
@@ -172,10 +195,18 @@ or type (e.g. csv, binary, images, etc).`,
 			if repoType == "" && !all {
 				repoType = pfs.UserRepoType // default to user
 			}
-			repoInfos, err := c.ListRepoByType(repoType)
+			metadata, err := cmdutil.ParseKeyValues(metadataPairs)
 			if err != nil {
 				return err
 			}
+			listRepoClient, err := c.PfsAPIClient.ListRepo(c.Ctx(), &pfs.ListRepoRequest{Type: repoType, Metadata: metadata})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			repoInfos, err := clientsdk.ListRepoInfo(listRepoClient)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
 			if raw {
 				encoder := cmdutil.Encoder(output, os.Stdout)
 				for _, repoInfo := range repoInfos {
@@ -203,6 +234,7 @@ or type (e.g. csv, binary, images, etc).`,
 	listRepo.Flags().AddFlagSet(timestampFlags)
 	listRepo.Flags().BoolVar(&all, "all", false, "include system repos of all types")
 	listRepo.Flags().StringVar(&repoType, "type", "", "only include repos of the given type")
+	listRepo.Flags().StringArrayVar(&metadataPairs, "metadata", nil, "only include repos with this key=value pair of metadata; may be repeated")
 	commands = append(commands, cmdutil.CreateAlias(listRepo, "list repo"))
 
 	var force bool
@@ -245,6 +277,168 @@ or type (e.g. csv, binary, images, etc).`,
 	shell.RegisterCompletionFunc(deleteRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteRepo, "delete repo"))
 
+	renameRepo := &cobra.Command{
+		Use:   "{{alias}} <repo> <new-name>",
+		Short: "Rename a repo.",
+		Long:  "Rename a repo, keeping its commits, branches and role bindings. The inputs of pipelines which read from the repo are updated to its new name.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			err = txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				_, err := c.PfsAPIClient.RenameRepo(c.Ctx(), &pfs.RenameRepoRequest{
+					Repo:    cmdutil.ParseRepo(args[0]),
+					NewName: args[1],
+				})
+				return err
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	shell.RegisterCompletionFunc(renameRepo, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(renameRepo, "rename repo"))
+
+	var forkBranches []string
+	forkRepo := &cobra.Command{
+		Use:   "{{alias}} <repo> <new-repo>",
+		Short: "Fork a repo.",
+		Long:  "Create a new repo with branches that start at the heads of the branches of an existing repo. The data is shared with the existing repo rather than copied, and the two repos can be written to independently.",
+		Example: `
+# Fork all of the branches of repo "foo" into the new repo "bar"
+$ {{alias}} foo bar
+
+# Fork only the master branch of repo "foo"
+$ {{alias}} foo bar -b master`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			_, err = c.PfsAPIClient.ForkRepo(c.Ctx(), &pfs.ForkRepoRequest{
+				Source:      cmdutil.ParseRepo(args[0]),
+				Destination: cmdutil.ParseRepo(args[1]),
+				Branches:    forkBranches,
+				Description: description,
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	forkRepo.Flags().StringSliceVarP(&forkBranches, "branch", "b", nil, "a branch to fork; may be repeated, all branches are forked if none are given")
+	forkRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the new repo.")
+	shell.RegisterCompletionFunc(forkRepo, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(forkRepo, "fork repo"))
+
+	var exportBase string
+	var exportPath string
+	exportRepo := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Export a repo to a bundle.",
+		Long: `Export the commits, branches and file contents of a repo to a bundle, which can be imported by another cluster with 'import repo'.
+
+Unfinished commits are left out of the bundle. If --base is given, the bundle is incremental: it leaves out the base commit and its ancestors, which must already have been imported from an earlier bundle.
+
+The bundle contains the keys needed to decrypt the file contents, so it should be stored and transferred as securely as the data itself.`,
+		Example: `
+# Export repo "foo" to the file foo.bundle
+$ {{alias}} foo -o foo.bundle
+
+# Export the commits of repo "foo" made after commit 0123456789ab
+$ {{alias}} foo --base foo@0123456789ab -o foo-incremental.bundle`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			var base *pfs.Commit
+			if exportBase != "" {
+				var err error
+				base, err = cmdutil.ParseCommit(exportBase)
+				if err != nil {
+					return err
+				}
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			var w io.Writer = os.Stdout
+			if exportPath != "" {
+				f, err := os.Create(exportPath)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				defer func() {
+					if err := f.Close(); err != nil && retErr == nil {
+						retErr = errors.EnsureStack(err)
+					}
+				}()
+				w = f
+			}
+			return c.ExportRepo(args[0], base, w)
+		}),
+	}
+	exportRepo.Flags().StringVar(&exportBase, "base", "", "A commit, of the form <repo>@<commit>, that was exported in an earlier bundle; it and its ancestors are left out of the bundle.")
+	exportRepo.Flags().StringVarP(&exportPath, "output", "o", "", "The path of the file to write the bundle to; it is written to stdout if not given.")
+	shell.RegisterCompletionFunc(exportRepo, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(exportRepo, "export repo"))
+
+	var importPath string
+	importRepo := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Import a repo from a bundle.",
+		Long: `Import a bundle written by 'export repo'. The commits and branches in the bundle are added to the given repo, or to the repo the bundle was exported from if none is given.
+
+A full bundle creates the repo, which must not exist. An incremental bundle is added to an existing repo, which must contain the bundle's base commit. Commits whose IDs are already in use by another repo are given new IDs, which are printed.`,
+		Example: `
+# Import the bundle foo.bundle into the repo it was exported from
+$ {{alias}} -f foo.bundle
+
+# Import the bundle foo.bundle into the new repo "bar"
+$ {{alias}} bar -f foo.bundle`,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) (retErr error) {
+			var repo string
+			if len(args) > 0 {
+				repo = args[0]
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			var r io.Reader = os.Stdin
+			if importPath != "" && importPath != "-" {
+				f, err := os.Open(importPath)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				defer func() {
+					if err := f.Close(); err != nil && retErr == nil {
+						retErr = errors.EnsureStack(err)
+					}
+				}()
+				r = f
+			}
+			resp, err := c.ImportRepo(repo, r)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("imported %d commits into repo %q (%d chunks, %d already present)\n", resp.Commits, resp.Repo.Name, resp.Chunks, resp.DeduplicatedChunks)
+			oldIDs := make([]string, 0, len(resp.CommitIds))
+			for oldID := range resp.CommitIds {
+				oldIDs = append(oldIDs, oldID)
+			}
+			sort.Strings(oldIDs)
+			for _, oldID := range oldIDs {
+				fmt.Printf("commit %s was imported as %s\n", oldID, resp.CommitIds[oldID])
+			}
+			return nil
+		}),
+	}
+	importRepo.Flags().StringVarP(&importPath, "file", "f", "", "The path of the bundle to import; it is read from stdin if not given or \"-\".")
+	commands = append(commands, cmdutil.CreateAlias(importRepo, "import repo"))
+
 	commitDocs := &cobra.Command{
 		Short: "Docs for commits.",
 		Long: `Commits are atomic transactions on the content of a repo.
@@ -282,6 +476,10 @@ $ {{alias}} test -p XXX`,
 			if err != nil {
 				return err
 			}
+			metadata, err := cmdutil.ParseKeyValues(metadataPairs)
+			if err != nil {
+				return err
+			}
 			c, err := newClient("user")
 			if err != nil {
 				return err
@@ -307,6 +505,7 @@ $ {{alias}} test -p XXX`,
 						Branch:      branch,
 						Parent:      parentCommit,
 						Description: description,
+						Metadata:    metadata,
 					},
 				)
 				return err
@@ -321,6 +520,7 @@ $ {{alias}} test -p XXX`,
 	startCommit.MarkFlagCustom("parent", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
 	startCommit.Flags().StringVarP(&description, "message", "m", "", "A description of this commit's contents")
 	startCommit.Flags().StringVar(&description, "description", "", "A description of this commit's contents (synonym for --message)")
+	startCommit.Flags().StringArrayVar(&metadataPairs, "metadata", nil, "A key=value pair of metadata for the commit; may be repeated.")
 	shell.RegisterCompletionFunc(startCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(startCommit, "start commit"))
 
@@ -333,6 +533,10 @@ $ {{alias}} test -p XXX`,
 			if err != nil {
 				return err
 			}
+			metadata, err := cmdutil.ParseKeyValues(metadataPairs)
+			if err != nil {
+				return err
+			}
 			c, err := newClient("user")
 			if err != nil {
 				return err
@@ -346,6 +550,7 @@ $ {{alias}} test -p XXX`,
 						Commit:      commit,
 						Description: description,
 						Force:       force,
+						Metadata:    metadata,
 					},
 				)
 				return err
@@ -356,6 +561,7 @@ $ {{alias}} test -p XXX`,
 	finishCommit.Flags().StringVarP(&description, "message", "m", "", "A description of this commit's contents (overwrites any existing commit description)")
 	finishCommit.Flags().StringVar(&description, "description", "", "A description of this commit's contents (synonym for --message)")
 	finishCommit.Flags().BoolVarP(&force, "force", "f", false, "finish the commit even if it has provenance, which could break jobs; prefer 'stop job'")
+	finishCommit.Flags().StringArrayVar(&metadataPairs, "metadata", nil, "A key=value pair of metadata to add to the commit; may be repeated.")
 	shell.RegisterCompletionFunc(finishCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(finishCommit, "finish commit"))
 
@@ -403,6 +609,44 @@ $ {{alias}} test -p XXX`,
 	shell.RegisterCompletionFunc(inspectCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectCommit, "inspect commit"))
 
+	updateCommit := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>",
+		Short: "Update the metadata of a commit.",
+		Long:  "Update the metadata of a commit.",
+		Example: `
+# set the metadata key "model_version" of the head of branch "master" in repo "foo"
+$ {{alias}} foo@master --metadata model_version=3
+
+# remove the metadata key "approved_by" from commit XXX in repo "foo"
+$ {{alias}} foo@XXX --remove-metadata approved_by`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			commit, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			metadata, err := cmdutil.ParseKeyValues(metadataPairs)
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			_, err = c.PfsAPIClient.SetMetadata(c.Ctx(), &pfs.SetMetadataRequest{
+				Commit:     commit,
+				Metadata:   metadata,
+				RemoveKeys: removeMetadata,
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	updateCommit.Flags().StringArrayVar(&metadataPairs, "metadata", nil, "A key=value pair of metadata to set on the commit; may be repeated.")
+	updateCommit.Flags().StringArrayVar(&removeMetadata, "remove-metadata", nil, "A metadata key to remove from the commit; may be repeated.")
+	shell.RegisterCompletionFunc(updateCommit, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(updateCommit, "update commit"))
+
 	var from string
 	var number int64
 	var originStr string
@@ -453,6 +697,11 @@ $ {{alias}} foo@master --from XXX`,
 				return err
 			}
 
+			metadata, err := cmdutil.ParseKeyValues(metadataPairs)
+			if err != nil {
+				return err
+			}
+
 			listClient, err := c.PfsAPIClient.ListCommit(c.Ctx(), &pfs.ListCommitRequest{
 				Repo:       branch.Repo,
 				From:       fromCommit,
@@ -460,6 +709,7 @@ $ {{alias}} foo@master --from XXX`,
 				Number:     number,
 				All:        all,
 				OriginKind: origin,
+				Metadata:   metadata,
 			})
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
@@ -488,6 +738,7 @@ $ {{alias}} foo@master --from XXX`,
 	listCommit.MarkFlagCustom("from", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
 	listCommit.Flags().BoolVar(&all, "all", false, "return all types of commits, including aliases")
 	listCommit.Flags().StringVar(&originStr, "origin", "", "only return commits of a specific type")
+	listCommit.Flags().StringArrayVar(&metadataPairs, "metadata", nil, "only return commits with this key=value pair of metadata; may be repeated")
 	listCommit.Flags().AddFlagSet(outputFlags)
 	listCommit.Flags().AddFlagSet(timestampFlags)
 	shell.RegisterCompletionFunc(listCommit, shell.RepoCompletion)
@@ -781,6 +1032,81 @@ $ {{alias}}`,
 	shell.RegisterCompletionFunc(squashCommitSet, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(squashCommitSet, "squash commitset"))
 
+	var targetBranch string
+	revertCommit := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>",
+		Short: "Undo the changes made by a commit.",
+		Long:  "Undo the changes made by a commit, by finishing a new commit which restores the files it changed to their contents in its parent. The history of the branch is preserved.",
+		Example: `
+# undo the changes made by the head commit of "master" in repo "foo"
+$ {{alias}} foo@master
+
+# undo the changes made by commit XXX, on branch "fix"
+$ {{alias}} foo@XXX --branch fix`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			commit, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			var branch *pfs.Branch
+			if targetBranch != "" {
+				branch = commit.Branch.Repo.NewBranch(targetBranch)
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			revert, err := c.RevertCommit(commit, branch, description)
+			if err != nil {
+				return err
+			}
+			fmt.Println(revert.ID)
+			return nil
+		}),
+	}
+	revertCommit.Flags().StringVarP(&targetBranch, "branch", "b", "", "The branch to create the revert commit on, defaults to the branch of the commit.")
+	revertCommit.Flags().StringVarP(&description, "message", "m", "", "A description of the revert commit's contents")
+	revertCommit.Flags().StringVar(&description, "description", "", "A description of the revert commit's contents (synonym for --message)")
+	shell.RegisterCompletionFunc(revertCommit, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(revertCommit, "revert commit"))
+
+	cherryPickCommit := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit> <repo>@<branch>",
+		Short: "Apply the changes made by a commit to a branch.",
+		Long:  "Apply the changes made by a commit to a branch, by finishing a new commit on it. The branch may be in another repo.",
+		Example: `
+# apply the changes made by commit XXX in repo "foo" to branch "release"
+$ {{alias}} foo@XXX foo@release`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			commit, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			branch, err := cmdutil.ParseBranch(args[1])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			cherryPick, err := c.CherryPickCommit(commit, branch, description)
+			if err != nil {
+				return err
+			}
+			fmt.Println(cherryPick.ID)
+			return nil
+		}),
+	}
+	cherryPickCommit.Flags().StringVarP(&description, "message", "m", "", "A description of the new commit's contents")
+	cherryPickCommit.Flags().StringVar(&description, "description", "", "A description of the new commit's contents (synonym for --message)")
+	shell.RegisterCompletionFunc(cherryPickCommit, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(cherryPickCommit, "cherry-pick commit"))
+
 	branchDocs := &cobra.Command{
 		Short: "Docs for branches.",
 		Long: `A branch in Pachyderm is an alias for a Commit ID.
@@ -796,6 +1122,9 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	var branchProvenance cmdutil.RepeatedStringArg
 	var head string
 	trigger := &pfs.Trigger{}
+	var protect, unprotect bool
+	var protectPrincipals []string
+	var stagingBranch string
 	createBranch := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>",
 		Short: "Create a new branch, or update an existing branch, on a repo.",
@@ -812,12 +1141,26 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 			if len(provenance) != 0 && trigger.Branch != "" {
 				return errors.Errorf("cannot use provenance and triggers on the same branch")
 			}
-			if (trigger.CronSpec != "" || trigger.Size_ != "" || trigger.Commits != 0) && trigger.Branch == "" {
+			if (trigger.CronSpec != "" || trigger.Size_ != "" || trigger.Commits != 0 || trigger.Glob != "" || trigger.Description != "") && trigger.Branch == "" {
 				return errors.Errorf("trigger condition specified without a branch to trigger on, specify a branch with --trigger")
 			}
 			if proto.Equal(trigger, &pfs.Trigger{}) {
 				trigger = nil
 			}
+			var protection *pfs.BranchProtection
+			if protect || len(protectPrincipals) > 0 || stagingBranch != "" {
+				if unprotect {
+					return errors.Errorf("cannot protect and unprotect a branch at the same time")
+				}
+				protection = &pfs.BranchProtection{
+					Principals:    protectPrincipals,
+					NoDelete:      protect,
+					NoForce:       protect,
+					StagingBranch: stagingBranch,
+				}
+			} else if unprotect {
+				protection = &pfs.BranchProtection{}
+			}
 			var headCommit *pfs.Commit
 			if head != "" {
 				if strings.Contains(head, "@") {
@@ -845,6 +1188,7 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 						Branch:     branch,
 						Provenance: provenance,
 						Trigger:    trigger,
+						Protection: protection,
 					})
 				return grpcutil.ScrubGRPC(err)
 			})
@@ -858,7 +1202,13 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	createBranch.Flags().StringVar(&trigger.CronSpec, "trigger-cron", "", "The cron spec to use in triggering.")
 	createBranch.Flags().StringVar(&trigger.Size_, "trigger-size", "", "The data size to use in triggering.")
 	createBranch.Flags().Int64Var(&trigger.Commits, "trigger-commits", 0, "The number of commits to use in triggering.")
+	createBranch.Flags().StringVar(&trigger.Glob, "trigger-glob", "", "Only trigger when a file matching this glob pattern has changed, e.g. '/configs/**'.")
+	createBranch.Flags().StringVar(&trigger.Description, "trigger-description", "", "Only trigger when the description of the new commit matches this regular expression.")
 	createBranch.Flags().BoolVar(&trigger.All, "trigger-all", false, "Only trigger when all conditions are met, rather than when any are met.")
+	createBranch.Flags().BoolVar(&protect, "protect", false, "Protect the branch from being deleted, and its commits from being squashed or force-finished.")
+	createBranch.Flags().StringSliceVar(&protectPrincipals, "protect-principal", nil, "Only allow these principals to move the head of the branch, e.g. user:alice.")
+	createBranch.Flags().StringVar(&stagingBranch, "protect-staging", "", "Only allow moving the head of the branch to commits on this staging branch, with 'create branch'.")
+	createBranch.Flags().BoolVar(&unprotect, "unprotect", false, "Remove the protection of the branch.")
 	commands = append(commands, cmdutil.CreateAlias(createBranch, "create branch"))
 
 	inspectBranch := &cobra.Command{
@@ -897,6 +1247,38 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	shell.RegisterCompletionFunc(inspectBranch, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectBranch, "inspect branch"))
 
+	updateBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch>",
+		Short: "Update the metadata of a branch.",
+		Long:  "Update the metadata of a branch.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			metadata, err := cmdutil.ParseKeyValues(metadataPairs)
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			_, err = c.PfsAPIClient.SetMetadata(c.Ctx(), &pfs.SetMetadataRequest{
+				Branch:     branch,
+				Metadata:   metadata,
+				RemoveKeys: removeMetadata,
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	updateBranch.Flags().StringArrayVar(&metadataPairs, "metadata", nil, "A key=value pair of metadata to set on the branch; may be repeated.")
+	updateBranch.Flags().StringArrayVar(&removeMetadata, "remove-metadata", nil, "A metadata key to remove from the branch; may be repeated.")
+	shell.RegisterCompletionFunc(updateBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(updateBranch, "update branch"))
+
 	listBranch := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Return all branches on a repo.",
@@ -961,6 +1343,93 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	shell.RegisterCompletionFunc(deleteBranch, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteBranch, "delete branch"))
 
+	renameBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch> <new-name>",
+		Short: "Rename a branch.",
+		Long:  "Rename a branch, keeping its commits. Branch provenance, triggers and the inputs of pipelines which read from the branch are updated to its new name.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				_, err := c.PfsAPIClient.RenameBranch(c.Ctx(), &pfs.RenameBranchRequest{Branch: branch, NewName: args[1]})
+				return err
+			})
+		}),
+	}
+	shell.RegisterCompletionFunc(renameBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(renameBranch, "rename branch"))
+
+	var strategy string
+	mergeBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<source-branch> <target-branch>",
+		Short: "Merge the changes made on a branch into another branch.",
+		Long:  "Merge the changes made on a branch into another branch of the same repo, by finishing a new commit on the target branch with the changes made on the source branch since their common ancestor. Files changed differently on both branches are conflicts, which are resolved by --strategy.",
+		Example: `
+# merge branch "feature" into "master" in repo "foo", failing if there are conflicts
+$ {{alias}} foo@feature master
+
+# merge, keeping the files of "master" if there are conflicts
+$ {{alias}} foo@feature master --strategy ours`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) (retErr error) {
+			source, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			mergeStrategy, err := parseMergeStrategy(strategy)
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			resp, err := c.PfsAPIClient.MergeBranch(c.Ctx(), &pfs.MergeBranchRequest{
+				Source:      source,
+				Target:      source.Repo.NewBranch(args[1]),
+				Strategy:    mergeStrategy,
+				Description: description,
+			})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return cmdutil.Encoder(output, os.Stdout).EncodeProto(resp)
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			if len(resp.Conflicts) > 0 {
+				w := tabwriter.NewWriter(os.Stdout, pretty.MergeConflictHeader)
+				for _, conflict := range resp.Conflicts {
+					pretty.PrintMergeConflict(w, conflict)
+				}
+				if err := w.Flush(); err != nil {
+					return err
+				}
+			}
+			if resp.Commit == nil {
+				return errors.Errorf("not merging %s into %s due to %d conflicts, use --strategy to resolve them", source, args[1], len(resp.Conflicts))
+			}
+			fmt.Println(resp.Commit.ID)
+			return nil
+		}),
+	}
+	mergeBranch.Flags().StringVar(&strategy, "strategy", "fail", "How to resolve files changed differently on both branches, one of: fail, ours, theirs.")
+	mergeBranch.Flags().StringVarP(&description, "message", "m", "", "A description of the merge commit's contents")
+	mergeBranch.Flags().StringVar(&description, "description", "", "A description of the merge commit's contents (synonym for --message)")
+	mergeBranch.Flags().AddFlagSet(outputFlags)
+	shell.RegisterCompletionFunc(mergeBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(mergeBranch, "merge branch"))
+
 	fileDocs := &cobra.Command{
 		Short: "Docs for files.",
 		Long: `Files are the lowest level data objects in Pachyderm.
@@ -979,6 +1448,9 @@ from commits with 'get file'.`,
 	var compress bool
 	var enableProgress bool
 	var fullPath bool
+	var split string
+	var targetFileDatums, targetFileBytes, headerRecords uint
+	var attributes []string
 	putFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>[:<path/to/file>]",
 		Short: "Put a file into the filesystem.",
@@ -1021,7 +1493,17 @@ $ {{alias}} repo@branch -i file
 # Put several files or URLs that are listed at URL.
 # NOTE this URL can reference local files, so it could cause you to put sensitive
 # files into your Pachyderm cluster.
-$ {{alias}} repo@branch -i http://host/path`,
+$ {{alias}} repo@branch -i http://host/path
+
+# Split a JSON lines file into files of 100 records each under repo/branch/path/:
+$ {{alias}} repo@branch:/path -f data.jsonl --split json --target-file-datums 100
+
+# Split a CSV file into files of about 1MB each under repo/branch/path/,
+# repeating the CSV header at the start of each file:
+$ {{alias}} repo@branch:/path -f data.csv --split csv --target-file-bytes 1048576 --header-records 1
+
+# Put a file with a content type and a user defined attribute:
+$ {{alias}} repo@branch:/path -f image.png --attr content-type=image/png --attr label=cat`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
 			if !enableProgress {
 				progress.Disable()
@@ -1030,6 +1512,31 @@ $ {{alias}} repo@branch -i http://host/path`,
 			if err != nil {
 				return err
 			}
+			var putFileOpts []client.PutFileOption
+			if appendFile {
+				putFileOpts = append(putFileOpts, client.WithAppendPutFile())
+			}
+			if len(attributes) > 0 {
+				attrs, err := cmdutil.ParseKeyValues(attributes)
+				if err != nil {
+					return err
+				}
+				putFileOpts = append(putFileOpts, client.WithAttributesPutFile(attrs))
+			}
+			if split != "" {
+				delimiter, err := parseDelimiter(split)
+				if err != nil {
+					return err
+				}
+				putFileOpts = append(putFileOpts,
+					client.WithSplitPutFile(delimiter),
+					client.WithTargetFileDatumsPutFile(int64(targetFileDatums)),
+					client.WithTargetFileBytesPutFile(int64(targetFileBytes)),
+					client.WithHeaderRecordsPutFile(int64(headerRecords)),
+				)
+			} else if targetFileDatums != 0 || targetFileBytes != 0 || headerRecords != 0 {
+				return errors.New("cannot set --target-file-datums, --target-file-bytes or --header-records without --split")
+			}
 			opts := []client.Option{client.WithMaxConcurrentStreams(parallelism)}
 			if compress {
 				opts = append(opts, client.WithGZIPCompression())
@@ -1097,13 +1604,13 @@ $ {{alias}} repo@branch -i http://host/path`,
 						if !fullPath {
 							target = filepath.Base(source)
 						}
-						if err := putFileHelper(mf, joinPaths("", target), source, recursive, appendFile); err != nil {
+						if err := putFileHelper(mf, joinPaths("", target), source, recursive, putFileOpts); err != nil {
 							return err
 						}
 					} else if len(sources) == 1 {
 						// We have a single source and the user has specified a path,
 						// we use the path and ignore source (in terms of naming the file).
-						if err := putFileHelper(mf, file.Path, source, recursive, appendFile); err != nil {
+						if err := putFileHelper(mf, file.Path, source, recursive, putFileOpts); err != nil {
 							return err
 						}
 					} else {
@@ -1113,7 +1620,7 @@ $ {{alias}} repo@branch -i http://host/path`,
 						if !fullPath {
 							target = filepath.Base(source)
 						}
-						if err := putFileHelper(mf, joinPaths(file.Path, target), source, recursive, appendFile); err != nil {
+						if err := putFileHelper(mf, joinPaths(file.Path, target), source, recursive, putFileOpts); err != nil {
 							return err
 						}
 					}
@@ -1130,6 +1637,11 @@ $ {{alias}} repo@branch -i http://host/path`,
 	putFile.Flags().BoolVarP(&appendFile, "append", "a", false, "Append to the existing content of the file, either from previous commits or previous calls to 'put file' within this commit.")
 	putFile.Flags().BoolVar(&enableProgress, "progress", isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()), "Print progress bars.")
 	putFile.Flags().BoolVar(&fullPath, "full-path", false, "If true, use the entire path provided to -f as the target filename in PFS. By default only the base of the path is used.")
+	putFile.Flags().StringVar(&split, "split", "", "Split the input into records, and put them in numbered files in a directory at the target path. Accepts one of {line,json,sql,csv}.")
+	putFile.Flags().UintVar(&targetFileDatums, "target-file-datums", 0, "The number of records to put in each file when splitting. If neither this nor --target-file-bytes is set, each record gets its own file.")
+	putFile.Flags().UintVar(&targetFileBytes, "target-file-bytes", 0, "The number of bytes after which a new file is started when splitting. Records are never split across files.")
+	putFile.Flags().UintVar(&headerRecords, "header-records", 0, "The number of records at the start of the input to treat as a header and put at the start of every file when splitting (e.g. the header row of a CSV file).")
+	putFile.Flags().StringArrayVar(&attributes, "attr", nil, "A key=value attribute to set on the files, such as content-type=text/csv; may be repeated.")
 	shell.RegisterCompletionFunc(putFile,
 		func(flag, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
 			if flag == "-f" || flag == "--file" || flag == "-i" || flag == "input-file" {
@@ -1194,6 +1706,9 @@ $ {{alias}} foo@master^:XXX
 # in repo "foo"
 $ {{alias}} foo@master^2:XXX
 
+# get file "XXX" on branch "master" in repo "foo" as of June 1st 2021, UTC
+$ {{alias}} foo@master@2021-06-01T00:00Z:XXX
+
 # get file "test[].txt" on branch "master" in repo "foo"
 # the path is interpreted as a glob pattern: quote and protect regex characters
 $ {{alias}} 'foo@master:/test\[\].txt'`,
@@ -1238,6 +1753,7 @@ $ {{alias}} 'foo@master:/test\[\].txt'`,
 	shell.RegisterCompletionFunc(getFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(getFile, "get file"))
 
+	var inspectHistory string
 	inspectFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/in/pfs>",
 		Short: "Return info about a file.",
@@ -1247,12 +1763,16 @@ $ {{alias}} 'foo@master:/test\[\].txt'`,
 			if err != nil {
 				return err
 			}
+			history, err := cmdutil.ParseHistory(inspectHistory)
+			if err != nil {
+				return errors.Wrapf(err, "error parsing history flag")
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
 			}
 			defer c.Close()
-			fileInfo, err := c.InspectFile(file.Commit, file.Path)
+			fileInfo, err := c.InspectFileHistory(file.Commit, file.Path, history)
 			if err != nil {
 				return err
 			}
@@ -1268,6 +1788,7 @@ $ {{alias}} 'foo@master:/test\[\].txt'`,
 		}),
 	}
 	inspectFile.Flags().AddFlagSet(outputFlags)
+	inspectFile.Flags().StringVar(&inspectHistory, "history", "none", "Return a previous version of the file, counting back from the commit it was last modified in ('all' returns the earliest version).")
 	shell.RegisterCompletionFunc(inspectFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectFile, "inspect file"))
 
@@ -1291,6 +1812,9 @@ $ {{alias}} foo@master^
 # in repo "foo"
 $ {{alias}} foo@master^2
 
+# list top-level files on branch "master" in repo "foo" as of June 1st 2021, UTC
+$ {{alias}} foo@master@2021-06-01T00:00Z
+
 # list the last n versions of top-level files on branch "master" in repo "foo"
 $ {{alias}} foo@master --history n
 
@@ -1316,7 +1840,7 @@ $ {{alias}} 'foo@master:dir\[1\]'`,
 			defer c.Close()
 			if raw {
 				encoder := cmdutil.Encoder(output, os.Stdout)
-				return c.ListFile(file.Commit, file.Path, func(fi *pfs.FileInfo) error {
+				return c.ListFileHistory(file.Commit, file.Path, history, func(fi *pfs.FileInfo) error {
 					return encoder.EncodeProto(fi)
 				})
 			} else if output != "" {
@@ -1327,7 +1851,7 @@ $ {{alias}} 'foo@master:dir\[1\]'`,
 				header = pretty.FileHeaderWithCommit
 			}
 			writer := tabwriter.NewWriter(os.Stdout, header)
-			if err := c.ListFile(file.Commit, file.Path, func(fi *pfs.FileInfo) error {
+			if err := c.ListFileHistory(file.Commit, file.Path, history, func(fi *pfs.FileInfo) error {
 				pretty.PrintFileInfo(writer, fi, fullTimestamps, history != 0)
 				return nil
 			}); err != nil {
@@ -1393,19 +1917,28 @@ $ {{alias}} "foo@master:data/*"`,
 
 	var shallow bool
 	var nameOnly bool
+	var summary bool
 	var diffCmdArg string
 	diffFile := &cobra.Command{
 		Use:   "{{alias}} <new-repo>@<new-branch-or-commit>:<new-path> [<old-repo>@<old-branch-or-commit>:<old-path>]",
-		Short: "Return a diff of two file trees in input repo. Diff of file trees in output repo coming soon.",
-		Long:  "Return a diff of two file trees in input repo. Diff of file trees in output repo coming soon.",
+		Short: "Return a diff of two file trees.",
+		Long:  "Return a diff of two file trees, which may be in any commits of any repos, including output repos. If the old file tree is omitted, the new one is compared with the same path in the parent of its commit, skipping commits that failed.",
 		Example: `
-# Return the diff of the file "path" of the input repo "foo" between the head of the
+# Return the diff of the file "path" of the repo "foo" between the head of the
 # "master" branch and its parent.
 $ {{alias}} foo@master:path
 
-# Return the diff between the master branches of input repos foo and bar at paths
+# Return the diff between the master branches of repos foo and bar at paths
 # path1 and path2, respectively.
-$ {{alias}} foo@master:path1 bar@master:path2`,
+$ {{alias}} foo@master:path1 bar@master:path2
+
+# Return the diff of the output repo "edges" between the output commits of two
+# different jobs.
+$ {{alias}} edges@<new-commit>:/ edges@<old-commit>:/
+
+# Return the numbers and sizes of the files added, modified and deleted
+# between the "master" and "staging" branches of the repo "foo".
+$ {{alias}} foo@master:/ foo@staging:/ --summary`,
 		Run: cmdutil.RunBoundedArgs(1, 2, func(args []string) error {
 			newFile, err := cmdutil.ParseFile(args[0])
 			if err != nil {
@@ -1424,6 +1957,16 @@ $ {{alias}} foo@master:path1 bar@master:path2`,
 			}
 			defer c.Close()
 
+			if summary {
+				s, err := c.DiffFileSummary(newFile.Commit, newFile.Path, oldFile.Commit, oldFile.Path)
+				if err != nil {
+					return err
+				}
+				writer := tabwriter.NewWriter(os.Stdout, pretty.DiffFileSummaryHeader)
+				pretty.PrintDiffFileSummary(writer, s)
+				return writer.Flush()
+			}
+
 			return pager.Page(noPager, os.Stdout, func(w io.Writer) (retErr error) {
 				var writer *tabwriter.Writer
 				if nameOnly {
@@ -1489,6 +2032,7 @@ $ {{alias}} foo@master:path1 bar@master:path2`,
 	}
 	diffFile.Flags().BoolVarP(&shallow, "shallow", "s", false, "Don't descend into sub directories.")
 	diffFile.Flags().BoolVar(&nameOnly, "name-only", false, "Show only the names of changed files.")
+	diffFile.Flags().BoolVar(&summary, "summary", false, "Show only the numbers and sizes of the added, modified and deleted files.")
 	diffFile.Flags().StringVar(&diffCmdArg, "diff-command", "", "Use a program other than git to diff files.")
 	diffFile.Flags().AddFlagSet(timestampFlags)
 	diffFile.Flags().AddFlagSet(pagerFlags)
@@ -1561,6 +2105,175 @@ Objects are a low-level resource and should not be accessed directly by most use
 	fsck.Flags().BoolVarP(&fix, "fix", "f", false, "Attempt to fix as many issues as possible.")
 	commands = append(commands, cmdutil.CreateAlias(fsck, "fsck"))
 
+	printStorageKeyInfo := func(info *pfs.StorageKeyInfo) error {
+		if raw {
+			return cmdutil.Encoder(output, os.Stdout).EncodeProto(info)
+		} else if output != "" {
+			return errors.New("cannot set --output (-o) without --raw")
+		}
+		fmt.Printf("Version: %d\n", info.Version)
+		fmt.Printf("Rewrapped file sets: %d/%d\n", info.RewrappedFileSets, info.FileSets)
+		return nil
+	}
+
+	rotateStorageKey := &cobra.Command{
+		Short: "Rotate the storage encryption key.",
+		Long: "Create a new version of the master key that wraps the encryption keys of stored chunks. " +
+			"Existing chunks are rewrapped with the new version in the background, use 'inspect storage-key' to check the progress.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			info, err := c.RotateStorageKey()
+			if err != nil {
+				return err
+			}
+			return printStorageKeyInfo(info)
+		}),
+	}
+	rotateStorageKey.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(rotateStorageKey, "rotate storage-key"))
+
+	inspectStorageKey := &cobra.Command{
+		Short: "Return info about the storage encryption key.",
+		Long:  "Return the version of the master key that wraps the encryption keys of stored chunks, and the progress of rewrapping existing chunks with it.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			info, err := c.InspectStorageKey()
+			if err != nil {
+				return err
+			}
+			return printStorageKeyInfo(info)
+		}),
+	}
+	inspectStorageKey.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(inspectStorageKey, "inspect storage-key"))
+
+	quotaDocs := &cobra.Command{
+		Short: "Docs for quotas.",
+		Long: `Quotas limit the size and the number of files of the head commit of a repo's master branch.
+
+A quota can apply to all writes to a repo, or only to writes by a single principal.
+Quotas are enforced when files are modified and when commits are finished.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(quotaDocs, "quota", " quota$"))
+
+	var principal string
+	var sizeBytes string
+	var fileCount int64
+	var update bool
+	createQuota := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Create a quota on a repo.",
+		Long:  "Create a quota on a repo, limiting the size and the number of files of the head of its master branch.",
+		Example: `
+# Limit the repo "foo" to 10GB and 100000 files
+$ {{alias}} foo --size 10GB --file-count 100000
+
+# Limit the writes by user "alice" to the repo "foo" to 1GB
+$ {{alias}} foo --principal user:alice --size 1GB`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			var size int64
+			if sizeBytes != "" {
+				var err error
+				size, err = units.FromHumanSize(sizeBytes)
+				if err != nil {
+					return errors.Wrapf(err, "could not parse size %q", sizeBytes)
+				}
+			}
+			if size == 0 && fileCount == 0 {
+				return errors.New("must set at least one of --size or --file-count")
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.CreateQuota(args[0], principal, size, fileCount, update)
+		}),
+	}
+	createQuota.Flags().StringVarP(&principal, "principal", "p", "", "The principal the quota applies to, the quota applies to all principals if unset.")
+	createQuota.Flags().StringVar(&sizeBytes, "size", "", "The maximum size of the repo, e.g. 10GB.")
+	createQuota.Flags().Int64Var(&fileCount, "file-count", 0, "The maximum number of files in the repo.")
+	createQuota.Flags().BoolVar(&update, "update", false, "Overwrite the existing quota if there is one.")
+	shell.RegisterCompletionFunc(createQuota, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(createQuota, "create quota"))
+
+	inspectQuota := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Return info about a quota.",
+		Long:  "Return info about a quota on a repo, including its current usage.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			quotaInfo, err := c.InspectQuota(args[0], principal)
+			if err != nil {
+				return err
+			}
+			if raw {
+				return cmdutil.Encoder(output, os.Stdout).EncodeProto(quotaInfo)
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.QuotaHeader)
+			pretty.PrintQuotaInfo(writer, quotaInfo)
+			return writer.Flush()
+		}),
+	}
+	inspectQuota.Flags().StringVarP(&principal, "principal", "p", "", "The principal of the quota to inspect.")
+	inspectQuota.Flags().AddFlagSet(outputFlags)
+	shell.RegisterCompletionFunc(inspectQuota, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(inspectQuota, "inspect quota"))
+
+	listQuota := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Return a list of quotas.",
+		Long:  "Return a list of quotas on a repo, or on all repos if no repo is given, including their current usage.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			var repoName string
+			if len(args) > 0 {
+				repoName = args[0]
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			quotaInfos, err := c.ListQuota(repoName)
+			if err != nil {
+				return err
+			}
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				for _, quotaInfo := range quotaInfos {
+					if err := encoder.EncodeProto(quotaInfo); err != nil {
+						return err
+					}
+				}
+				return nil
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.QuotaHeader)
+			for _, quotaInfo := range quotaInfos {
+				pretty.PrintQuotaInfo(writer, quotaInfo)
+			}
+			return writer.Flush()
+		}),
+	}
+	listQuota.Flags().AddFlagSet(outputFlags)
+	shell.RegisterCompletionFunc(listQuota, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(listQuota, "list quota"))
+
 	var seed int64
 	runLoadTest := &cobra.Command{
 		Use:     "{{alias}} <spec>",
@@ -1598,13 +2311,9 @@ Objects are a low-level resource and should not be accessed directly by most use
 	return commands
 }
 
-func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendFile bool) (retErr error) {
+func putFileHelper(mf client.ModifyFile, path, source string, recursive bool, opts []client.PutFileOption) (retErr error) {
 	// Resolve the path and convert to unix path in case we're on windows.
 	path = filepath.ToSlash(filepath.Clean(path))
-	var opts []client.PutFileOption
-	if appendFile {
-		opts = append(opts, client.WithAppendPutFile())
-	}
 	// try parsing the filename as a url, if it is one do a PutFileURL
 	if url, err := url.Parse(source); err == nil && url.Scheme != "" {
 		return mf.PutFileURL(path, url.String(), recursive, opts...)
@@ -1632,7 +2341,7 @@ func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendF
 			// don't do a second recursive 'put file', just put the one file at
 			// filePath into childDest, and then this walk loop will go on to the
 			// next one
-			return putFileHelper(mf, childDest, filePath, false, appendFile)
+			return putFileHelper(mf, childDest, filePath, false, opts)
 		})
 	}
 	f, err := progress.Open(source)
@@ -1647,6 +2356,21 @@ func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendF
 	return mf.PutFile(path, f, opts...)
 }
 
+func parseDelimiter(split string) (pfs.Delimiter, error) {
+	switch split {
+	case "line":
+		return pfs.Delimiter_LINE, nil
+	case "json":
+		return pfs.Delimiter_JSON, nil
+	case "sql":
+		return pfs.Delimiter_SQL, nil
+	case "csv":
+		return pfs.Delimiter_CSV, nil
+	default:
+		return pfs.Delimiter_NONE, errors.Errorf("unrecognized delimiter '%s'; only accepts one of {line,json,sql,csv}", split)
+	}
+}
+
 func joinPaths(prefix, filePath string) string {
 	if url, err := url.Parse(filePath); err == nil && url.Scheme != "" {
 		if url.Scheme == "pfs" {
@@ -1737,6 +2461,14 @@ func newClient(name string, options ...client.Option) (*client.APIClient, error)
 	return client.NewOnUserMachine(name, options...)
 }
 
+func parseMergeStrategy(input string) (pfs.MergeStrategy, error) {
+	result, ok := pfs.MergeStrategy_value[strings.ToUpper(input)]
+	if !ok {
+		return pfs.MergeStrategy_FAIL, errors.Errorf("unknown merge strategy '%s', must be one of: fail, ours, theirs", input)
+	}
+	return pfs.MergeStrategy(result), nil
+}
+
 func parseOriginKind(input string) (pfs.OriginKind, error) {
 	if input == "" {
 		return pfs.OriginKind_ORIGIN_KIND_UNKNOWN, nil
@@ -122,3 +122,38 @@ func TestDiffFile(t *testing.T) {
 		"repo", tu.UniqueString("TestDiffFile-repo"),
 	).Run())
 }
+
+func TestQuota(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	require.NoError(t, tu.BashCmd(`
+		pachctl create repo {{.repo}}
+
+		( pachctl create quota {{.repo}} 2>&1 || true ) \
+		  | match "must set at least one of --size or --file-count"
+		pachctl create quota {{.repo}} --size 1KB --file-count 2
+		( pachctl create quota {{.repo}} --file-count 3 2>&1 || true ) \
+		  | match "already exists"
+
+		echo "foo" | pachctl put file {{.repo}}@master:/file1
+		echo "bar" | pachctl put file {{.repo}}@master:/file2
+		( echo "baz" | pachctl put file {{.repo}}@master:/file3 2>&1 || true ) \
+		  | match "quota exceeded on repo {{.repo}}: the commit would contain 3 files, the limit is 2 files"
+
+		pachctl inspect quota {{.repo}} \
+		  | match "2 / 2"
+		pachctl list quota \
+		  | match "{{.repo}}" \
+		  | match "2 / 2"
+
+		pachctl create quota {{.repo}} --file-count 3 --update
+		echo "baz" | pachctl put file {{.repo}}@master:/file3
+		pachctl list quota {{.repo}} \
+		  | match "3 / 3"
+		pachctl inspect repo {{.repo}} \
+		  | match "3 / 3"
+		`,
+		"repo", tu.UniqueString("TestQuota-repo"),
+	).Run())
+}
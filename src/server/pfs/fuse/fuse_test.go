@@ -3,6 +3,7 @@ package fuse
 import (
 	"bytes"
 	"crypto/sha256"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -129,6 +130,44 @@ func TestSeek(t *testing.T) {
 	})
 }
 
+// TestReadAt tests reading a file which hasn't been downloaded, sequentially
+// and at arbitrary offsets.
+func TestReadAt(t *testing.T) {
+	env := testpachd.NewRealEnv(t, testutil.NewTestDBConfig(t))
+	require.NoError(t, env.PachClient.CreateRepo("repo"))
+	random.SeedRand(123)
+	data := random.String(3 * MB)
+	err := env.PachClient.PutFile(client.NewCommit("repo", "master", ""), "file", strings.NewReader(data))
+	require.NoError(t, err)
+	withMount(t, env.PachClient, nil, func(mountPoint string) {
+		f, err := os.Open(filepath.Join(mountPoint, "repo", "file"))
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, f.Close())
+		}()
+
+		readAt := func(offset int64, size int) {
+			buf := make([]byte, size)
+			n, err := f.ReadAt(buf, offset)
+			if offset+int64(size) > int64(len(data)) {
+				require.Equal(t, io.EOF, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, data[offset:offset+int64(n)], string(buf[:n]))
+		}
+
+		// read the whole file sequentially
+		for offset := int64(0); offset < int64(len(data)); offset += 100 * 1024 {
+			readAt(offset, 100*1024)
+		}
+		// then jump backwards and forwards
+		readAt(MB, 1024)
+		readAt(17, 1024)
+		readAt(2*MB+5, MB)
+	})
+}
+
 func TestHeadlessBranch(t *testing.T) {
 	env := testpachd.NewRealEnv(t, testutil.NewTestDBConfig(t))
 	require.NoError(t, env.PachClient.CreateRepo("repo"))
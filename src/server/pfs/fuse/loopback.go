@@ -359,6 +359,20 @@ func (n *loopbackNode) Readlink(ctx context.Context) ([]byte, syscall.Errno) {
 
 func (n *loopbackNode) Open(ctx context.Context, flags uint32) (fh fs.FileHandle, fuseFlags uint32, errno syscall.Errno) {
 	p := n.path()
+	if !isWrite(flags) && !isCreate(flags) {
+		// Files that are only read don't need to be downloaded, their ranges
+		// are read from pachd as they're requested.
+		if err := n.download(p, meta); err != nil {
+			return nil, 0, fs.ToErrno(err)
+		}
+		file, err := n.remoteFile(p)
+		if err != nil {
+			return nil, 0, fs.ToErrno(err)
+		}
+		if file != nil {
+			return file, 0, 0
+		}
+	}
 	state := full
 	if isWrite(flags) {
 		if errno := n.checkWrite(p); errno != 0 {
@@ -611,6 +625,47 @@ func (n *loopbackNode) download(path string, state fileState) (retErr error) {
 	return nil
 }
 
+// remoteFile returns a remoteFile for path, or nil if the content of path
+// is already in the loopback filesystem (or path has no commit to read from).
+func (n *loopbackNode) remoteFile(path string) (*remoteFile, error) {
+	path = n.trimPath(path)
+	if n.hasContent(path) {
+		return nil, nil
+	}
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 {
+		return nil, nil
+	}
+	branch := n.root().branch(parts[0])
+	commit, err := n.commit(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	if commit == "" {
+		return nil, nil
+	}
+	return newRemoteFile(n.c(), client.NewCommit(parts[0], branch, commit).NewFile(pathpkg.Join(parts[1:]...))), nil
+}
+
+// hasContent returns true if the content of path, or of a directory containing
+// it, has been downloaded into the loopback filesystem.
+func (n *loopbackNode) hasContent(path string) bool {
+	n.root().mu.Lock()
+	defer n.root().mu.Unlock()
+	for {
+		if n.root().files[path] >= full {
+			return true
+		}
+		if path == "" {
+			return false
+		}
+		path = pathpkg.Dir(path)
+		if path == "." {
+			path = ""
+		}
+	}
+}
+
 func (n *loopbackNode) trimPath(path string) string {
 	path = strings.TrimPrefix(path, n.root().rootPath)
 	return strings.TrimPrefix(path, "/")
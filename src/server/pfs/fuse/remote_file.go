@@ -0,0 +1,73 @@
+package fuse
+
+import (
+	"context"
+	"io"
+	"sync"
+	"syscall"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// remoteFile is a read only file handle for a file whose content hasn't been
+// downloaded into the loopback filesystem. The file is streamed from pachd
+// starting at the offset of the first read, and it is only requested again
+// when a read isn't at the offset where the previous read ended.
+type remoteFile struct {
+	c      *client.APIClient
+	cancel context.CancelFunc
+	file   *pfs.File
+
+	mu     sync.Mutex
+	r      io.ReadSeeker
+	offset int64
+}
+
+var _ = (fs.FileReader)((*remoteFile)(nil))
+var _ = (fs.FileReleaser)((*remoteFile)(nil))
+
+func newRemoteFile(c *client.APIClient, file *pfs.File) *remoteFile {
+	ctx, cancel := context.WithCancel(c.Ctx())
+	return &remoteFile{
+		c:      c.WithCtx(ctx),
+		cancel: cancel,
+		file:   file,
+	}
+}
+
+func (f *remoteFile) Read(ctx context.Context, buf []byte, off int64) (fuse.ReadResult, syscall.Errno) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.r == nil {
+		r, err := f.c.GetFileReadSeeker(f.file.Commit, f.file.Path)
+		if err != nil {
+			return nil, fs.ToErrno(err)
+		}
+		f.r, f.offset = r, 0
+	}
+	if off != f.offset {
+		if _, err := f.r.Seek(off, io.SeekStart); err != nil {
+			return nil, fs.ToErrno(err)
+		}
+		f.offset = off
+	}
+	n, err := io.ReadFull(f.r, buf)
+	f.offset += int64(n)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		// The stream can't be resumed, so the file is requested again by
+		// the next read.
+		f.r = nil
+		return nil, fs.ToErrno(err)
+	}
+	return fuse.ReadResultData(buf[:n]), fs.OK
+}
+
+func (f *remoteFile) Release(ctx context.Context) syscall.Errno {
+	f.cancel()
+	return fs.OK
+}
@@ -1,6 +1,8 @@
 package pfs
 
 import (
+	"context"
+
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	pfs_client "github.com/pachyderm/pachyderm/v2/src/pfs"
 )
@@ -16,9 +18,13 @@ type APIServer interface {
 	CreateRepoInTransaction(*txncontext.TransactionContext, *pfs_client.CreateRepoRequest) error
 	InspectRepoInTransaction(*txncontext.TransactionContext, *pfs_client.InspectRepoRequest) (*pfs_client.RepoInfo, error)
 	DeleteRepoInTransaction(*txncontext.TransactionContext, *pfs_client.DeleteRepoRequest) error
+	RenameRepoInTransaction(*txncontext.TransactionContext, *pfs_client.RenameRepoRequest) error
 
 	StartCommitInTransaction(*txncontext.TransactionContext, *pfs_client.StartCommitRequest) (*pfs_client.Commit, error)
 	FinishCommitInTransaction(*txncontext.TransactionContext, *pfs_client.FinishCommitRequest) error
+	// PrepareFinishCommit does the work of FinishCommitInTransaction which can
+	// be done before the transaction, so that it isn't held open for it.
+	PrepareFinishCommit(context.Context, *pfs_client.FinishCommitRequest)
 	InspectCommitInTransaction(*txncontext.TransactionContext, *pfs_client.InspectCommitRequest) (*pfs_client.CommitInfo, error)
 
 	InspectCommitSetInTransaction(*txncontext.TransactionContext, *pfs_client.CommitSet) ([]*pfs_client.CommitInfo, error)
@@ -27,6 +33,8 @@ type APIServer interface {
 	CreateBranchInTransaction(*txncontext.TransactionContext, *pfs_client.CreateBranchRequest) error
 	InspectBranchInTransaction(*txncontext.TransactionContext, *pfs_client.InspectBranchRequest) (*pfs_client.BranchInfo, error)
 	DeleteBranchInTransaction(*txncontext.TransactionContext, *pfs_client.DeleteBranchRequest) error
+	RenameBranchInTransaction(*txncontext.TransactionContext, *pfs_client.RenameBranchRequest) error
+	RunBranchesInTransaction(*txncontext.TransactionContext, []*pfs_client.Branch, []*pfs_client.Commit) error
 
 	AddFileSetInTransaction(*txncontext.TransactionContext, *pfs_client.AddFileSetRequest) error
 }
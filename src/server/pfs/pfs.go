@@ -106,6 +106,32 @@ type ErrCommitOnOutputBranch struct {
 	Branch *pfs.Branch
 }
 
+// ErrQuotaNotFound represents a quota-not-found error.
+type ErrQuotaNotFound struct {
+	Repo      *pfs.Repo
+	Principal string
+}
+
+// ErrQuotaExists represents a quota-exists error.
+type ErrQuotaExists struct {
+	Quota *pfs.Quota
+}
+
+// ErrQuotaExceeded represents an error where a write to a repo would exceed
+// one of its quotas.
+type ErrQuotaExceeded struct {
+	Quota     *pfs.Quota
+	SizeBytes int64
+	FileCount int64
+}
+
+// ErrBranchProtected represents an error where an operation is forbidden by
+// the protection of a branch.
+type ErrBranchProtected struct {
+	Branch *pfs.Branch
+	Reason string
+}
+
 func (e ErrFileNotFound) Error() string {
 	return fmt.Sprintf("file %v not found in repo %v at commit %v", e.File.Path, e.File.Commit.Branch.Repo, e.File.Commit.ID)
 }
@@ -170,6 +196,36 @@ func (e ErrCommitOnOutputBranch) Error() string {
 	return fmt.Sprintf("cannot start a commit on an output branch: %s", e.Branch)
 }
 
+func (e ErrQuotaNotFound) Error() string {
+	return fmt.Sprintf("quota on repo %v not found%s", e.Repo, principalSuffix(e.Principal))
+}
+
+func (e ErrQuotaExists) Error() string {
+	return fmt.Sprintf("quota on repo %v already exists%s", e.Quota.Repo, principalSuffix(e.Quota.Principal))
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	var limits []string
+	if e.Quota.SizeBytes > 0 && e.SizeBytes > e.Quota.SizeBytes {
+		limits = append(limits, fmt.Sprintf("the commit would contain up to %d bytes, the limit is %d bytes", e.SizeBytes, e.Quota.SizeBytes))
+	}
+	if e.Quota.FileCount > 0 && e.FileCount > e.Quota.FileCount {
+		limits = append(limits, fmt.Sprintf("the commit would contain %d files, the limit is %d files", e.FileCount, e.Quota.FileCount))
+	}
+	return fmt.Sprintf("quota exceeded on repo %v%s: %s", e.Quota.Repo, principalSuffix(e.Quota.Principal), strings.Join(limits, "; "))
+}
+
+func (e ErrBranchProtected) Error() string {
+	return fmt.Sprintf("branch %v is protected: %s", e.Branch, e.Reason)
+}
+
+func principalSuffix(principal string) string {
+	if principal == "" {
+		return ""
+	}
+	return fmt.Sprintf(" for principal %q", principal)
+}
+
 var (
 	commitNotFoundRe          = regexp.MustCompile("commit [^ ]+ not found in repo [^ ]+")
 	commitsetNotFoundRe       = regexp.MustCompile("no commits found for commitset")
@@ -185,6 +241,9 @@ var (
 	ambiguousCommitRe         = regexp.MustCompile("commit .+ is ambiguous")
 	inconsistentCommitRe      = regexp.MustCompile("branch already has a commit in this transaction")
 	commitOnOutputBranchRe    = regexp.MustCompile("cannot start a commit on an output branch")
+	quotaNotFoundRe           = regexp.MustCompile("quota on repo [^ ]+ not found")
+	quotaExceededRe           = regexp.MustCompile("quota exceeded on repo")
+	branchProtectedRe         = regexp.MustCompile("branch [^ ]+ is protected")
 )
 
 // IsCommitNotFoundErr returns true if 'err' has an error message that matches
@@ -319,3 +378,30 @@ func IsCommitOnOutputBranchErr(err error) bool {
 	}
 	return commitOnOutputBranchRe.MatchString(err.Error())
 }
+
+// IsQuotaNotFoundErr returns true if 'err' is an error message about a quota
+// not being found
+func IsQuotaNotFoundErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return quotaNotFoundRe.MatchString(err.Error())
+}
+
+// IsQuotaExceededErr returns true if the err is due to a write that would
+// exceed a repo's quota.
+func IsQuotaExceededErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return quotaExceededRe.MatchString(err.Error())
+}
+
+// IsBranchProtectedErr returns true if the err is due to an operation
+// forbidden by the protection of a branch.
+func IsBranchProtectedErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return branchProtectedRe.MatchString(err.Error())
+}
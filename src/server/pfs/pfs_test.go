@@ -5,6 +5,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
 func TestErrorMatching(t *testing.T) {
@@ -21,3 +22,13 @@ func TestErrorMatching(t *testing.T) {
 	require.False(t, IsCommitFinishedErr(ErrCommitDeleted{c}))
 	require.True(t, IsCommitFinishedErr(ErrCommitFinished{c}))
 }
+
+func TestErrQuotaExceeded(t *testing.T) {
+	quota := &pfs.Quota{Repo: client.NewRepo("foo"), SizeBytes: 100, FileCount: 10}
+	err := ErrQuotaExceeded{Quota: quota, SizeBytes: 200, FileCount: 5}
+	require.True(t, IsQuotaExceededErr(err))
+	require.Equal(t, "quota exceeded on repo foo: the commit would contain up to 200 bytes, the limit is 100 bytes", err.Error())
+	err = ErrQuotaExceeded{Quota: quota, SizeBytes: 200, FileCount: 20}
+	require.Equal(t, "quota exceeded on repo foo: the commit would contain up to 200 bytes, the limit is 100 bytes; the commit would contain 20 files, the limit is 10 files", err.Error())
+	require.False(t, IsQuotaExceededErr(ErrQuotaExists{Quota: quota}))
+}
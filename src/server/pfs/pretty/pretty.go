@@ -5,6 +5,7 @@ import (
 	"html/template"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
 	units "github.com/docker/go-units"
@@ -16,11 +17,11 @@ import (
 
 const (
 	// RepoHeader is the header for repos.
-	RepoHeader = "NAME\tCREATED\tSIZE (MASTER)\tDESCRIPTION\t\n"
+	RepoHeader = "NAME\tCREATED\tSIZE (MASTER)\tDESCRIPTION\tMETADATA\t\n"
 	// RepoAuthHeader is the header for repos with auth information attached.
-	RepoAuthHeader = "NAME\tCREATED\tSIZE (MASTER)\tACCESS LEVEL\t\n"
+	RepoAuthHeader = "NAME\tCREATED\tSIZE (MASTER)\tACCESS LEVEL\tDESCRIPTION\tMETADATA\t\n"
 	// CommitHeader is the header for commits.
-	CommitHeader = "REPO\tBRANCH\tCOMMIT\tFINISHED\tSIZE\tORIGIN\tDESCRIPTION\n"
+	CommitHeader = "REPO\tBRANCH\tCOMMIT\tFINISHED\tSIZE\tORIGIN\tDESCRIPTION\tMETADATA\n"
 	// CommitSetHeader is the header for commitsets.
 	CommitSetHeader = "ID\tCOMMITS\tPROGRESS\tCREATED\tMODIFIED\n"
 	// BranchHeader is the header for branches.
@@ -31,6 +32,13 @@ const (
 	FileHeaderWithCommit = "COMMIT\tNAME\tTAG\tTYPE\tCOMMITTED\tSIZE\t\n"
 	// DiffFileHeader is the header for files produced by diff file.
 	DiffFileHeader = "OP\t" + FileHeader
+	// DiffFileSummaryHeader is the header for the summary produced by diff
+	// file.
+	DiffFileSummaryHeader = "ADDED\tMODIFIED\tDELETED\t\n"
+	// QuotaHeader is the header for quotas.
+	QuotaHeader = "REPO\tPRINCIPAL\tSIZE\tFILES\t\n"
+	// MergeConflictHeader is the header for merge conflicts.
+	MergeConflictHeader = "PATH\tOURS\tTHEIRS\t\n"
 )
 
 // PrintRepoInfo pretty-prints repo info.
@@ -50,6 +58,7 @@ func PrintRepoInfo(w io.Writer, repoInfo *pfs.RepoInfo, fullTimestamps bool) {
 		fmt.Fprintf(w, "%s\t", repoInfo.AuthInfo.Roles)
 	}
 	fmt.Fprintf(w, "%s\t", repoInfo.Description)
+	fmt.Fprintf(w, "%s\t", printMetadata(repoInfo.Metadata))
 	fmt.Fprintln(w)
 }
 
@@ -71,11 +80,14 @@ func NewPrintableRepoInfo(ri *pfs.RepoInfo) *PrintableRepoInfo {
 func PrintDetailedRepoInfo(repoInfo *PrintableRepoInfo) error {
 	template, err := template.New("RepoInfo").Funcs(funcMap).Parse(
 		`Name: {{.Repo.Name}}{{if .Description}}
-Description: {{.Description}}{{end}}{{if .FullTimestamps}}
+Description: {{.Description}}{{end}}{{if .ForkedFrom}}
+Forked from: {{.ForkedFrom.Name}}{{end}}{{if .Metadata}}
+Metadata: {{printMetadata .Metadata}}{{end}}{{if .FullTimestamps}}
 Created: {{.Created}}{{else}}
 Created: {{prettyAgo .Created}}{{end}}
 Size of HEAD on master: {{prettySize .Details.SizeBytes}}{{if .AuthInfo}}
-Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}
+Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}{{range .Details.Quotas}}
+Quota{{if .Quota.Principal}} for {{.Quota.Principal}}{{end}}: {{printQuotaUsage .}}{{end}}
 `)
 	if err != nil {
 		return err
@@ -87,6 +99,38 @@ Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}
 	return nil
 }
 
+// PrintQuotaInfo pretty-prints quota info.
+func PrintQuotaInfo(w io.Writer, quotaInfo *pfs.QuotaInfo) {
+	fmt.Fprintf(w, "%s\t", quotaInfo.Quota.Repo)
+	if quotaInfo.Quota.Principal != "" {
+		fmt.Fprintf(w, "%s\t", quotaInfo.Quota.Principal)
+	} else {
+		fmt.Fprintf(w, "-\t")
+	}
+	fmt.Fprintf(w, "%s\t", printSizeUsage(quotaInfo))
+	fmt.Fprintf(w, "%s\t", printFileCountUsage(quotaInfo))
+	fmt.Fprintln(w)
+}
+
+func printSizeUsage(quotaInfo *pfs.QuotaInfo) string {
+	used := units.BytesSize(float64(quotaInfo.SizeBytesUpperBound))
+	if quotaInfo.Quota.SizeBytes == 0 {
+		return fmt.Sprintf("<= %s", used)
+	}
+	return fmt.Sprintf("<= %s / %s", used, units.BytesSize(float64(quotaInfo.Quota.SizeBytes)))
+}
+
+func printFileCountUsage(quotaInfo *pfs.QuotaInfo) string {
+	if quotaInfo.Quota.FileCount == 0 {
+		return fmt.Sprintf("%d", quotaInfo.FileCount)
+	}
+	return fmt.Sprintf("%d / %d", quotaInfo.FileCount, quotaInfo.Quota.FileCount)
+}
+
+func printQuotaUsage(quotaInfo *pfs.QuotaInfo) string {
+	return fmt.Sprintf("%s, %s files", printSizeUsage(quotaInfo), printFileCountUsage(quotaInfo))
+}
+
 func printTrigger(trigger *pfs.Trigger) string {
 	var conds []string
 	if trigger.CronSpec != "" {
@@ -98,6 +142,12 @@ func printTrigger(trigger *pfs.Trigger) string {
 	if trigger.Commits != 0 {
 		conds = append(conds, fmt.Sprintf("Commits(%d)", trigger.Commits))
 	}
+	if trigger.Glob != "" {
+		conds = append(conds, fmt.Sprintf("Glob(%s)", trigger.Glob))
+	}
+	if trigger.Description != "" {
+		conds = append(conds, fmt.Sprintf("Description(%s)", trigger.Description))
+	}
 	cond := ""
 	if trigger.All {
 		cond = strings.Join(conds, " and ")
@@ -107,6 +157,33 @@ func printTrigger(trigger *pfs.Trigger) string {
 	return fmt.Sprintf("%s on %s", trigger.Branch, cond)
 }
 
+func printProtection(protection *pfs.BranchProtection) string {
+	var rules []string
+	if len(protection.Principals) > 0 {
+		rules = append(rules, fmt.Sprintf("Principals(%s)", strings.Join(protection.Principals, ", ")))
+	}
+	if protection.StagingBranch != "" {
+		rules = append(rules, fmt.Sprintf("Staging(%s)", protection.StagingBranch))
+	}
+	if protection.NoDelete {
+		rules = append(rules, "NoDelete")
+	}
+	if protection.NoForce {
+		rules = append(rules, "NoForce")
+	}
+	return strings.Join(rules, ", ")
+}
+
+// printMetadata renders metadata as its key=value pairs, sorted by key.
+func printMetadata(metadata map[string]string) string {
+	var pairs []string
+	for key, value := range metadata {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
+
 // PrintBranch pretty-prints a Branch.
 func PrintBranch(w io.Writer, branchInfo *pfs.BranchInfo) {
 	fmt.Fprintf(w, "%s\t", branchInfo.Branch.Name)
@@ -129,7 +206,9 @@ func PrintDetailedBranchInfo(branchInfo *pfs.BranchInfo) error {
 		`Name: {{.Branch.Repo.Name}}@{{.Branch.Name}}{{if .Head}}
 Head Commit: {{ .Head.Branch.Repo.Name}}@{{.Head.ID}} {{end}}{{if .Provenance}}
 Provenance: {{range .Provenance}} {{.Repo.Name}}@{{.Name}} {{end}} {{end}}{{if .Trigger}}
-Trigger: {{printTrigger .Trigger}} {{end}}
+Trigger: {{printTrigger .Trigger}} {{end}}{{if .Protection}}
+Protection: {{printProtection .Protection}} {{end}}{{if .Metadata}}
+Metadata: {{printMetadata .Metadata}} {{end}}
 `)
 	if err != nil {
 		return err
@@ -162,6 +241,7 @@ func PrintCommitInfo(w io.Writer, commitInfo *pfs.CommitInfo, fullTimestamps boo
 	}
 	fmt.Fprintf(w, "%v\t", commitInfo.Origin.Kind)
 	fmt.Fprintf(w, "%s\t", commitInfo.Description)
+	fmt.Fprintf(w, "%s\t", printMetadata(commitInfo.Metadata))
 	fmt.Fprintln(w)
 }
 
@@ -237,8 +317,12 @@ func PrintDetailedCommitInfo(w io.Writer, commitInfo *PrintableCommitInfo) error
 	template, err := template.New("CommitInfo").Funcs(funcMap).Parse(
 		`Commit: {{.Commit.Branch.Repo.Name}}@{{.Commit.ID}}
 Original Branch: {{.Commit.Branch.Name}}{{if .Description}}
-Description: {{.Description}}{{end}}{{if .ParentCommit}}
-Parent: {{.ParentCommit.ID}}{{end}}{{if .FullTimestamps}}
+Description: {{.Description}}{{end}}{{if .Metadata}}
+Metadata: {{printMetadata .Metadata}}{{end}}{{if .ParentCommit}}
+Parent: {{.ParentCommit.ID}}{{end}}{{with .Origin}}{{if .Reverted}}
+Reverts: {{.Reverted.Branch.Repo.Name}}@{{.Reverted.ID}}{{end}}{{if .CherryPicked}}
+Cherry-picked from: {{.CherryPicked.Branch.Repo.Name}}@{{.CherryPicked.ID}}{{end}}{{if .Forked}}
+Forked from: {{.Forked.Branch.Repo.Name}}@{{.Forked.ID}}{{end}}{{end}}{{if .FullTimestamps}}
 Started: {{.Started}}{{else}}
 Started: {{prettyAgo .Started}}{{end}}{{if .Finished}}{{if .FullTimestamps}}
 Finished: {{.Finished}}{{else}}
@@ -288,13 +372,43 @@ func PrintDiffFileInfo(w io.Writer, added bool, fileInfo *pfs.FileInfo, fullTime
 	PrintFileInfo(w, fileInfo, fullTimestamps, false)
 }
 
+// PrintDiffFileSummary pretty-prints a summary from diff file.
+func PrintDiffFileSummary(w io.Writer, summary *pfs.DiffFileSummary) {
+	fmt.Fprintf(w, "%d (+%s)\t", summary.Added, units.BytesSize(float64(summary.AddedBytes)))
+	delta := "+" + units.BytesSize(float64(summary.ModifiedBytesDelta))
+	if summary.ModifiedBytesDelta < 0 {
+		delta = "-" + units.BytesSize(float64(-summary.ModifiedBytesDelta))
+	}
+	fmt.Fprintf(w, "%d (%s)\t", summary.Modified, delta)
+	fmt.Fprintf(w, "%d (-%s)\t\n", summary.Deleted, units.BytesSize(float64(summary.DeletedBytes)))
+}
+
+// PrintMergeConflict pretty-prints a merge conflict.
+func PrintMergeConflict(w io.Writer, conflict *pfs.MergeConflict) {
+	fmt.Fprintf(w, "%s\t", conflict.Path)
+	fmt.Fprintf(w, "%s\t", printMergeChange(conflict.Base, conflict.Ours))
+	fmt.Fprintf(w, "%s\t\n", printMergeChange(conflict.Base, conflict.Theirs))
+}
+
+func printMergeChange(base, fileInfo *pfs.FileInfo) string {
+	switch {
+	case fileInfo == nil:
+		return "deleted"
+	case base == nil:
+		return fmt.Sprintf("added (%s)", units.BytesSize(float64(fileInfo.SizeBytes)))
+	default:
+		return fmt.Sprintf("modified (%s)", units.BytesSize(float64(fileInfo.SizeBytes)))
+	}
+}
+
 // PrintDetailedFileInfo pretty-prints detailed file info.
 func PrintDetailedFileInfo(fileInfo *pfs.FileInfo) error {
 	template, err := template.New("FileInfo").Funcs(funcMap).Parse(
 		`Path: {{.File.Path}}
 Tag: {{.File.Tag}}
 Type: {{fileType .FileType}}
-Size: {{prettySize .SizeBytes}}
+Size: {{prettySize .SizeBytes}}{{if .Attributes}}
+Attributes: {{printMetadata .Attributes}}{{end}}
 `)
 	if err != nil {
 		return err
@@ -314,6 +428,10 @@ var funcMap = template.FuncMap{
 	"prettySize":   pretty.Size,
 	"fileType":     fileType,
 	"printTrigger": printTrigger,
+
+	"printQuotaUsage": printQuotaUsage,
+	"printProtection": printProtection,
+	"printMetadata":   printMetadata,
 }
 
 // CompactPrintCommit renders 'c' as a compact string, e.g.
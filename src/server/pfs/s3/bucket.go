@@ -8,6 +8,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	glob "github.com/pachyderm/ohmyglob"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -32,6 +33,24 @@ func newContents(fileInfo *pfsClient.FileInfo) (s2.Contents, error) {
 	}, nil
 }
 
+func newVersion(fileInfo *pfsClient.FileInfo, version string, isLatest bool) (s2.Version, error) {
+	t, err := types.TimestampFromProto(fileInfo.Committed)
+	if err != nil {
+		return s2.Version{}, err
+	}
+
+	return s2.Version{
+		Key:          fileInfo.File.Path,
+		Version:      version,
+		IsLatest:     isLatest,
+		LastModified: t,
+		ETag:         fmt.Sprintf("%x", fileInfo.Hash),
+		Size:         uint64(fileInfo.SizeBytes),
+		StorageClass: globalStorageClass,
+		Owner:        defaultUser,
+	}, nil
+}
+
 func (c *controller) GetLocation(r *http.Request, bucketName string) (string, error) {
 	c.logger.Debugf("GetLocation: %+v", bucketName)
 
@@ -96,7 +115,7 @@ func (c *controller) ListObjects(r *http.Request, bucketName, prefix, marker, de
 		pattern = fmt.Sprintf("%s*", glob.QuoteMeta(prefix))
 	}
 
-	err = pc.GlobFile(bucket.Commit, pattern, func(fileInfo *pfsClient.FileInfo) error {
+	add := func(fileInfo *pfsClient.FileInfo) error {
 		if fileInfo.FileType == pfsClient.FileType_DIR {
 			if fileInfo.File.Path == "/" {
 				// skip the root directory
@@ -141,9 +160,32 @@ func (c *controller) ListObjects(r *http.Request, bucketName, prefix, marker, de
 		}
 
 		return nil
-	})
+	}
 
-	return &result, err
+	// Files are listed in pages of at most one more than the number of keys
+	// (some of which may be skipped), starting after the marker.
+	var continuation string
+	if marker != "" {
+		continuation = pfsClient.EncodeContinuation(pfsClient.FileContinuation, "/"+marker+"\x00")
+	}
+	for {
+		fileInfos, next, err := pc.GlobFilePage(bucket.Commit, pattern, int64(maxKeys)+1, continuation)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileInfo := range fileInfos {
+			if err := add(fileInfo); err != nil {
+				if errors.Is(err, errutil.ErrBreak) {
+					return &result, nil
+				}
+				return nil, err
+			}
+		}
+		if next == "" {
+			return &result, nil
+		}
+		continuation = next
+	}
 }
 
 func (c *controller) CreateBucket(r *http.Request, bucketName string) error {
@@ -258,12 +300,147 @@ func (c *controller) DeleteBucket(r *http.Request, bucketName string) error {
 }
 
 func (c *controller) ListObjectVersions(r *http.Request, bucketName, prefix, keyMarker, versionIDMarker string, delimiter string, maxKeys int) (*s2.ListObjectVersionsResult, error) {
-	// NOTE: because this endpoint isn't implemented, conformance tests will
-	// fail on teardown. It's nevertheless unimplemented because it's too
-	// expensive to pull off with PFS until this is implemented:
-	// https://github.com/pachyderm/pachyderm/v2/issues/3896
 	c.logger.Debugf("ListObjectVersions: bucketName=%+v, prefix=%+v, keyMarker=%+v, versionIDMarker=%+v, delimiter=%+v, maxKeys=%+v", bucketName, prefix, keyMarker, versionIDMarker, delimiter, maxKeys)
-	return nil, s2.NotImplementedError(r)
+
+	// Strip / from prefix to normalize: "/" means "all objects" and "/foo"
+	// means the same as "foo"
+	prefix = strings.TrimPrefix(prefix, "/")
+
+	pc, err := c.requestClient(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if delimiter != "" && delimiter != "/" {
+		return nil, invalidDelimiterError(r)
+	}
+
+	bucket, err := c.driver.bucket(pc, r, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	bucketCaps, err := c.driver.bucketCapabilities(pc, r, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s2.ListObjectVersionsResult{
+		Versions:      []*s2.Version{},
+		DeleteMarkers: []*s2.DeleteMarker{},
+	}
+
+	if !bucketCaps.readable {
+		// serve empty results if we can't read the bucket; this helps with s3
+		// conformance
+		return &result, nil
+	}
+
+	// An object's versions are the commits in which the file changed, found
+	// by walking back through the commits' diff filesets. Buckets without
+	// historic versions only list the current version of each object. Objects
+	// that no longer exist at the head of the bucket aren't listed, so no
+	// delete markers are returned. Objects are listed in pages starting at the
+	// key marker, and their versions are walked one object at a time, so only
+	// the history of the objects which are returned is walked.
+	recursive := delimiter == ""
+	var pattern string
+	if recursive {
+		pattern = fmt.Sprintf("%s**", glob.QuoteMeta(prefix))
+	} else {
+		pattern = fmt.Sprintf("%s*", glob.QuoteMeta(prefix))
+	}
+
+	add := func(fileInfo *pfsClient.FileInfo, key, version string, isLatest bool) error {
+		if len(result.Versions) >= maxKeys {
+			if maxKeys > 0 {
+				result.IsTruncated = true
+			}
+			return errutil.ErrBreak
+		}
+		fileInfo.File.Path = key
+		v, err := newVersion(fileInfo, version, isLatest)
+		if err != nil {
+			return err
+		}
+		result.Versions = append(result.Versions, &v)
+		return nil
+	}
+
+	addVersions := func(fileInfo *pfsClient.FileInfo) error {
+		if fileInfo.FileType != pfsClient.FileType_FILE {
+			return nil
+		}
+		key := fileInfo.File.Path[1:] // strip leading slash
+		// The versions of the key marker are skipped up to and including the
+		// version marker, or entirely if there is no version marker.
+		var skipTo string
+		if key == keyMarker {
+			if versionIDMarker == "" {
+				return nil
+			}
+			skipTo = versionIDMarker
+		}
+		if !bucketCaps.historicVersions {
+			if skipTo != "" {
+				return nil
+			}
+			return add(fileInfo, key, "", true)
+		}
+		// Versions are walked newest first, so the first version is the
+		// latest.
+		isLatest, skipping, full := true, skipTo != "", false
+		if err := pc.WalkFileHistory(bucket.Commit, fileInfo.File.Path, -1, func(fileInfo *pfsClient.FileInfo) error {
+			if fileInfo.FileType != pfsClient.FileType_FILE {
+				return nil
+			}
+			version := fileInfo.File.Commit.ID
+			latest := isLatest
+			isLatest = false
+			if skipping {
+				if version == skipTo {
+					skipping = false
+				}
+				return nil
+			}
+			if err := add(fileInfo, key, version, latest); err != nil {
+				full = errors.Is(err, errutil.ErrBreak)
+				return err
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if full {
+			return errutil.ErrBreak
+		}
+		return nil
+	}
+
+	var continuation string
+	if keyMarker != "" {
+		continuation = pfsClient.EncodeContinuation(pfsClient.FileContinuation, "/"+keyMarker)
+	}
+	for {
+		fileInfos, next, err := pc.GlobFilePage(bucket.Commit, pattern, int64(maxKeys)+1, continuation)
+		if err != nil {
+			return nil, maybeNotFoundError(r, err)
+		}
+		for _, fileInfo := range fileInfos {
+			if err := addVersions(fileInfo); err != nil {
+				if errors.Is(err, errutil.ErrBreak) {
+					return &result, nil
+				}
+				if pfsServer.IsFileNotFoundErr(err) {
+					continue
+				}
+				return nil, maybeNotFoundError(r, err)
+			}
+		}
+		if next == "" {
+			return &result, nil
+		}
+		continuation = next
+	}
 }
 
 func (c *controller) GetBucketVersioning(r *http.Request, bucketName string) (string, error) {
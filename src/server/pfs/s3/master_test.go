@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"testing"
@@ -15,6 +17,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
 	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
@@ -112,6 +115,29 @@ func masterStatObject(t *testing.T, pachClient *client.APIClient, minioClient *m
 	require.Equal(t, int64(11), info.Size)
 }
 
+func masterGetObjectVersion(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
+	repo := tu.UniqueString("testgetobjectversion")
+	require.NoError(t, pachClient.CreateRepo(repo))
+	commit := client.NewCommit(repo, "master", "")
+	require.NoError(t, pachClient.PutFile(commit, "file", strings.NewReader("content1")))
+	commitInfo1, err := pachClient.InspectCommit(repo, "master", "")
+	require.NoError(t, err)
+	require.NoError(t, pachClient.PutFile(commit, "file", strings.NewReader("content2")))
+
+	bucket := fmt.Sprintf("master.%s", repo)
+	status, content := getObjectVersion(t, minioClient, bucket, "file", commitInfo1.Commit.ID)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "content1", content)
+	fetchedContent, err := getObject(t, minioClient, bucket, "file")
+	require.NoError(t, err)
+	require.Equal(t, "content2", fetchedContent)
+
+	status, _ = getObjectVersion(t, minioClient, bucket, "file", uuid.NewWithoutDashes())
+	require.Equal(t, http.StatusNotFound, status)
+	status, _ = getObjectVersion(t, minioClient, bucket, "file", "master")
+	require.Equal(t, http.StatusNotFound, status)
+}
+
 func masterPutObject(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
 	repo := tu.UniqueString("testputobject")
 	require.NoError(t, pachClient.CreateRepo(repo))
@@ -131,6 +157,34 @@ func masterPutObject(t *testing.T, pachClient *client.APIClient, minioClient *mi
 	require.Equal(t, "content2", fetchedContent)
 }
 
+func masterPutObjectAttributes(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
+	repo := tu.UniqueString("testputobjectattributes")
+	require.NoError(t, pachClient.CreateRepo(repo))
+	bucket := fmt.Sprintf("master.%s", repo)
+
+	r := strings.NewReader("content")
+	_, err := minioClient.PutObject(bucket, "file", r, int64(r.Len()), minio.PutObjectOptions{
+		ContentType:  "application/json",
+		UserMetadata: map[string]string{"Label": "cat"},
+	})
+	require.NoError(t, err)
+
+	fileInfo, err := pachClient.InspectFile(client.NewCommit(repo, "master", ""), "file")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"content-type": "application/json", "label": "cat"}, fileInfo.Attributes)
+	info, err := minioClient.StatObject(bucket, "file", minio.StatObjectOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "application/json", info.ContentType)
+	require.Equal(t, "cat", info.Metadata.Get("X-Amz-Meta-Label"))
+
+	// Attributes set with PFS are returned as user defined metadata
+	require.NoError(t, pachClient.PutFile(client.NewCommit(repo, "master", ""), "file2", strings.NewReader("content"),
+		client.WithAttributesPutFile(map[string]string{"owner": "alice"})))
+	info, err = minioClient.StatObject(bucket, "file2", minio.StatObjectOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "alice", info.Metadata.Get("X-Amz-Meta-Owner"))
+}
+
 func masterRemoveObject(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
 	repo := tu.UniqueString("testremoveobject")
 	require.NoError(t, pachClient.CreateRepo(repo))
@@ -386,6 +440,76 @@ func masterListObjectsPaginated(t *testing.T, pachClient *client.APIClient, mini
 	checkListObjects(t, ch, &startTime, &endTime, expectedFiles, []string{})
 }
 
+func masterListObjectVersions(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
+	repo := tu.UniqueString("testlistobjectversions")
+	require.NoError(t, pachClient.CreateRepo(repo))
+	commit := client.NewCommit(repo, "master", "")
+
+	// file1 has three versions, and file2 and dir/file3 have one each
+	var commitIDs []string
+	for i := 0; i < 3; i++ {
+		require.NoError(t, pachClient.PutFile(commit, "file1", strings.NewReader(fmt.Sprintf("content%d", i))))
+		commitInfo, err := pachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		commitIDs = append(commitIDs, commitInfo.Commit.ID)
+	}
+	require.NoError(t, pachClient.PutFile(commit, "file2", strings.NewReader("content")))
+	require.NoError(t, pachClient.PutFile(commit, "dir/file3", strings.NewReader("content")))
+
+	bucket := fmt.Sprintf("master.%s", repo)
+	result := listObjectVersions(t, minioClient, bucket, url.Values{})
+	require.False(t, result.IsTruncated)
+	require.Equal(t, 5, len(result.Versions))
+	require.Equal(t, "dir/file3", result.Versions[0].Key)
+	for i, v := range result.Versions[1:4] {
+		require.Equal(t, "file1", v.Key)
+		require.Equal(t, commitIDs[2-i], v.Version)
+		require.Equal(t, i == 0, v.IsLatest)
+		require.Equal(t, int64(8), v.Size)
+	}
+	require.Equal(t, "file2", result.Versions[4].Key)
+	require.True(t, result.Versions[4].IsLatest)
+
+	// delimiters exclude objects in subdirectories
+	result = listObjectVersions(t, minioClient, bucket, url.Values{"delimiter": []string{"/"}})
+	require.Equal(t, 4, len(result.Versions))
+	result = listObjectVersions(t, minioClient, bucket, url.Values{"prefix": []string{"dir/"}})
+	require.Equal(t, 1, len(result.Versions))
+	require.Equal(t, "dir/file3", result.Versions[0].Key)
+
+	// paginate through file1's versions with key and version markers
+	result = listObjectVersions(t, minioClient, bucket, url.Values{
+		"prefix":   []string{"file"},
+		"max-keys": []string{"2"},
+	})
+	require.True(t, result.IsTruncated)
+	require.Equal(t, 2, len(result.Versions))
+	require.Equal(t, commitIDs[1], result.Versions[1].Version)
+	result = listObjectVersions(t, minioClient, bucket, url.Values{
+		"prefix":            []string{"file"},
+		"key-marker":        []string{"file1"},
+		"version-id-marker": []string{commitIDs[1]},
+	})
+	require.False(t, result.IsTruncated)
+	require.Equal(t, 2, len(result.Versions))
+	require.Equal(t, commitIDs[0], result.Versions[0].Version)
+	require.Equal(t, "file2", result.Versions[1].Key)
+	result = listObjectVersions(t, minioClient, bucket, url.Values{
+		"key-marker":        []string{"file1"},
+		"version-id-marker": []string{commitIDs[2]},
+		"max-keys":          []string{"1"},
+	})
+	require.True(t, result.IsTruncated)
+	require.Equal(t, 1, len(result.Versions))
+	require.Equal(t, commitIDs[1], result.Versions[0].Version)
+	require.False(t, result.Versions[0].IsLatest)
+
+	// a key marker without a version marker skips all of the key's versions
+	result = listObjectVersions(t, minioClient, bucket, url.Values{"key-marker": []string{"file1"}})
+	require.Equal(t, 1, len(result.Versions))
+	require.Equal(t, "file2", result.Versions[0].Key)
+}
+
 func masterListObjectsHeadlessBranch(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
 	repo := tu.UniqueString("testlistobjectsheadlessbranch")
 	require.NoError(t, pachClient.CreateRepo(repo))
@@ -544,12 +668,18 @@ func TestMasterDriver(t *testing.T) {
 		t.Run("GetObjectInBranch", func(t *testing.T) {
 			masterGetObjectInBranch(t, pachClient, minioClient)
 		})
+		t.Run("GetObjectVersion", func(t *testing.T) {
+			masterGetObjectVersion(t, pachClient, minioClient)
+		})
 		t.Run("StatObject", func(t *testing.T) {
 			masterStatObject(t, pachClient, minioClient)
 		})
 		t.Run("PutObject", func(t *testing.T) {
 			masterPutObject(t, pachClient, minioClient)
 		})
+		t.Run("PutObjectAttributes", func(t *testing.T) {
+			masterPutObjectAttributes(t, pachClient, minioClient)
+		})
 		t.Run("RemoveObject", func(t *testing.T) {
 			masterRemoveObject(t, pachClient, minioClient)
 		})
@@ -592,6 +722,9 @@ func TestMasterDriver(t *testing.T) {
 		t.Run("ListObjectsPaginated", func(t *testing.T) {
 			masterListObjectsPaginated(t, pachClient, minioClient)
 		})
+		t.Run("ListObjectVersions", func(t *testing.T) {
+			masterListObjectVersions(t, pachClient, minioClient)
+		})
 		t.Run("ListObjectsHeadlessBranch", func(t *testing.T) {
 			masterListObjectsHeadlessBranch(t, pachClient, minioClient)
 		})
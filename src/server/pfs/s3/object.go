@@ -7,11 +7,53 @@ import (
 	"strings"
 
 	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	pfsServer "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/pachyderm/s2"
 )
 
+const (
+	// contentTypeAttribute is the file attribute holding an object's
+	// Content-Type.
+	contentTypeAttribute = "content-type"
+	// userMetadataPrefix is the prefix of the headers holding an object's user
+	// defined metadata, which is stored in file attributes without the prefix.
+	userMetadataPrefix = "X-Amz-Meta-"
+)
+
+// objectAttributes returns the file attributes for the Content-Type and user
+// defined metadata headers of a request.
+func objectAttributes(header http.Header) map[string]string {
+	attributes := make(map[string]string)
+	if contentType := header.Get("Content-Type"); contentType != "" {
+		attributes[contentTypeAttribute] = contentType
+	}
+	for key, values := range header {
+		if strings.HasPrefix(key, userMetadataPrefix) && len(values) > 0 {
+			attributes[strings.ToLower(strings.TrimPrefix(key, userMetadataPrefix))] = values[0]
+		}
+	}
+	return attributes
+}
+
+// setObjectHeaders sets the Content-Type and user defined metadata headers of
+// the response to r from the file attributes.
+func setObjectHeaders(r *http.Request, attributes map[string]string) {
+	w, ok := r.Context().Value(responseWriterKey{}).(http.ResponseWriter)
+	if !ok {
+		return
+	}
+	for key, value := range attributes {
+		if key == contentTypeAttribute {
+			w.Header().Set("Content-Type", value)
+			continue
+		}
+		w.Header().Set(userMetadataPrefix+key, value)
+	}
+}
+
 func (c *controller) GetObject(r *http.Request, bucketName, file, version string) (*s2.GetObjectResult, error) {
 	c.logger.Debugf("GetObject: bucketName=%+v, file=%+v, version=%+v", bucketName, file, version)
 
@@ -36,16 +78,26 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 		return nil, s2.NoSuchKeyError(r)
 	}
 
+	commit := bucket.Commit
 	commitID := bucket.Commit.ID
 	if version != "" {
 		if !bucketCaps.historicVersions {
 			return nil, s2.NotImplementedError(r)
 		}
+		if !uuid.IsUUIDWithoutDashes(version) {
+			return nil, s2.NoSuchVersionError(r)
+		}
+		// Versions are commit IDs, which may belong to an ancestor on
+		// another branch, so the commit is resolved without a branch.
+		commit = bucket.Commit.Branch.Repo.NewCommit("", version)
 		commitID = version
 	}
 
-	fileInfo, err := pc.InspectFile(bucket.Commit, file)
+	fileInfo, err := pc.InspectFile(commit, file)
 	if err != nil {
+		if version != "" && pfsServer.IsCommitNotFoundErr(err) {
+			return nil, s2.NoSuchVersionError(r)
+		}
 		return nil, maybeNotFoundError(r, err)
 	}
 
@@ -54,10 +106,13 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 		return nil, err
 	}
 
-	content, err := pc.GetFileReadSeeker(bucket.Commit, file)
+	// Requests with a Range header seek the content to the start of the
+	// range, so only the chunks overlapping the range are read.
+	content, err := pc.GetFileReadSeeker(commit, file)
 	if err != nil {
 		return nil, err
 	}
+	setObjectHeaders(r, fileInfo.Attributes)
 
 	result := s2.GetObjectResult{
 		ModTime:      modTime,
@@ -149,7 +204,7 @@ func (c *controller) PutObject(r *http.Request, bucketName, file string, reader
 	}
 
 	bucketCommit := bucket.Commit
-	if err := pc.PutFile(bucketCommit, file, reader); err != nil {
+	if err := pc.PutFile(bucketCommit, file, reader, client.WithAttributesPutFile(objectAttributes(r.Header))); err != nil {
 		if errutil.IsWriteToOutputBranchError(err) {
 			return nil, writeToOutputBranchError(r)
 		} else if errutil.IsNotADirectoryError(err) {
@@ -1,6 +1,7 @@
 package s3
 
 import (
+	"context"
 	"fmt"
 	stdlog "log"
 	"net/http"
@@ -110,7 +111,20 @@ func Router(driver Driver, clientFactory ClientFactory) *mux.Router {
 	s3Server.Bucket = c
 	s3Server.Object = c
 	s3Server.Multipart = c
-	return s3Server.Router()
+	router := s3Server.Router()
+	router.Use(withResponseWriter)
+	return router
+}
+
+type responseWriterKey struct{}
+
+// withResponseWriter makes the response writer available to the controller
+// through the request context, so that it can set headers (e.g. an object's
+// Content-Type) that s2 has no way of returning.
+func withResponseWriter(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), responseWriterKey{}, w)))
+	})
 }
 
 // S3Server wraps an HTTP server with an S3-like API for PFS. This allows you to
@@ -3,10 +3,13 @@ package s3
 import (
 	"context"
 	"crypto/md5"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"sort"
@@ -34,6 +37,49 @@ func getObject(t *testing.T, minioClient *minio.Client, bucket, file string) (st
 	return string(bytes), err
 }
 
+// listVersionsResult is the subset of a ListObjectVersions response that the
+// tests check. minio-go doesn't support versioned requests, so they're made
+// directly.
+type listVersionsResult struct {
+	IsTruncated bool `xml:"IsTruncated"`
+	Versions    []struct {
+		Key      string `xml:"Key"`
+		Version  string `xml:"VersionId"`
+		IsLatest bool   `xml:"IsLatest"`
+		Size     int64  `xml:"Size"`
+	} `xml:"Version"`
+}
+
+func listObjectVersions(t *testing.T, minioClient *minio.Client, bucket string, params url.Values) *listVersionsResult {
+	t.Helper()
+
+	params.Set("versions", "")
+	u := *minioClient.EndpointURL()
+	u.Path = "/" + bucket + "/"
+	u.RawQuery = params.Encode()
+	resp, err := http.Get(u.String())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	result := &listVersionsResult{}
+	require.NoError(t, xml.NewDecoder(resp.Body).Decode(result))
+	return result
+}
+
+func getObjectVersion(t *testing.T, minioClient *minio.Client, bucket, file, version string) (int, string) {
+	t.Helper()
+
+	u := *minioClient.EndpointURL()
+	u.Path = "/" + bucket + "/" + file
+	u.RawQuery = url.Values{"versionId": []string{version}}.Encode()
+	resp, err := http.Get(u.String())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	bytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(bytes)
+}
+
 func checkListObjects(t *testing.T, ch <-chan minio.ObjectInfo, startTime *time.Time, endTime *time.Time, expectedFiles []string, expectedDirs []string) {
 	t.Helper()
 
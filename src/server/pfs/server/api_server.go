@@ -15,10 +15,12 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsload"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
@@ -78,7 +80,13 @@ func (a *apiServer) CreateRepoInTransaction(txnCtx *txncontext.TransactionContex
 	if repo := request.GetRepo(); repo != nil && repo.Name == fileSetsRepo {
 		return errors.Errorf("%s is a reserved name", fileSetsRepo)
 	}
-	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update)
+	if err := a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update); err != nil {
+		return err
+	}
+	if len(request.Metadata) > 0 {
+		return a.driver.setMetadata(txnCtx, &pfs.SetMetadataRequest{Repo: request.Repo, Metadata: request.Metadata})
+	}
+	return nil
 }
 
 // CreateRepo implements the protobuf pfs.CreateRepo RPC
@@ -121,6 +129,9 @@ func (a *apiServer) InspectRepo(ctx context.Context, request *pfs.InspectRepoReq
 		repoInfo.Details = &pfs.RepoInfo_Details{}
 	}
 	repoInfo.Details.SizeBytes = size
+	if repoInfo.Details.Quotas, err = a.driver.repoQuotaInfos(ctx, repoInfo.Repo); err != nil {
+		return nil, err
+	}
 	return repoInfo, nil
 }
 
@@ -128,7 +139,25 @@ func (a *apiServer) InspectRepo(ctx context.Context, request *pfs.InspectRepoReq
 func (a *apiServer) ListRepo(request *pfs.ListRepoRequest, srv pfs.API_ListRepoServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
-	return a.driver.listRepo(srv.Context(), true, request.Type, srv.Send)
+	after, err := pfs.DecodeContinuation(pfs.RepoContinuation, request.Continuation)
+	if err != nil {
+		return err
+	}
+	var sent int64
+	if err := a.driver.listRepo(srv.Context(), true, request.Type, request.Metadata, after, func(ri *pfs.RepoInfo) error {
+		sent++
+		if sent == request.PageSize {
+			ri.Continuation = pfs.EncodeContinuation(pfs.RepoContinuation, pfsdb.RepoKey(ri.Repo))
+			if err := srv.Send(ri); err != nil {
+				return err
+			}
+			return errutil.ErrBreak
+		}
+		return srv.Send(ri)
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return err
+	}
+	return nil
 }
 
 // DeleteRepoInTransaction is identical to DeleteRepo except that it can run
@@ -149,10 +178,59 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 	return &types.Empty{}, nil
 }
 
+// RenameRepoInTransaction is identical to RenameRepo except that it can run
+// inside an existing postgres transaction.  This is not an RPC.
+func (a *apiServer) RenameRepoInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.RenameRepoRequest) error {
+	return a.driver.renameRepo(txnCtx, request.Repo, request.NewName)
+}
+
+// RenameRepo implements the protobuf pfs.RenameRepo RPC
+func (a *apiServer) RenameRepo(ctx context.Context, request *pfs.RenameRepoRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.RenameRepo(request)
+	}, nil); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// ForkRepo implements the protobuf pfs.ForkRepo RPC
+func (a *apiServer) ForkRepo(ctx context.Context, request *pfs.ForkRepoRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.driver.forkRepo(ctx, request.Source, request.Destination, request.Branches, request.Description); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// SetMetadata implements the protobuf pfs.SetMetadata RPC
+func (a *apiServer) SetMetadata(ctx context.Context, request *pfs.SetMetadataRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.setMetadata(txnCtx, request)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 // StartCommitInTransaction is identical to StartCommit except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) StartCommitInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.StartCommitRequest) (*pfs.Commit, error) {
-	return a.driver.startCommit(txnCtx, request.Parent, request.Branch, request.Description)
+	commit, err := a.driver.startCommit(txnCtx, request.Parent, request.Branch, request.Description)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Metadata) > 0 {
+		if err := a.driver.setMetadata(txnCtx, &pfs.SetMetadataRequest{Commit: commit, Metadata: request.Metadata}); err != nil {
+			return nil, err
+		}
+	}
+	return commit, nil
 }
 
 // StartCommit implements the protobuf pfs.StartCommit RPC
@@ -174,14 +252,28 @@ func (a *apiServer) StartCommit(ctx context.Context, request *pfs.StartCommitReq
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) FinishCommitInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.FinishCommitRequest) error {
 	return metrics.ReportRequest(func() error {
+		if len(request.Metadata) > 0 {
+			if err := a.driver.setMetadata(txnCtx, &pfs.SetMetadataRequest{Commit: request.Commit, Metadata: request.Metadata}); err != nil {
+				return err
+			}
+		}
 		return a.driver.finishCommit(txnCtx, request.Commit, request.Description, request.Error, request.Force)
 	})
 }
 
+// PrepareFinishCommit computes the quota usage checked by
+// FinishCommitInTransaction. This is not an RPC.
+func (a *apiServer) PrepareFinishCommit(ctx context.Context, request *pfs.FinishCommitRequest) {
+	if !request.Error {
+		a.driver.precomputeCommitQuotas(ctx, request.Commit)
+	}
+}
+
 // FinishCommit implements the protobuf pfs.FinishCommit RPC
 func (a *apiServer) FinishCommit(ctx context.Context, request *pfs.FinishCommitRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	a.PrepareFinishCommit(ctx, request)
 	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
 		return txn.FinishCommit(request)
 	}, func(txnCtx *txncontext.TransactionContext) (string, error) {
@@ -223,8 +315,20 @@ func (a *apiServer) ListCommit(request *pfs.ListCommitRequest, respServer pfs.AP
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("stream containing %d commits", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.listCommit(respServer.Context(), request.Repo, request.To, request.From, request.Number, request.Reverse, request.All, request.OriginKind, func(ci *pfs.CommitInfo) error {
+	after, err := pfs.DecodeContinuation(pfs.CommitContinuation, request.Continuation)
+	if err != nil {
+		return err
+	}
+	// A page ends after at most number commits.
+	number := request.Number
+	if request.PageSize > 0 && (number == 0 || number > request.PageSize) {
+		number = request.PageSize
+	}
+	return a.driver.listCommit(respServer.Context(), request.Repo, request.To, request.From, number, request.Reverse, request.All, request.OriginKind, request.Metadata, after, func(ci *pfs.CommitInfo) error {
 		sent++
+		if int64(sent) == request.PageSize {
+			ci.Continuation = pfs.EncodeContinuation(pfs.CommitContinuation, pfsdb.CommitKey(ci.Commit))
+		}
 		return respServer.Send(ci)
 	})
 }
@@ -274,6 +378,27 @@ func (a *apiServer) SquashCommitSet(ctx context.Context, request *pfs.SquashComm
 	return &types.Empty{}, nil
 }
 
+// RevertCommit implements the protobuf pfs.RevertCommit RPC
+func (a *apiServer) RevertCommit(ctx context.Context, request *pfs.RevertCommitRequest) (response *pfs.Commit, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.revertCommit(ctx, request.Commit, request.Branch, request.Description)
+}
+
+// CherryPickCommit implements the protobuf pfs.CherryPickCommit RPC
+func (a *apiServer) CherryPickCommit(ctx context.Context, request *pfs.CherryPickCommitRequest) (response *pfs.Commit, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.cherryPickCommit(ctx, request.Commit, request.Branch, request.Description)
+}
+
+// MergeBranch implements the protobuf pfs.MergeBranch RPC
+func (a *apiServer) MergeBranch(ctx context.Context, request *pfs.MergeBranchRequest) (response *pfs.MergeBranchResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.mergeBranch(ctx, request.Source, request.Target, request.Strategy, request.Description)
+}
+
 // SubscribeCommit implements the protobuf pfs.SubscribeCommit RPC
 func (a *apiServer) SubscribeCommit(request *pfs.SubscribeCommitRequest, stream pfs.API_SubscribeCommitServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -291,7 +416,13 @@ func (a *apiServer) ClearCommit(ctx context.Context, request *pfs.ClearCommitReq
 // CreateBranchInTransaction is identical to CreateBranch except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) CreateBranchInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.CreateBranchRequest) error {
-	return a.driver.createBranch(txnCtx, request.Branch, request.Head, request.Provenance, request.Trigger)
+	if err := a.driver.createBranch(txnCtx, request.Branch, request.Head, request.Provenance, request.Trigger); err != nil {
+		return err
+	}
+	if request.Protection != nil {
+		return a.driver.setBranchProtection(txnCtx, request.Branch, request.Protection)
+	}
+	return nil
 }
 
 // CreateBranch implements the protobuf pfs.CreateBranch RPC
@@ -354,7 +485,7 @@ func (a *apiServer) ListBranch(request *pfs.ListBranchRequest, srv pfs.API_ListB
 // DeleteBranchInTransaction is identical to DeleteBranch except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) DeleteBranchInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.DeleteBranchRequest) error {
-	return a.driver.deleteBranch(txnCtx, request.Branch, request.Force)
+	return a.driver.deleteBranch(txnCtx, request.Branch, request.Force, false)
 }
 
 // DeleteBranch implements the protobuf pfs.DeleteBranch RPC
@@ -369,6 +500,32 @@ func (a *apiServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchR
 	return &types.Empty{}, nil
 }
 
+// RenameBranchInTransaction is identical to RenameBranch except that it can run
+// inside an existing postgres transaction.  This is not an RPC.
+func (a *apiServer) RenameBranchInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.RenameBranchRequest) error {
+	return a.driver.renameBranch(txnCtx, request.Branch, request.NewName)
+}
+
+// RenameBranch implements the protobuf pfs.RenameBranch RPC
+func (a *apiServer) RenameBranch(ctx context.Context, request *pfs.RenameBranchRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.RenameBranch(request)
+	}, nil); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// RunBranchesInTransaction starts new commits in the given branches that are
+// provenant on the given commits (and on the heads of any other provenant
+// branches) rather than on the heads of all their provenant branches. This is
+// used to implement 'run pipeline'.  This is not an RPC.
+func (a *apiServer) RunBranchesInTransaction(txnCtx *txncontext.TransactionContext, branches []*pfs.Branch, provenance []*pfs.Commit) error {
+	return a.driver.runBranches(txnCtx, branches, provenance)
+}
+
 func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 	commit, err := readCommit(server)
 	if err != nil {
@@ -396,10 +553,46 @@ type modifyFileSource interface {
 	Recv() (*pfs.ModifyFileRequest, error)
 }
 
+// peekedModifyFileSource is a modifyFileSource that returns a message that
+// was already read from the underlying source, if peeked is set, before
+// reading the rest of the source.
+type peekedModifyFileSource struct {
+	modifyFileSource
+	msg    *pfs.ModifyFileRequest
+	err    error
+	peeked bool
+}
+
+func (s *peekedModifyFileSource) Recv() (*pfs.ModifyFileRequest, error) {
+	if s.peeked {
+		s.peeked = false
+		return s.msg, s.err
+	}
+	return s.modifyFileSource.Recv()
+}
+
 // modifyFile reads from a modifyFileSource until io.EOF and writes changes to an UnorderedWriter.
 // SetCommit messages will result in an error.
-func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter, server modifyFileSource) (int64, error) {
+func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter, server modifyFileSource) (_ int64, retErr error) {
 	var bytesRead int64
+	// split holds the split put, if any, that raw AddFile messages are
+	// currently being streamed into.
+	var split *splitPut
+	defer func() {
+		if split != nil {
+			if err := split.close(); retErr == nil {
+				retErr = err
+			}
+		}
+	}()
+	finishSplit := func() error {
+		if split == nil {
+			return nil
+		}
+		err := split.close()
+		split = nil
+		return err
+	}
 	for {
 		msg, err := server.Recv()
 		if err != nil {
@@ -408,20 +601,38 @@ func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter,
 			}
 			return bytesRead, err
 		}
+		if mod, ok := msg.Body.(*pfs.ModifyFileRequest_AddFile); ok {
+			if src, ok := mod.AddFile.Source.(*pfs.AddFile_Raw); ok && mod.AddFile.Delimiter != pfs.Delimiter_NONE {
+				if split != nil && !split.matches(mod.AddFile) {
+					if err := finishSplit(); err != nil {
+						return bytesRead, err
+					}
+				}
+				if split == nil {
+					split = newSplitPut(uw, mod.AddFile)
+				}
+				if err := split.write(src.Raw.Value); err != nil {
+					return bytesRead, err
+				}
+				bytesRead += int64(len(src.Raw.Value))
+				continue
+			}
+		}
+		if err := finishSplit(); err != nil {
+			return bytesRead, err
+		}
 		switch mod := msg.Body.(type) {
 		case *pfs.ModifyFileRequest_AddFile:
 			var err error
 			var n int64
-			p := mod.AddFile.Path
-			t := mod.AddFile.Tag
 			switch src := mod.AddFile.Source.(type) {
 			case *pfs.AddFile_Raw:
-				n, err = putFileRaw(uw, p, t, src.Raw)
+				n, err = putFileRaw(uw, mod.AddFile, src.Raw)
 			case *pfs.AddFile_Url:
-				n, err = putFileURL(ctx, uw, p, t, src.Url)
+				n, err = putFileURL(ctx, uw, mod.AddFile, src.Url)
 			default:
 				// need to write empty data to path
-				n, err = putFileRaw(uw, p, t, &types.BytesValue{})
+				n, err = putFileRaw(uw, mod.AddFile, &types.BytesValue{})
 			}
 			if err != nil {
 				return bytesRead, err
@@ -445,14 +656,99 @@ func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter,
 	return bytesRead, nil
 }
 
-func putFileRaw(uw *fileset.UnorderedWriter, path, tag string, src *types.BytesValue) (int64, error) {
-	if err := uw.Put(path, tag, true, bytes.NewReader(src.Value)); err != nil {
+func putFileRaw(uw *fileset.UnorderedWriter, addFile *pfs.AddFile, src *types.BytesValue) (int64, error) {
+	if err := uw.Put(addFile.Path, addFile.Tag, true, bytes.NewReader(src.Value), fileset.WithAttributes(addFile.Attributes)); err != nil {
 		return 0, err
 	}
 	return int64(len(src.Value)), nil
 }
 
-func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag string, src *pfs.AddFile_URLSource) (n int64, retErr error) {
+// putFileSplit splits the content in r into records according to addFile's
+// split options and writes them to files under addFile's path.
+func putFileSplit(uw *fileset.UnorderedWriter, addFile *pfs.AddFile, p string, r io.Reader) error {
+	var rr fileset.RecordReader
+	switch addFile.Delimiter {
+	case pfs.Delimiter_LINE:
+		rr = fileset.NewLineReader(r)
+	case pfs.Delimiter_JSON:
+		rr = fileset.NewJSONReader(r)
+	case pfs.Delimiter_CSV:
+		rr = fileset.NewCSVReader(r)
+	case pfs.Delimiter_SQL:
+		rr = fileset.NewSQLReader(r)
+	default:
+		return errors.Errorf("unrecognized delimiter %v", addFile.Delimiter)
+	}
+	return uw.PutSplit(p, addFile.Tag, rr,
+		fileset.WithTargetFileDatums(addFile.TargetFileDatums),
+		fileset.WithTargetFileBytes(addFile.TargetFileBytes),
+		fileset.WithHeaderRecords(addFile.HeaderRecords),
+		fileset.WithSplitAttributes(addFile.Attributes),
+	)
+}
+
+// splitPut streams the content of consecutive raw AddFile messages into a
+// single putFileSplit call, so that records may span messages.
+type splitPut struct {
+	addFile *pfs.AddFile
+	w       *io.PipeWriter
+	done    chan error
+	closed  bool
+	err     error
+}
+
+func newSplitPut(uw *fileset.UnorderedWriter, addFile *pfs.AddFile) *splitPut {
+	r, w := io.Pipe()
+	sp := &splitPut{
+		addFile: addFile,
+		w:       w,
+		done:    make(chan error, 1),
+	}
+	go func() {
+		err := putFileSplit(uw, addFile, addFile.Path, r)
+		// Unblock any pending writes if the split failed or stopped reading.
+		r.CloseWithError(err)
+		sp.done <- err
+	}()
+	return sp
+}
+
+func (sp *splitPut) matches(addFile *pfs.AddFile) bool {
+	return sp.addFile.Path == addFile.Path &&
+		sp.addFile.Tag == addFile.Tag &&
+		sp.addFile.Delimiter == addFile.Delimiter &&
+		sp.addFile.TargetFileDatums == addFile.TargetFileDatums &&
+		sp.addFile.TargetFileBytes == addFile.TargetFileBytes &&
+		sp.addFile.HeaderRecords == addFile.HeaderRecords
+}
+
+func (sp *splitPut) write(data []byte) error {
+	if _, err := sp.w.Write(data); err != nil {
+		if closeErr := sp.close(); closeErr != nil {
+			return closeErr
+		}
+		return err
+	}
+	return nil
+}
+
+func (sp *splitPut) close() error {
+	if !sp.closed {
+		sp.closed = true
+		sp.w.Close()
+		sp.err = <-sp.done
+	}
+	return sp.err
+}
+
+func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, addFile *pfs.AddFile, src *pfs.AddFile_URLSource) (n int64, retErr error) {
+	dstPath, tag := addFile.Path, addFile.Tag
+	put := func(p string, r io.Reader) error {
+		if addFile.Delimiter != pfs.Delimiter_NONE {
+			return putFileSplit(uw, addFile, p, r)
+		}
+		return uw.Put(p, tag, true, r, fileset.WithAttributes(addFile.Attributes))
+	}
 	url, err := url.Parse(src.URL)
 	if err != nil {
 		return 0, err
@@ -472,7 +768,7 @@ func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag s
 				retErr = err
 			}
 		}()
-		return 0, uw.Put(dstPath, tag, true, resp.Body)
+		return 0, put(dstPath, resp.Body)
 	default:
 		url, err := obj.ParseURL(src.URL)
 		if err != nil {
@@ -488,14 +784,14 @@ func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag s
 				return miscutil.WithPipe(func(w io.Writer) error {
 					return objClient.Get(ctx, name, w)
 				}, func(r io.Reader) error {
-					return uw.Put(filepath.Join(dstPath, strings.TrimPrefix(name, path)), tag, true, r)
+					return put(filepath.Join(dstPath, strings.TrimPrefix(name, path)), r)
 				})
 			})
 		}
 		return 0, miscutil.WithPipe(func(w io.Writer) error {
 			return objClient.Get(ctx, url.Object, w)
 		}, func(r io.Reader) error {
-			return uw.Put(dstPath, tag, true, r)
+			return put(dstPath, r)
 		})
 	}
 }
@@ -530,6 +826,23 @@ func (a *apiServer) GetFileTAR(request *pfs.GetFileRequest, server pfs.API_GetFi
 	})
 }
 
+// GetFileRange implements the protobuf pfs.GetFileRange RPC
+func (a *apiServer) GetFileRange(request *pfs.GetFileRangeRequest, server pfs.API_GetFileRangeServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	return metrics.ReportRequestWithThroughput(func() (int64, error) {
+		var bytesWritten int64
+		err := grpcutil.WithStreamingBytesWriter(server, func(w io.Writer) error {
+			var err error
+			bytesWritten, err = withGetFileWriter(w, func(w io.Writer) error {
+				return a.driver.getFileRange(server.Context(), request.File, request.OffsetBytes, request.SizeBytes, w)
+			})
+			return err
+		})
+		return bytesWritten, err
+	})
+}
+
 // TODO: Parallelize and decide on appropriate config.
 func getFileURL(ctx context.Context, URL string, src Source) (int64, error) {
 	parsedURL, err := obj.ParseURL(URL)
@@ -596,7 +909,7 @@ func getFileTar(ctx context.Context, w io.Writer, src Source) error {
 func (a *apiServer) InspectFile(ctx context.Context, request *pfs.InspectFileRequest) (response *pfs.FileInfo, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	return a.driver.inspectFile(ctx, request.File)
+	return a.driver.inspectFile(ctx, request.File, request.History)
 }
 
 // ListFile implements the protobuf pfs.ListFile RPC
@@ -606,10 +919,33 @@ func (a *apiServer) ListFile(request *pfs.ListFileRequest, server pfs.API_ListFi
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.listFile(server.Context(), request.File, func(fi *pfs.FileInfo) error {
+	start, err := pfs.DecodeContinuation(pfs.FileContinuation, request.Continuation)
+	if err != nil {
+		return err
+	}
+	if (request.PageSize > 0 || start != "") && request.History != 0 {
+		return errors.Errorf("cannot paginate a file listing with history")
+	}
+	if err := a.driver.listFile(server.Context(), request.File, request.History, start, func(fi *pfs.FileInfo) error {
 		sent++
+		if int64(sent) == request.PageSize {
+			// The next page starts after the file, or after everything under
+			// the directory, since '0' is the byte after '/'.
+			next := fi.File.Path + "\x00"
+			if strings.HasSuffix(fi.File.Path, "/") {
+				next = strings.TrimSuffix(fi.File.Path, "/") + "0"
+			}
+			fi.Continuation = pfs.EncodeContinuation(pfs.FileContinuation, next)
+			if err := server.Send(fi); err != nil {
+				return err
+			}
+			return errutil.ErrBreak
+		}
 		return server.Send(fi)
-	})
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return err
+	}
+	return nil
 }
 
 // WalkFile implements the protobuf pfs.WalkFile RPC
@@ -619,7 +955,7 @@ func (a *apiServer) WalkFile(request *pfs.WalkFileRequest, server pfs.API_WalkFi
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.walkFile(server.Context(), request.File, func(fi *pfs.FileInfo) error {
+	return a.driver.walkFile(server.Context(), request.File, request.History, func(fi *pfs.FileInfo) error {
 		sent++
 		return server.Send(fi)
 	})
@@ -632,10 +968,24 @@ func (a *apiServer) GlobFile(request *pfs.GlobFileRequest, respServer pfs.API_Gl
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.globFile(respServer.Context(), request.Commit, request.Pattern, func(fi *pfs.FileInfo) error {
+	start, err := pfs.DecodeContinuation(pfs.FileContinuation, request.Continuation)
+	if err != nil {
+		return err
+	}
+	if err := a.driver.globFile(respServer.Context(), request.Commit, request.Pattern, start, func(fi *pfs.FileInfo) error {
 		sent++
+		if int64(sent) == request.PageSize {
+			fi.Continuation = pfs.EncodeContinuation(pfs.FileContinuation, fi.File.Path+"\x00")
+			if err := respServer.Send(fi); err != nil {
+				return err
+			}
+			return errutil.ErrBreak
+		}
 		return respServer.Send(fi)
-	})
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return err
+	}
+	return nil
 }
 
 // DiffFile implements the protobuf pfs.DiffFile RPC
@@ -645,7 +995,15 @@ func (a *apiServer) DiffFile(request *pfs.DiffFileRequest, server pfs.API_DiffFi
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.diffFile(server.Context(), request.OldFile, request.NewFile, func(oldFi, newFi *pfs.FileInfo) error {
+	if request.Summary {
+		summary, err := a.driver.diffFileSummary(server.Context(), request.OldFile, request.NewFile)
+		if err != nil {
+			return err
+		}
+		sent++
+		return server.Send(&pfs.DiffFileResponse{Summary: summary})
+	}
+	return a.driver.diffFile(server.Context(), request.OldFile, request.NewFile, request.Shallow, func(oldFi, newFi *pfs.FileInfo) error {
 		sent++
 		return server.Send(&pfs.DiffFileResponse{
 			OldFile: oldFi,
@@ -664,6 +1022,54 @@ func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (respon
 	return &types.Empty{}, nil
 }
 
+// ExportRepo implements the protobuf pfs.ExportRepo RPC
+func (a *apiServer) ExportRepo(request *pfs.ExportRepoRequest, server pfs.API_ExportRepoServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	return grpcutil.WithStreamingBytesWriter(server, func(w io.Writer) error {
+		return a.driver.exportRepo(server.Context(), request.Repo, request.Base, w)
+	})
+}
+
+// ImportRepo implements the protobuf pfs.ImportRepo RPC
+func (a *apiServer) ImportRepo(server pfs.API_ImportRepoServer) (retErr error) {
+	request, err := server.Recv()
+	if err != nil {
+		return err
+	}
+	func() { a.Log(request.Repo, nil, nil, 0) }()
+	var response *pfs.ImportRepoResponse
+	defer func(start time.Time) { a.Log(request.Repo, response, retErr, time.Since(start)) }(time.Now())
+	response, err = a.driver.importRepo(server.Context(), request.Repo, &importRepoReader{server: server, buf: request.Data})
+	if err != nil {
+		return err
+	}
+	return server.SendAndClose(response)
+}
+
+// importRepoReader reads a bundle from the data of the requests of an
+// ImportRepo stream.
+type importRepoReader struct {
+	server pfs.API_ImportRepoServer
+	buf    []byte
+}
+
+func (r *importRepoReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		request, err := r.server.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if request.Repo != nil {
+			return 0, errors.Errorf("the repo can only be set in the first request")
+		}
+		r.buf = request.Data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
 // Fsckimplements the protobuf pfs.Fsck RPC
 func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -680,12 +1086,64 @@ func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer
 	return nil
 }
 
+// RotateStorageKey implements the protobuf pfs.RotateStorageKey RPC
+func (a *apiServer) RotateStorageKey(ctx context.Context, request *pfs.RotateStorageKeyRequest) (response *pfs.StorageKeyInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.rotateStorageKey(ctx)
+}
+
+// InspectStorageKey implements the protobuf pfs.InspectStorageKey RPC
+func (a *apiServer) InspectStorageKey(ctx context.Context, request *pfs.InspectStorageKeyRequest) (response *pfs.StorageKeyInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.inspectStorageKey(ctx)
+}
+
+// CreateQuota implements the protobuf pfs.CreateQuota RPC
+func (a *apiServer) CreateQuota(ctx context.Context, request *pfs.CreateQuotaRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.createQuota(txnCtx, request.Quota, request.Update)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// InspectQuota implements the protobuf pfs.InspectQuota RPC
+func (a *apiServer) InspectQuota(ctx context.Context, request *pfs.InspectQuotaRequest) (response *pfs.QuotaInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.inspectQuota(ctx, request.Repo, request.Principal)
+}
+
+// ListQuota implements the protobuf pfs.ListQuota RPC
+func (a *apiServer) ListQuota(request *pfs.ListQuotaRequest, srv pfs.API_ListQuotaServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	return a.driver.listQuota(srv.Context(), request.Repo, srv.Send)
+}
+
 // CreateFileSet implements the pfs.CreateFileset RPC
 func (a *apiServer) CreateFileSet(server pfs.API_CreateFileSetServer) (retErr error) {
 	func() { a.Log(nil, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(nil, nil, retErr, time.Since(start)) }(time.Now())
-	fsID, err := a.driver.createFileSet(server.Context(), func(uw *fileset.UnorderedWriter) error {
-		_, err := a.modifyFile(server.Context(), uw, server)
+	// The first message may set the commit that the fileset is going to be
+	// added to, so that it is written with the storage options of its repo.
+	var repo *pfs.Repo
+	src := &peekedModifyFileSource{modifyFileSource: server}
+	src.msg, src.err = server.Recv()
+	if commit, ok := src.msg.GetBody().(*pfs.ModifyFileRequest_SetCommit); ok && src.err == nil {
+		if commit.SetCommit.GetBranch() != nil {
+			repo = commit.SetCommit.Branch.Repo
+		}
+	} else {
+		src.peeked = true
+	}
+	fsID, err := a.driver.createFileSet(server.Context(), repo, func(uw *fileset.UnorderedWriter) error {
+		_, err := a.modifyFile(server.Context(), uw, src)
 		return err
 	})
 	if err != nil {
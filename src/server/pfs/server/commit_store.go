@@ -29,10 +29,19 @@ type commitStore interface {
 	GetTotalFileSet(ctx context.Context, commit *pfs.Commit) (*fileset.ID, error)
 	// GetDiffFileSet returns the diff fileset for a commit
 	GetDiffFileSet(ctx context.Context, commit *pfs.Commit) (*fileset.ID, error)
+	// GetFileSetIDs returns the total fileset (nil if it hasn't been computed)
+	// and the diff filesets stored for a commit, without renewing them.
+	GetFileSetIDs(ctx context.Context, commit *pfs.Commit) (*fileset.ID, []fileset.ID, error)
+	// DropTotalFileSet clears the total fileset for the commit, so that it is
+	// recomputed from the diff.
+	DropTotalFileSet(ctx context.Context, commit *pfs.Commit) error
 	// DropFileSets clears the diff and total filesets for the commit.
 	DropFileSets(ctx context.Context, commit *pfs.Commit) error
 	// DropFileSetsTx is identical to DropFileSets except it runs in the provided transaction.
 	DropFileSetsTx(tx *sqlx.Tx, commit *pfs.Commit) error
+	// RenameTx moves the diff and total filesets of a commit to the commit it
+	// was renamed to.
+	RenameTx(tx *sqlx.Tx, from, to *pfs.Commit) error
 }
 
 var _ commitStore = &postgresCommitStore{}
@@ -116,6 +125,32 @@ func (cs *postgresCommitStore) GetDiffFileSet(ctx context.Context, commit *pfs.C
 	return cs.s.Compose(ctx, ids, defaultTTL)
 }
 
+func (cs *postgresCommitStore) GetFileSetIDs(ctx context.Context, commit *pfs.Commit) (*fileset.ID, []fileset.ID, error) {
+	var total *fileset.ID
+	var diffs []fileset.ID
+	if err := dbutil.WithTx(ctx, cs.db, func(tx *sqlx.Tx) error {
+		var err error
+		total, err = getTotal(tx, commit)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		diffs, err = getDiff(tx, commit)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	return total, diffs, nil
+}
+
+func (cs *postgresCommitStore) DropTotalFileSet(ctx context.Context, commit *pfs.Commit) error {
+	return dbutil.WithTx(ctx, cs.db, func(tx *sqlx.Tx) error {
+		return dropTotal(tx, cs.tr, commit)
+	})
+}
+
 func (cs *postgresCommitStore) SetTotalFileSet(ctx context.Context, commit *pfs.Commit, id fileset.ID) error {
 	return dbutil.WithTx(ctx, cs.db, func(tx *sqlx.Tx) error {
 		if err := dropTotal(tx, cs.tr, commit); err != nil {
@@ -155,6 +190,35 @@ func (cs *postgresCommitStore) dropDiff(tx *sqlx.Tx, commit *pfs.Commit) error {
 	return nil
 }
 
+func (cs *postgresCommitStore) RenameTx(tx *sqlx.Tx, from, to *pfs.Commit) error {
+	diffIDs, err := getDiff(tx, from)
+	if err != nil {
+		return err
+	}
+	for _, diffID := range diffIDs {
+		if err := cs.tr.CreateTx(tx, commitDiffTrackerID(to, diffID), []string{diffID.TrackerID()}, track.NoTTL); err != nil {
+			return err
+		}
+		if err := cs.tr.DeleteTx(tx, commitDiffTrackerID(from, diffID)); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.Exec(`UPDATE pfs.commit_diffs SET commit_id = $1 WHERE commit_id = $2`, pfsdb.CommitKey(to), pfsdb.CommitKey(from)); err != nil {
+		return err
+	}
+	id, err := getTotal(tx, from)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+	if err := dropTotal(tx, cs.tr, from); err != nil {
+		return err
+	}
+	return setTotal(tx, cs.tr, to, *id)
+}
+
 func getDiff(tx *sqlx.Tx, commit *pfs.Commit) ([]fileset.ID, error) {
 	var ids []fileset.ID
 	if err := tx.Select(&ids,
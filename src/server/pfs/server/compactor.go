@@ -45,6 +45,12 @@ func newCompactor(ctx context.Context, storage *fileset.Storage, etcdClient *etc
 }
 
 func (c *compactor) Compact(ctx context.Context, ids []fileset.ID, ttl time.Duration) (*fileset.ID, error) {
+	return c.compact(ctx, ids, ttl, nil)
+}
+
+// compact is like Compact, but compresses the chunks written by the
+// compaction with compression if it is set.
+func (c *compactor) compact(ctx context.Context, ids []fileset.ID, ttl time.Duration, compression *Compression) (*fileset.ID, error) {
 	return c.storage.CompactLevelBased(ctx, ids, defaultTTL, func(ctx context.Context, ids []fileset.ID, ttl time.Duration) (*fileset.ID, error) {
 		var id *fileset.ID
 		if err := c.compactionQueue.RunTaskBlock(ctx, func(master *work.Master) error {
@@ -62,6 +68,7 @@ func (c *compactor) Compact(ctx context.Context, ids []fileset.ID, ttl time.Dura
 							Lower: task.PathRange.Lower,
 							Upper: task.PathRange.Upper,
 						},
+						Compression: compression,
 					})
 					if err != nil {
 						return nil, err
@@ -118,7 +125,11 @@ func (c *compactor) compactionWorker(ctx context.Context) error {
 				Lower: task.Range.Lower,
 				Upper: task.Range.Upper,
 			}
-			id, err := c.storage.Compact(ctx, ids, defaultTTL, index.WithRange(pathRange))
+			var writerOpts []fileset.WriterOption
+			if task.Compression != nil {
+				writerOpts = append(writerOpts, fileset.WithWriterCompression(task.Compression.Algo, int(task.Compression.Level)))
+			}
+			id, err := c.storage.CompactWithWriterOptions(ctx, ids, defaultTTL, writerOpts, index.WithRange(pathRange))
 			if err != nil {
 				return nil, err
 			}
@@ -91,6 +91,32 @@ func (d *Differ) Iterate(ctx context.Context, cb func(aFi, bFi *pfs.FileInfo) er
 	return eg.Wait()
 }
 
+// Summarize returns a summary of the differences between `a` and `b`, where `a`
+// is the old side and `b` is the new side. Directories are not counted.
+func (d *Differ) Summarize(ctx context.Context) (*pfs.DiffFileSummary, error) {
+	summary := &pfs.DiffFileSummary{}
+	if err := d.Iterate(ctx, func(aFi, bFi *pfs.FileInfo) error {
+		// Directory paths end in a slash, so both sides of a path are always
+		// the same type.
+		switch {
+		case aFi != nil && aFi.FileType == pfs.FileType_DIR, bFi != nil && bFi.FileType == pfs.FileType_DIR:
+		case aFi == nil:
+			summary.Added++
+			summary.AddedBytes += bFi.SizeBytes
+		case bFi == nil:
+			summary.Deleted++
+			summary.DeletedBytes += aFi.SizeBytes
+		default:
+			summary.Modified++
+			summary.ModifiedBytesDelta += bFi.SizeBytes - aFi.SizeBytes
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
 func equalFileInfos(aFi, bFi *pfs.FileInfo) bool {
 	return bytes.Equal(aFi.Hash, bFi.Hash)
 }
@@ -33,6 +33,7 @@ import (
 	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/jmoiron/sqlx"
 	log "github.com/sirupsen/logrus"
 )
@@ -77,10 +78,80 @@ type driver struct {
 	repos    col.PostgresCollection
 	commits  col.PostgresCollection
 	branches col.PostgresCollection
+	quotas   col.PostgresCollection
+	metadata col.PostgresCollection
+	// quotaUsage caches the usage of the filesets checked against quotas.
+	quotaUsage *lru.Cache
 
+	tracker     track.Tracker
 	storage     *fileset.Storage
 	commitStore commitStore
 	compactor   *compactor
+	rewrapper   *fileset.Rewrapper
+	// scrubber is nil if chunk scrubbing is disabled.
+	scrubber *chunk.Scrubber
+	// compressionOverrides maps repo names to the chunk compression used for
+	// the data written to them, if it differs from the storage default.
+	compressionOverrides map[string]*Compression
+}
+
+// storageMasterKeyName is the name of the key that wraps chunk encryption keys.
+const storageMasterKeyName = "master"
+
+// parseCompressionOverrides parses a comma separated list of
+// repo=compression pairs, see chunk.ParseCompression.
+func parseCompressionOverrides(s string) (map[string]*Compression, error) {
+	overrides := make(map[string]*Compression)
+	for _, override := range strings.Split(s, ",") {
+		override = strings.TrimSpace(override)
+		if override == "" {
+			continue
+		}
+		parts := strings.SplitN(override, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid compression override %q, expected repo=compression", override)
+		}
+		algo, level, err := chunk.ParseCompression(parts[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid compression override for repo %q", parts[0])
+		}
+		overrides[parts[0]] = &Compression{Algo: algo, Level: int64(level)}
+	}
+	return overrides, nil
+}
+
+// compression returns the chunk compression override for the data written to
+// repo, or nil if the repo uses the storage default.
+func (d *driver) compression(repo *pfs.Repo) *Compression {
+	if repo == nil {
+		return nil
+	}
+	return d.compressionOverrides[repo.Name]
+}
+
+// writerOptions returns the options for the fileset writers that write data to
+// repo.
+func (d *driver) writerOptions(repo *pfs.Repo) []fileset.WriterOption {
+	opts := []fileset.WriterOption{fileset.WithTTL(defaultTTL)}
+	if c := d.compression(repo); c != nil {
+		opts = append(opts, fileset.WithWriterCompression(c.Algo, int(c.Level)))
+	}
+	return opts
+}
+
+func newScrubber(chunkStorage *chunk.Storage, conf *serviceenv.Configuration) (*chunk.Scrubber, error) {
+	var secondary obj.Client
+	if conf.StorageScrubSecondaryURL != "" {
+		url, err := obj.ParseURL(conf.StorageScrubSecondaryURL)
+		if err != nil {
+			return nil, err
+		}
+		secondary, err = obj.NewClientFromURLAndSecret(url)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return chunk.NewScrubber(chunkStorage, conf.StorageScrubRate, secondary), nil
 }
 
 func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPrefix string) (*driver, error) {
@@ -93,6 +164,8 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 	repos := pfsdb.Repos(env.GetDBClient(), env.GetPostgresListener())
 	commits := pfsdb.Commits(env.GetDBClient(), env.GetPostgresListener())
 	branches := pfsdb.Branches(env.GetDBClient(), env.GetPostgresListener())
+	quotas := pfsdb.Quotas(env.GetDBClient(), env.GetPostgresListener())
+	metadata := pfsdb.Metadata(env.GetDBClient(), env.GetPostgresListener())
 
 	// Setup driver struct.
 	d := &driver{
@@ -103,10 +176,14 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 		repos:      repos,
 		commits:    commits,
 		branches:   branches,
+		quotas:     quotas,
+		metadata:   metadata,
+		quotaUsage: newQuotaUsageCache(),
 		// TODO: set maxFanIn based on downward API.
 	}
 	// Setup tracker and chunk / fileset storage.
 	tracker := track.NewPostgresTracker(env.GetDBClient())
+	d.tracker = tracker
 	chunkStorageOpts, err := chunk.StorageOptions(env.Config())
 	if err != nil {
 		return nil, err
@@ -117,9 +194,20 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 	if err != nil {
 		return nil, err
 	}
-	chunkStorageOpts = append(chunkStorageOpts, chunk.WithSecret(secret))
+	chunkStorageOpts = append(chunkStorageOpts, chunk.WithSecret(secret), chunk.WithKeyStore(keyStore, storageMasterKeyName))
 	chunkStorage := chunk.NewStorage(objClient, memCache, env.GetDBClient(), tracker, chunkStorageOpts...)
 	d.storage = fileset.NewStorage(fileset.NewPostgresStore(env.GetDBClient()), tracker, chunkStorage, fileset.StorageOptions(env.Config())...)
+	d.rewrapper = fileset.NewRewrapper(d.storage)
+	if env.Config().StorageScrubRate > 0 {
+		d.scrubber, err = newScrubber(chunkStorage, env.Config())
+		if err != nil {
+			return nil, err
+		}
+	}
+	d.compressionOverrides, err = parseCompressionOverrides(env.Config().StorageCompressionRepoOverrides)
+	if err != nil {
+		return nil, err
+	}
 	// Setup compaction queue and worker.
 	d.compactor, err = newCompactor(env.Context(), d.storage, etcdClient, etcdPrefix, env.Config().StorageCompactionMaxFanIn)
 	if err != nil {
@@ -270,11 +358,32 @@ func (d *driver) getPermissions(ctx context.Context, repo *pfs.Repo) ([]auth.Per
 	return resp.Permissions, resp.Roles, nil
 }
 
-func (d *driver) listRepo(ctx context.Context, includeAuth bool, repoType string, cb func(*pfs.RepoInfo) error) error {
+// listRepo calls cb with the repos of repoType with all of the key/value pairs
+// in metadata. If after is set, only the repos after the repo with that key
+// are listed.
+func (d *driver) listRepo(ctx context.Context, includeAuth bool, repoType string, metadata map[string]string, after string, cb func(*pfs.RepoInfo) error) error {
 	authSeemsActive := true
 	repoInfo := &pfs.RepoInfo{}
+	if after != "" {
+		if err := d.repos.ReadOnly(ctx).Get(after, repoInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return errors.Errorf("invalid continuation: repo %q no longer exists", after)
+			}
+			return err
+		}
+	}
 
+	matches, err := d.withMetadata(ctx, metadata, pfsdb.MetadataRepoPairKey)
+	if err != nil {
+		return err
+	}
+	if matches != nil && len(matches) == 0 {
+		return nil
+	}
 	processFunc := func(string) error {
+		if matches != nil && !matches[pfsdb.MetadataRepoKey(repoInfo.Repo)] {
+			return nil
+		}
 		size, err := d.repoSizeUpperBound(ctx, repoInfo.Repo)
 		if err != nil {
 			return err
@@ -293,11 +402,13 @@ func (d *driver) listRepo(ctx context.Context, includeAuth bool, repoType string
 		return cb(proto.Clone(repoInfo).(*pfs.RepoInfo))
 	}
 
+	opts := col.DefaultOptions()
+	opts.After = after
 	if repoType == "" {
 		// blank type means return all
-		return d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions(), processFunc)
+		return d.repos.ReadOnly(ctx).List(repoInfo, opts, processFunc)
 	} else {
-		return d.repos.ReadOnly(ctx).GetByIndex(pfsdb.ReposTypeIndex, repoType, repoInfo, col.DefaultOptions(), processFunc)
+		return d.repos.ReadOnly(ctx).GetByIndex(pfsdb.ReposTypeIndex, repoType, repoInfo, opts, processFunc)
 	}
 }
 
@@ -319,7 +430,9 @@ func (d *driver) deleteAllBranchesFromRepos(txnCtx *txncontext.TransactionContex
 		// branch is provenant on another (which is likely the case when
 		// multiple repos are provided) we delete them in the right order.
 		branch := branchInfos[len(branchInfos)-1-i].Branch
-		if err := d.deleteBranch(txnCtx, branch, force); err != nil {
+		// The branches are deleted along with their repos, which is not
+		// subject to branch protection.
+		if err := d.deleteBranch(txnCtx, branch, force, true); err != nil {
 			return errors.Wrapf(err, "delete branch %s", branch)
 		}
 	}
@@ -404,6 +517,12 @@ func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 	if err := d.commits.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repo)); err != nil {
 		return err
 	}
+	if err := d.quotas.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.QuotasRepoIndex, pfsdb.RepoKey(repo)); err != nil {
+		return err
+	}
+	if err := d.metadata.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.MetadataRepoIndex, pfsdb.RepoKey(repo)); err != nil {
+		return err
+	}
 	if err := repos.Delete(pfsdb.RepoKey(repo)); err != nil && !col.IsErrNotFound(err) {
 		return errors.Wrapf(err, "repos.Delete")
 	}
@@ -472,6 +591,8 @@ func (d *driver) startCommit(
 				return err
 			}
 			branchInfo.Branch = branch
+		} else if err := d.checkBranchProtection(txnCtx, branchInfo, forbidStartCommit); err != nil {
+			return err
 		}
 		// If the parent is unspecified, use the current head of the branch
 		if parent == nil {
@@ -494,7 +615,7 @@ func (d *driver) startCommit(
 		specBranch := client.NewSystemRepo(spoutName, pfs.SpecRepoType).NewBranch("master")
 		specCommit := specBranch.NewCommit(spoutCommit)
 		log.Infof("Adding spout spec commit to current commitset: %s", specCommit)
-		if _, err := d.aliasCommit(txnCtx, specCommit, specBranch); err != nil {
+		if _, err := d.aliasCommit(txnCtx, specCommit, specBranch, true); err != nil {
 			return nil, err
 		}
 	} else if len(branchInfo.Provenance) > 0 {
@@ -589,6 +710,21 @@ func (d *driver) finishCommit(txnCtx *txncontext.TransactionContext, commit *pfs
 		} else if isPipeline {
 			return errors.Errorf("cannot finish a pipeline output or meta commit, use 'stop job' instead")
 		}
+	} else {
+		branchInfo := &pfs.BranchInfo{}
+		if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(pfsdb.BranchKey(commitInfo.Commit.Branch), branchInfo); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		if branchInfo.Branch != nil {
+			if err := d.checkBranchProtection(txnCtx, branchInfo, forbidForceFinish(commitInfo.Commit.Branch.Repo, commitError)); err != nil {
+				return err
+			}
+		}
+	}
+	if !commitError {
+		if err := d.checkCommitQuotas(txnCtx, commitInfo); err != nil {
+			return err
+		}
 	}
 	if description != "" {
 		commitInfo.Description = description
@@ -650,7 +786,11 @@ func (d *driver) resolveAlias(txnCtx *txncontext.TransactionContext, source *pfs
 	return baseInfo, nil
 }
 
-func (d *driver) aliasCommit(txnCtx *txncontext.TransactionContext, parent *pfs.Commit, branch *pfs.Branch) (*pfs.CommitInfo, error) {
+// aliasCommit creates an alias of 'parent' on 'branch' in the transaction's
+// CommitSet. If 'moveHead' is set, the head of 'branch' is updated to point to
+// the alias, otherwise the alias is left as an off-head commit in the branch
+// (e.g. when 'run pipeline' is given explicit provenance).
+func (d *driver) aliasCommit(txnCtx *txncontext.TransactionContext, parent *pfs.Commit, branch *pfs.Branch, moveHead bool) (*pfs.CommitInfo, error) {
 	// It is considered an error if the CommitSet attempts to use two different
 	// commits from the same branch.  Therefore, if there is already a row for the
 	// given branch and it doesn't reference the same parent commit, we fail.  In
@@ -664,10 +804,6 @@ func (d *driver) aliasCommit(txnCtx *txncontext.TransactionContext, parent *pfs.
 		ID:     txnCtx.CommitSetID,
 	}
 
-	// Update the branch head to point to the alias
-	// TODO(global ids): we likely want this behavior to be optional, like when
-	// doing a 'run pipeline' with explicit provenance (to make off-head commits
-	// in the branch).
 	branchInfo := &pfs.BranchInfo{}
 	if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(pfsdb.BranchKey(branch), branchInfo); err != nil {
 		return nil, err
@@ -722,10 +858,12 @@ func (d *driver) aliasCommit(txnCtx *txncontext.TransactionContext, parent *pfs.
 		}
 	}
 
-	// Update the branch head
-	branchInfo.Head = commit
-	if err := d.branches.ReadWrite(txnCtx.SqlTx).Put(pfsdb.BranchKey(branch), branchInfo); err != nil {
-		return nil, err
+	// Update the branch head to point to the alias
+	if moveHead {
+		branchInfo.Head = commit
+		if err := d.branches.ReadWrite(txnCtx.SqlTx).Put(pfsdb.BranchKey(branch), branchInfo); err != nil {
+			return nil, err
+		}
 	}
 
 	return commitInfo, nil
@@ -796,6 +934,30 @@ func (d *driver) propagateBranches(txnCtx *txncontext.TransactionContext, branch
 		return branchInfo, nil
 	}
 
+	// A provenant branch may already have a commit in this CommitSet which is
+	// not its head (i.e. an off-head alias created by runBranches), in which
+	// case that commit is used as the branch's commit for the CommitSet instead
+	// of aliasing the head.
+	inCommitSetCache := map[string]bool{}
+	inCommitSet := func(branchInfo *pfs.BranchInfo) (bool, error) {
+		if branchInfo.Head.ID == txnCtx.CommitSetID {
+			return true, nil
+		}
+		key := pfsdb.CommitKey(branchInfo.Branch.NewCommit(txnCtx.CommitSetID))
+		if ok, cached := inCommitSetCache[key]; cached {
+			return ok, nil
+		}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).Get(key, &pfs.CommitInfo{}); err != nil {
+			if !col.IsErrNotFound(err) {
+				return false, err
+			}
+			inCommitSetCache[key] = false
+			return false, nil
+		}
+		inCommitSetCache[key] = true
+		return true, nil
+	}
+
 	// subvBIMap = ( ⋃{b.subvenance | b ∈ branches} ) ∪ branches
 	subvBIMap := map[string]*pfs.BranchInfo{}
 	for _, branch := range branches {
@@ -827,6 +989,8 @@ func (d *driver) propagateBranches(txnCtx *txncontext.TransactionContext, branch
 	})
 
 	// Iterate through downstream branches and determine which need a new commit.
+	// Their protection isn't checked, since only principals allowed to move
+	// their heads can give them provenance (see createBranch).
 	hasNewCommits := false
 	for _, subvBI := range subvBIs {
 		// Do not propagate an open commit onto spout output branches (which should
@@ -843,7 +1007,13 @@ func (d *driver) propagateBranches(txnCtx *txncontext.TransactionContext, branch
 			if err != nil {
 				return err
 			}
-			ids = append(ids, provOfSubvBI.Head.ID)
+			if ok, err := inCommitSet(provOfSubvBI); err != nil {
+				return err
+			} else if ok {
+				ids = append(ids, txnCtx.CommitSetID)
+			} else {
+				ids = append(ids, provOfSubvBI.Head.ID)
+			}
 		}
 
 		if allSameString(ids) {
@@ -860,8 +1030,10 @@ func (d *driver) propagateBranches(txnCtx *txncontext.TransactionContext, branch
 			if err != nil {
 				return err
 			}
-			if provOfSubvBI.Head.ID != txnCtx.CommitSetID {
-				if _, err := d.aliasCommit(txnCtx, provOfSubvBI.Head, provOfSubvBI.Head.Branch); err != nil {
+			if ok, err := inCommitSet(provOfSubvBI); err != nil {
+				return err
+			} else if !ok {
+				if _, err := d.aliasCommit(txnCtx, provOfSubvBI.Head, provOfSubvBI.Head.Branch, true); err != nil {
 					return err
 				}
 				// Update the cached branch head
@@ -922,6 +1094,121 @@ func (d *driver) propagateBranches(txnCtx *txncontext.TransactionContext, branch
 	return nil
 }
 
+// runBranches starts new commits in 'branches' (which must have provenance)
+// that are provenant on the commits in 'provenance' rather than on the heads of
+// the branches' provenance. Each commit in 'provenance' must be on a branch in
+// the provenance of 'branches', and at most one commit may be given for each
+// branch. The provenance of each given commit is carried into the CommitSet
+// along with it, and the remaining provenant branches use their current head.
+// Provenant commits that aren't the head of their branch are aliased into the
+// CommitSet without moving the head, so upstream branches are left untouched.
+func (d *driver) runBranches(txnCtx *txncontext.TransactionContext, branches []*pfs.Branch, provenance []*pfs.Commit) error {
+	// provBIs is the union of the provenance of 'branches'
+	provBIs := map[string]*pfs.BranchInfo{}
+	for _, branch := range branches {
+		branchInfo := &pfs.BranchInfo{}
+		if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(pfsdb.BranchKey(branch), branchInfo); err != nil {
+			return err
+		}
+		if len(branchInfo.Provenance) == 0 {
+			return errors.Errorf("branch %s has no provenance", branch)
+		}
+		for _, provBranch := range branchInfo.Provenance {
+			if _, ok := provBIs[pfsdb.BranchKey(provBranch)]; ok {
+				continue
+			}
+			provBI := &pfs.BranchInfo{}
+			if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(pfsdb.BranchKey(provBranch), provBI); err != nil {
+				return err
+			}
+			provBIs[pfsdb.BranchKey(provBranch)] = provBI
+		}
+	}
+
+	// Resolve the requested provenance
+	provCIs := map[string]*pfs.CommitInfo{}
+	for _, commit := range provenance {
+		commitInfo, err := d.resolveCommit(txnCtx.SqlTx, proto.Clone(commit).(*pfs.Commit))
+		if err != nil {
+			return err
+		}
+		key := pfsdb.BranchKey(commitInfo.Commit.Branch)
+		if _, ok := provBIs[key]; !ok {
+			return errors.Errorf("branch %s is not in the provenance of %v", commitInfo.Commit.Branch, branches)
+		}
+		if _, ok := provCIs[key]; ok {
+			return errors.Errorf("multiple commits given for branch %s", commitInfo.Commit.Branch)
+		}
+		provCIs[key] = commitInfo
+	}
+
+	// Carry along the upstream commits of each requested commit, so that the new
+	// CommitSet agrees with the CommitSets the requested commits came from.
+	upstreamCIs := map[string]*pfs.CommitInfo{}
+	for key, commitInfo := range provCIs {
+		for _, upstreamBranch := range provBIs[key].Provenance {
+			upstreamCI := &pfs.CommitInfo{}
+			if err := d.commits.ReadWrite(txnCtx.SqlTx).Get(pfsdb.CommitKey(upstreamBranch.NewCommit(commitInfo.Commit.ID)), upstreamCI); err != nil {
+				if col.IsErrNotFound(err) {
+					// 'upstreamBranch' was added to the provenance after 'commitInfo' was created
+					continue
+				}
+				return err
+			}
+			upstreamKey := pfsdb.BranchKey(upstreamBranch)
+			for _, other := range []*pfs.CommitInfo{provCIs[upstreamKey], upstreamCIs[upstreamKey]} {
+				if other == nil {
+					continue
+				}
+				if same, err := d.sameCommitData(txnCtx, other.Commit, upstreamCI.Commit); err != nil {
+					return err
+				} else if !same {
+					return errors.EnsureStack(pfsserver.ErrInconsistentCommit{Commit: commitInfo.Commit, Branch: upstreamBranch})
+				}
+			}
+			if _, ok := provCIs[upstreamKey]; !ok {
+				upstreamCIs[upstreamKey] = upstreamCI
+			}
+		}
+	}
+	for key, commitInfo := range upstreamCIs {
+		provCIs[key] = commitInfo
+	}
+
+	// Alias the chosen commit of every provenant branch into the CommitSet
+	for key, provBI := range provBIs {
+		commit := provBI.Head
+		if commitInfo, ok := provCIs[key]; ok {
+			commit = commitInfo.Commit
+		}
+		if _, err := d.aliasCommit(txnCtx, commit, provBI.Branch, commit.ID == provBI.Head.ID); err != nil {
+			return err
+		}
+	}
+
+	// Start the new commits in 'branches' (and anything downstream of them)
+	for _, branch := range branches {
+		if err := txnCtx.PropagateBranch(branch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sameCommitData returns true if 'a' and 'b' are aliases of the same commit
+// (or are the same commit).
+func (d *driver) sameCommitData(txnCtx *txncontext.TransactionContext, a, b *pfs.Commit) (bool, error) {
+	aRoot, err := d.resolveAlias(txnCtx, a)
+	if err != nil {
+		return false, err
+	}
+	bRoot, err := d.resolveAlias(txnCtx, b)
+	if err != nil {
+		return false, err
+	}
+	return proto.Equal(aRoot.Commit, bRoot.Commit), nil
+}
+
 // inspectCommit takes a Commit and returns the corresponding CommitInfo.
 //
 // As a side effect, this function also replaces the ID in the given commit
@@ -1020,9 +1307,18 @@ func (d *driver) resolveCommit(sqlTx *sqlx.Tx, userCommit *pfs.Commit) (*pfs.Com
 		return nil, errors.Errorf("cannot resolve commit with no ID or branch")
 	}
 	commit := proto.Clone(userCommit).(*pfs.Commit) // back up user commit, for error reporting
+	// Extract any time reference from 'commit.ID' (i.e. @<time>)
+	var before *time.Time
+	var err error
+	commit.ID, before, err = ancestry.ParseTime(commit.ID)
+	if err != nil {
+		return nil, err
+	}
+	if commit.ID == "" && commit.Branch.Name == "" {
+		return nil, errors.Errorf("cannot resolve commit with no ID or branch")
+	}
 	// Extract any ancestor tokens from 'commit.ID' (i.e. ~, ^ and .)
 	var ancestryLength int
-	var err error
 	commit.ID, ancestryLength, err = ancestry.Parse(commit.ID)
 	if err != nil {
 		return nil, err
@@ -1098,11 +1394,43 @@ func (d *driver) resolveCommit(sqlTx *sqlx.Tx, userCommit *pfs.Commit) (*pfs.Com
 			commit = cis[i%len(cis)].ParentCommit
 		}
 	}
+	if before != nil {
+		if commitInfo, err = d.commitBefore(sqlTx, commitInfo, *before); err != nil {
+			return nil, err
+		}
+		if commitInfo == nil {
+			return nil, pfsserver.ErrCommitNotFound{Commit: userCommit}
+		}
+	}
 	userCommit.Branch = proto.Clone(commitInfo.Commit.Branch).(*pfs.Branch)
 	userCommit.ID = commitInfo.Commit.ID
 	return commitInfo, nil
 }
 
+// commitBefore returns the newest of commitInfo and its ancestors that was
+// finished at or before t, or nil if there is no such commit.
+func (d *driver) commitBefore(sqlTx *sqlx.Tx, commitInfo *pfs.CommitInfo, t time.Time) (*pfs.CommitInfo, error) {
+	for {
+		if commitInfo.Finished != nil {
+			finished, err := types.TimestampFromProto(commitInfo.Finished)
+			if err != nil {
+				return nil, err
+			}
+			if !finished.After(t) {
+				return commitInfo, nil
+			}
+		}
+		if commitInfo.ParentCommit == nil {
+			return nil, nil
+		}
+		parentInfo := &pfs.CommitInfo{}
+		if err := d.commits.ReadWrite(sqlTx).Get(pfsdb.CommitKey(commitInfo.ParentCommit), parentInfo); err != nil {
+			return nil, err
+		}
+		commitInfo = parentInfo
+	}
+}
+
 // getCommit is like inspectCommit, without the blocking.
 // It does not add the size to the CommitInfo
 func (d *driver) getCommit(ctx context.Context, commit *pfs.Commit) (*pfs.CommitInfo, error) {
@@ -1152,6 +1480,8 @@ func (d *driver) listCommit(
 	reverse bool,
 	all bool,
 	originKind pfs.OriginKind,
+	metadata map[string]string,
+	after string,
 	cb func(*pfs.CommitInfo) error,
 ) error {
 	// Validate arguments
@@ -1188,6 +1518,32 @@ func (d *driver) listCommit(
 		}
 	}
 
+	// Make sure that the commit the list continues after still exists
+	var afterInfo *pfs.CommitInfo
+	if after != "" {
+		afterInfo = &pfs.CommitInfo{}
+		if err := d.commits.ReadOnly(ctx).Get(after, afterInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return errors.Errorf("invalid continuation: commit %q no longer exists", after)
+			}
+			return err
+		}
+	}
+
+	matches, err := d.withMetadata(ctx, metadata, pfsdb.MetadataCommitPairKey)
+	if err != nil {
+		return err
+	}
+	if matches != nil && len(matches) == 0 {
+		return nil
+	}
+	passesFilters := func(ci *pfs.CommitInfo) bool {
+		if matches != nil && !matches[pfsdb.MetadataCommitKey(ci.Commit)] {
+			return false
+		}
+		return passesCommitOriginFilter(ci, all, originKind)
+	}
+
 	// if number is 0, we return all commits that match the criteria
 	if number == 0 {
 		number = math.MaxInt64
@@ -1201,16 +1557,15 @@ func (d *driver) listCommit(
 		// sendCis sorts cis and passes them to f
 		sendCis := func() error {
 			// We don't sort these because there is no provenance between commits
-			// within a repo, so there is no topological sort necessary.
-			for i, ci := range cis {
+			// within a repo, so there is no topological sort necessary. Commits
+			// with the same revision are already ordered by key, in the
+			// direction of the list.
+			for _, ci := range cis {
 				if number == 0 {
 					return errutil.ErrBreak
 				}
 				number--
 
-				if reverse {
-					ci = cis[len(cis)-1-i]
-				}
 				var err error
 				ci.SizeBytesUpperBound, err = d.commitSizeUpperBound(ctx, ci.Commit)
 				if err != nil {
@@ -1235,7 +1590,7 @@ func (d *driver) listCommit(
 				}
 				lastRev = createRev
 			}
-			if passesCommitOriginFilter(ci, all, originKind) {
+			if passesFilters(ci) {
 				cis = append(cis, proto.Clone(ci).(*pfs.CommitInfo))
 			}
 			return nil
@@ -1243,7 +1598,7 @@ func (d *driver) listCommit(
 
 		// if neither from and to is given, we list all commits in
 		// the repo, sorted by revision timestamp (or reversed if so requested.)
-		opts := &col.Options{Target: col.SortByCreateRevision, Order: col.SortDescend}
+		opts := &col.Options{Target: col.SortByCreateRevision, Order: col.SortDescend, After: after}
 		if reverse {
 			opts.Order = col.SortAscend
 		}
@@ -1267,12 +1622,15 @@ func (d *driver) listCommit(
 			return errors.Errorf("cannot use 'Reverse' while also using 'From' or 'To'")
 		}
 		cursor := to
+		if afterInfo != nil {
+			cursor = afterInfo.ParentCommit
+		}
 		for number != 0 && cursor != nil && (from == nil || cursor.ID != from.ID) {
 			commitInfo := &pfs.CommitInfo{}
 			if err := d.commits.ReadOnly(ctx).Get(pfsdb.CommitKey(cursor), commitInfo); err != nil {
 				return err
 			}
-			if passesCommitOriginFilter(commitInfo, all, originKind) {
+			if passesFilters(commitInfo) {
 				if err := cb(commitInfo); err != nil {
 					if errors.Is(err, errutil.ErrBreak) {
 						return nil
@@ -1427,6 +1785,9 @@ func (d *driver) squashCommitSet(txnCtx *txncontext.TransactionContext, commitse
 		if err := d.commits.ReadWrite(txnCtx.SqlTx).Delete(pfsdb.CommitKey(commitInfo.Commit)); err != nil {
 			return err
 		}
+		if err := d.unindexMetadata(txnCtx, pfsdb.MetadataCommitKey(commitInfo.Commit)); err != nil {
+			return err
+		}
 
 		// Delete the commit's filesets
 		if err := d.commitStore.DropFileSetsTx(txnCtx.SqlTx, commitInfo.Commit); err != nil {
@@ -1436,6 +1797,9 @@ func (d *driver) squashCommitSet(txnCtx *txncontext.TransactionContext, commitse
 		// Update the commit's branch's branchInfo in case this was the head of the branch
 		branchInfo := &pfs.BranchInfo{}
 		if err := d.branches.ReadWrite(txnCtx.SqlTx).Update(pfsdb.BranchKey(commitInfo.Commit.Branch), branchInfo, func() error {
+			if err := d.checkBranchProtection(txnCtx, branchInfo, forbidSquashCommit); err != nil {
+				return err
+			}
 			if branchInfo.Head.ID == commitInfo.Commit.ID {
 				if commitInfo.ParentCommit == nil || !proto.Equal(commitInfo.ParentCommit.Branch, commitInfo.Commit.Branch) {
 					// Create a new empty commit for the branch head
@@ -1648,7 +2012,10 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 
 	// Retrieve (and create, if necessary) the current version of this branch
 	branchInfo := &pfs.BranchInfo{}
+	var oldProvenance []*pfs.Branch
+	var oldTrigger *pfs.Trigger
 	if err := d.branches.ReadWrite(txnCtx.SqlTx).Upsert(pfsdb.BranchKey(branch), branchInfo, func() error {
+		oldProvenance, oldTrigger = branchInfo.DirectProvenance, branchInfo.Trigger
 		branchInfo.Branch = branch
 		branchInfo.DirectProvenance = nil
 		for _, provBranch := range provenance {
@@ -1664,6 +2031,14 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 	}); err != nil {
 		return err
 	}
+	// The head of a branch is moved automatically by its trigger and
+	// provenance, without checking its protection, so they can only be changed
+	// by principals who could move its head themselves.
+	if !proto.Equal(oldTrigger, branchInfo.Trigger) || !sameBranches(oldProvenance, branchInfo.DirectProvenance) {
+		if err := d.checkBranchProtection(txnCtx, branchInfo, forbidAutomaticHead(branchInfo.Trigger, branchInfo.DirectProvenance)); err != nil {
+			return err
+		}
+	}
 
 	var ci *pfs.CommitInfo
 	if commit != nil {
@@ -1676,20 +2051,26 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 		// Verify the provenance of the new branch head and lock in its upstream commits
 		for _, provBranch := range provenance {
 			// Check that the CommitSet for the given commit has values for every branch in provenance and alias them
-			if _, err := d.aliasCommit(txnCtx, provBranch.NewCommit(ci.Commit.ID), provBranch); err != nil {
+			if _, err := d.aliasCommit(txnCtx, provBranch.NewCommit(ci.Commit.ID), provBranch, true); err != nil {
 				if pfsserver.IsCommitNotFoundErr(err) {
 					return errors.Errorf("cannot create branch %s with commit %s as head because it does not have provenance in the %s branch", branch, ci.Commit, provBranch)
 				}
 			}
 		}
 
+		if branchInfo.Head == nil || branchInfo.Head.ID != ci.Commit.ID {
+			if err := d.checkBranchProtection(txnCtx, branchInfo, forbidCreateBranch(ci.Commit)); err != nil {
+				return err
+			}
+		}
+
 		if commit.ID == txnCtx.CommitSetID && proto.Equal(commit.Branch, branchInfo.Branch) {
 			// We can reuse the existing commit only if it is already on this branch
 			branchInfo.Head = commit
 		} else if branchInfo.Head == nil || branchInfo.Head.ID != commit.ID {
 			// Create an alias of the head commit onto this branch - this will move the
 			// head of the branch and update the repo size if necessary
-			aliasCommitInfo, err := d.aliasCommit(txnCtx, commit, branch)
+			aliasCommitInfo, err := d.aliasCommit(txnCtx, commit, branch, true)
 			if err != nil {
 				return err
 			}
@@ -1873,7 +2254,9 @@ func (d *driver) listBranch(ctx context.Context, repo *pfs.Repo, reverse bool, c
 	return sendBis()
 }
 
-func (d *driver) deleteBranch(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, force bool) error {
+// deleteBranch deletes branch. ignoreProtection is set when the branch is
+// deleted along with its repo.
+func (d *driver) deleteBranch(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, force, ignoreProtection bool) error {
 	// Validate arguments
 	if branch == nil {
 		return errors.New("branch cannot be nil")
@@ -1894,6 +2277,11 @@ func (d *driver) deleteBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 	}
 
 	if branchInfo.Branch != nil {
+		if !ignoreProtection {
+			if err := d.checkBranchProtection(txnCtx, branchInfo, forbidDeleteBranch); err != nil {
+				return err
+			}
+		}
 		if !force {
 			if len(branchInfo.Subvenance) > 0 {
 				return errors.Errorf("branch %s has %v as subvenance, deleting it would break those branches", branch.Name, branchInfo.Subvenance)
@@ -1970,7 +2358,7 @@ func (d *driver) addBranchProvenance(txnCtx *txncontext.TransactionContext, bran
 
 func (d *driver) deleteAll(ctx context.Context) error {
 	var repoInfos []*pfs.RepoInfo
-	if err := d.listRepo(ctx, !includeAuth, "", func(repoInfo *pfs.RepoInfo) error {
+	if err := d.listRepo(ctx, !includeAuth, "", nil, "", func(repoInfo *pfs.RepoInfo) error {
 		repoInfos = append(repoInfos, repoInfo)
 		return nil
 	}); err != nil {
@@ -1987,6 +2375,27 @@ func (d *driver) deleteAll(ctx context.Context) error {
 	})
 }
 
+func (d *driver) rotateStorageKey(ctx context.Context) (*pfs.StorageKeyInfo, error) {
+	version, err := d.storage.ChunkStorage().RotateKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("rotated storage key to version %d", version)
+	return d.inspectStorageKey(ctx)
+}
+
+func (d *driver) inspectStorageKey(ctx context.Context) (*pfs.StorageKeyInfo, error) {
+	progress, err := d.rewrapper.Progress(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.StorageKeyInfo{
+		Version:           progress.KeyVersion,
+		FileSets:          progress.FileSets,
+		RewrappedFileSets: progress.Rewrapped,
+	}, nil
+}
+
 func (d *driver) makeEmptyCommit(txnCtx *txncontext.TransactionContext, branchInfo *pfs.BranchInfo) (*pfs.Commit, error) {
 	// Input repos and spouts want a closed head commit, so decide if we leave
 	// it open by the presence of branch provenance.  If it's only provenant on
@@ -2064,6 +2473,19 @@ func has(bs *[]*pfs.Branch, branch *pfs.Branch) bool {
 	return (*branchSet)(bs).has(branch)
 }
 
+// sameBranches returns whether the branch sets a and b are equal.
+func sameBranches(a, b []*pfs.Branch) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, branch := range a {
+		if !has(&b, branch) {
+			return false
+		}
+	}
+	return true
+}
+
 func getOrCreateKey(ctx context.Context, keyStore chunk.KeyStore, name string) ([]byte, error) {
 	secret, err := keyStore.Get(ctx, name)
 	if err != sql.ErrNoRows {
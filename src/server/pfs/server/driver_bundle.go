@@ -0,0 +1,584 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"golang.org/x/net/context"
+)
+
+// A repo bundle is a tar stream with the commits, branches and file contents
+// of a repo, written by exportRepo and read by importRepo. Its entries are, in
+// order:
+//
+//	repo              the pfs.RepoInfo of the exported repo
+//	base              the pfs.Commit an incremental bundle is relative to
+//	chunks/<id>       the stored (compressed and encrypted) object of a chunk,
+//	                  named by its hex encoded chunk.ID
+//	commits/<id>      the pfs.CommitInfo of a finished commit
+//	deletive/<id>     the files deleted by the commit, as length-delimited
+//	                  index.Index messages
+//	additive/<id>     the files added by the commit, as length-delimited
+//	                  index.Index messages
+//	branches/<name>   the pfs.BranchInfo of a branch
+//
+// The base entry is only present in incremental bundles. Commits come after
+// their parents, and after the chunks their files reference that aren't
+// referenced by an earlier commit or by the base commit. Messages are stored
+// in their binary encoding. The indexes hold the unwrapped encryption keys of
+// the chunks, so a bundle must be protected like the data in it.
+const (
+	bundleRepo     = "repo"
+	bundleBase     = "base"
+	bundleChunks   = "chunks"
+	bundleCommits  = "commits"
+	bundleDeletive = "deletive"
+	bundleAdditive = "additive"
+	bundleBranches = "branches"
+)
+
+// exportRepo writes a bundle of repo to w. If base is not nil the bundle is
+// incremental, leaving out base, its ancestors and the chunks referenced by
+// base. Unfinished commits are left out, and the branches they're the heads of
+// are exported with their nearest finished ancestor as their head.
+func (d *driver) exportRepo(ctx context.Context, repo *pfs.Repo, base *pfs.Commit, w io.Writer) error {
+	if repo.Type == "" {
+		repo.Type = pfs.UserRepoType
+	}
+	if repo.Type != pfs.UserRepoType {
+		return errors.Errorf("cannot export %s: only user repos can be exported", repo)
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_READ); err != nil {
+		return err
+	}
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(pfsdb.RepoKey(repo), repoInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrRepoNotFound{Repo: repo}
+		}
+		return err
+	}
+	commitInfos := make(map[string]*pfs.CommitInfo)
+	commitInfo := &pfs.CommitInfo{}
+	if err := d.commits.ReadOnly(ctx).GetByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repo), commitInfo, col.DefaultOptions(), func(string) error {
+		commitInfos[pfsdb.CommitKey(commitInfo.Commit)] = proto.Clone(commitInfo).(*pfs.CommitInfo)
+		return nil
+	}); err != nil {
+		return err
+	}
+	tw := tar.NewWriter(w)
+	if err := writeBundleMessage(tw, bundleRepo, repoInfo); err != nil {
+		return err
+	}
+	// exported holds the commits and chunks which are in the bundle, or were
+	// exported with the base commit.
+	exportedCommits := make(map[string]bool)
+	exportedChunks := make(map[string]bool)
+	if base != nil {
+		baseInfo, err := d.inspectCommit(ctx, base, pfs.CommitState_FINISHED)
+		if err != nil {
+			return err
+		}
+		if pfsdb.RepoKey(baseInfo.Commit.Branch.Repo) != pfsdb.RepoKey(repo) {
+			return errors.Errorf("base commit %s is not in repo %s", base, repo)
+		}
+		if err := writeBundleMessage(tw, bundleBase, baseInfo.Commit); err != nil {
+			return err
+		}
+		for ci := baseInfo; ci != nil; {
+			exportedCommits[pfsdb.CommitKey(ci.Commit)] = true
+			if ci.ParentCommit == nil {
+				break
+			}
+			ci = commitInfos[pfsdb.CommitKey(ci.ParentCommit)]
+		}
+		id, err := d.getFileSet(ctx, baseInfo.Commit)
+		if err != nil {
+			return err
+		}
+		fs, err := d.storage.Open(ctx, []fileset.ID{*id})
+		if err != nil {
+			return err
+		}
+		if err := fs.Iterate(ctx, func(f fileset.File) error {
+			for _, dataRef := range f.Index().File.DataRefs {
+				exportedChunks[string(dataRef.Ref.Id)] = true
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	for _, ci := range sortCommitInfos(commitInfos) {
+		key := pfsdb.CommitKey(ci.Commit)
+		if exportedCommits[key] || ci.Finished == nil {
+			continue
+		}
+		if err := d.exportCommit(ctx, tw, ci, exportedChunks); err != nil {
+			return err
+		}
+		exportedCommits[key] = true
+	}
+	var branchInfos []*pfs.BranchInfo
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadOnly(ctx).GetByIndex(pfsdb.BranchesRepoIndex, pfsdb.RepoKey(repo), branchInfo, col.DefaultOptions(), func(string) error {
+		branchInfos = append(branchInfos, proto.Clone(branchInfo).(*pfs.BranchInfo))
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, bi := range branchInfos {
+		head := bi.Head
+		for head != nil && !exportedCommits[pfsdb.CommitKey(head)] {
+			ci, ok := commitInfos[pfsdb.CommitKey(head)]
+			if !ok {
+				head = nil
+				break
+			}
+			head = ci.ParentCommit
+		}
+		if head == nil {
+			continue
+		}
+		bi.Head = head
+		if err := writeBundleMessage(tw, path.Join(bundleBranches, bi.Branch.Name), bi); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// sortCommitInfos returns commitInfos ordered by the time they were started,
+// with parents before their children.
+func sortCommitInfos(commitInfos map[string]*pfs.CommitInfo) []*pfs.CommitInfo {
+	var keys []string
+	for key := range commitInfos {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := commitInfos[keys[i]].Started, commitInfos[keys[j]].Started
+		if timestampBefore(a, b) || timestampBefore(b, a) {
+			return timestampBefore(a, b)
+		}
+		return keys[i] < keys[j]
+	})
+	var result []*pfs.CommitInfo
+	visited := make(map[string]bool)
+	var visit func(key string)
+	visit = func(key string) {
+		ci, ok := commitInfos[key]
+		if !ok || visited[key] {
+			return
+		}
+		visited[key] = true
+		if ci.ParentCommit != nil {
+			visit(pfsdb.CommitKey(ci.ParentCommit))
+		}
+		result = append(result, ci)
+	}
+	for _, key := range keys {
+		visit(key)
+	}
+	return result
+}
+
+// exportCommit writes commitInfo and its diff to tw, preceded by the chunks
+// referenced by the diff which aren't in exportedChunks.
+func (d *driver) exportCommit(ctx context.Context, tw *tar.Writer, commitInfo *pfs.CommitInfo, exportedChunks map[string]bool) error {
+	id, err := d.commitStore.GetDiffFileSet(ctx, commitInfo.Commit)
+	if err != nil {
+		return err
+	}
+	fs, err := d.storage.Open(ctx, []fileset.ID{*id})
+	if err != nil {
+		return err
+	}
+	var chunkIDs []chunk.ID
+	collect := func(chunkID chunk.ID) error {
+		if !exportedChunks[string(chunkID)] {
+			exportedChunks[string(chunkID)] = true
+			chunkIDs = append(chunkIDs, chunkID)
+		}
+		return nil
+	}
+	// The indexes are buffered, since the chunks they reference have to be
+	// written first.
+	var deletive, additive bytes.Buffer
+	if err := d.storage.ExportIndexes(ctx, fs, &deletive, true, collect); err != nil {
+		return err
+	}
+	if err := d.storage.ExportIndexes(ctx, fs, &additive, false, collect); err != nil {
+		return err
+	}
+	for _, chunkID := range chunkIDs {
+		if err := d.storage.ChunkStorage().Export(ctx, chunkID, func(data []byte) error {
+			return writeBundleEntry(tw, path.Join(bundleChunks, chunkID.HexString()), data)
+		}); err != nil {
+			return err
+		}
+	}
+	commitID := commitInfo.Commit.ID
+	if err := writeBundleMessage(tw, path.Join(bundleCommits, commitID), commitInfo); err != nil {
+		return err
+	}
+	if err := writeBundleEntry(tw, path.Join(bundleDeletive, commitID), deletive.Bytes()); err != nil {
+		return err
+	}
+	return writeBundleEntry(tw, path.Join(bundleAdditive, commitID), additive.Bytes())
+}
+
+func writeBundleMessage(tw *tar.Writer, name string, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	return writeBundleEntry(tw, name, data)
+}
+
+func writeBundleEntry(tw *tar.Writer, name string, data []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Size: int64(len(data)),
+		Mode: 0600,
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err := tw.Write(data)
+	return errors.EnsureStack(err)
+}
+
+// importRepo imports the bundle read from r into repo, or into the repo it was
+// exported from if repo is nil. A full bundle creates the repo, while an
+// incremental bundle is imported into the existing repo that holds its base
+// commit. Commits which already exist in the repo are skipped, and commits
+// whose IDs are used by other commits are imported with new IDs. Imported
+// commits record the exported commit in their origin, so the base commit and
+// existing commits are found by the IDs they were exported with.
+func (d *driver) importRepo(ctx context.Context, repo *pfs.Repo, r io.Reader) (*pfs.ImportRepoResponse, error) {
+	tr := tar.NewReader(r)
+	repoInfo := &pfs.RepoInfo{}
+	if err := readBundleMessage(tr, bundleRepo, repoInfo); err != nil {
+		return nil, err
+	}
+	if repo == nil {
+		repo = repoInfo.Repo
+	}
+	if repo.Type == "" {
+		repo.Type = pfs.UserRepoType
+	}
+	response := &pfs.ImportRepoResponse{
+		Repo:      repo,
+		CommitIds: make(map[string]string),
+	}
+	// commitIDs maps the IDs of all the exported commits in repo which were
+	// imported with new IDs, including those from earlier bundles.
+	commitIDs := make(map[string]string)
+	hdr, err := tr.Next()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.EnsureStack(err)
+	}
+	if hdr != nil && hdr.Name == bundleBase {
+		base := &pfs.Commit{}
+		if err := readBundleData(tr, base); err != nil {
+			return nil, err
+		}
+		if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_WRITE); err != nil {
+			return nil, err
+		}
+		if err := d.importedCommitIDs(ctx, repo, commitIDs); err != nil {
+			return nil, err
+		}
+		exists, err := d.commitExists(ctx, importedCommit(repo, base, commitIDs))
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, errors.Errorf("cannot import an incremental bundle into %s: it does not have the base commit %s", repo, base.ID)
+		}
+		if hdr, err = tr.Next(); err != nil && !errors.Is(err, io.EOF) {
+			return nil, errors.EnsureStack(err)
+		}
+	} else if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if err := d.createRepo(txnCtx, repo, repoInfo.Description, false); err != nil {
+			return err
+		}
+		if len(repoInfo.Metadata) == 0 {
+			return nil
+		}
+		newRepoInfo := &pfs.RepoInfo{}
+		if err := d.repos.ReadWrite(txnCtx.SqlTx).Update(pfsdb.RepoKey(repo), newRepoInfo, func() error {
+			newRepoInfo.Metadata = repoInfo.Metadata
+			return nil
+		}); err != nil {
+			return err
+		}
+		return d.indexRepoMetadata(txnCtx, repo, repoInfo.Metadata)
+	}); err != nil {
+		return nil, err
+	}
+	importer := d.storage.ChunkStorage().NewImporter("import-repo-" + uuid.NewWithoutDashes())
+	defer importer.Close()
+	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *renew.StringSet) error {
+		// commitInfo is the commit whose diff is being imported, and w is the
+		// writer for the diff, which is nil if the commit already exists.
+		var commitInfo *pfs.CommitInfo
+		var w *fileset.Writer
+		for ; hdr != nil; hdr, err = tr.Next() {
+			dir, name := path.Split(hdr.Name)
+			switch strings.TrimSuffix(dir, "/") {
+			case bundleChunks:
+				chunkID, err := chunk.IDFromHex(name)
+				if err != nil {
+					return errors.Wrapf(err, "invalid bundle entry %s", hdr.Name)
+				}
+				data, err := ioutil.ReadAll(tr)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				uploaded, err := importer.Import(ctx, chunkID, data)
+				if err != nil {
+					return err
+				}
+				response.Chunks++
+				if !uploaded {
+					response.DeduplicatedChunks++
+				}
+			case bundleCommits:
+				commitInfo = &pfs.CommitInfo{}
+				if err := readBundleData(tr, commitInfo); err != nil {
+					return err
+				}
+				w = nil
+				exists, err := d.commitExists(ctx, importedCommit(repo, commitInfo.Commit, commitIDs))
+				if err != nil {
+					return err
+				}
+				if !exists {
+					w = d.storage.NewWriter(ctx, d.writerOptions(repo)...)
+				}
+			case bundleDeletive, bundleAdditive:
+				if commitInfo == nil || name != commitInfo.Commit.ID {
+					return errors.Errorf("invalid bundle: unexpected entry %s", hdr.Name)
+				}
+				if w == nil {
+					continue
+				}
+				deletive := dir == bundleDeletive+"/"
+				if err := d.storage.ImportIndexes(ctx, tr, w, deletive); err != nil {
+					return err
+				}
+				if deletive {
+					continue
+				}
+				id, err := w.Close()
+				if err != nil {
+					return err
+				}
+				renewer.Add(id.HexString())
+				if err := d.importCommit(ctx, repo, commitInfo, *id, commitIDs); err != nil {
+					return err
+				}
+				if newID, ok := commitIDs[commitInfo.Commit.ID]; ok {
+					response.CommitIds[commitInfo.Commit.ID] = newID
+				}
+				response.Commits++
+				commitInfo, w = nil, nil
+			case bundleBranches:
+				branchInfo := &pfs.BranchInfo{}
+				if err := readBundleData(tr, branchInfo); err != nil {
+					return err
+				}
+				if err := d.importBranch(ctx, repo, branchInfo, commitIDs); err != nil {
+					return err
+				}
+			default:
+				return errors.Errorf("invalid bundle: unexpected entry %s", hdr.Name)
+			}
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return errors.EnsureStack(err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// importedCommitIDs adds the IDs of the commits in repo which were imported
+// with a different ID than they were exported with to commitIDs.
+func (d *driver) importedCommitIDs(ctx context.Context, repo *pfs.Repo, commitIDs map[string]string) error {
+	commitInfo := &pfs.CommitInfo{}
+	return d.commits.ReadOnly(ctx).GetByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repo), commitInfo, col.DefaultOptions(), func(string) error {
+		if imported := commitInfo.Origin.GetImported(); imported != nil && imported.ID != commitInfo.Commit.ID {
+			commitIDs[imported.ID] = commitInfo.Commit.ID
+		}
+		return nil
+	})
+}
+
+// commitExists returns whether a commit with the ID of commit exists in its
+// repo, on any branch.
+func (d *driver) commitExists(ctx context.Context, commit *pfs.Commit) (bool, error) {
+	var exists bool
+	commitInfo := &pfs.CommitInfo{}
+	if err := d.commits.ReadOnly(ctx).GetByIndex(pfsdb.CommitsBranchlessIndex, pfsdb.CommitBranchlessKey(commit), commitInfo, col.DefaultOptions(), func(string) error {
+		exists = true
+		return errutil.ErrBreak
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return false, err
+	}
+	return exists, nil
+}
+
+// importCommit creates the commit in repo described by the exported
+// commitInfo, with the diff id. The commit keeps its ID unless the ID is used
+// by another commit, in which case a new ID is recorded in commitIDs.
+func (d *driver) importCommit(ctx context.Context, repo *pfs.Repo, commitInfo *pfs.CommitInfo, id fileset.ID, commitIDs map[string]string) error {
+	if !commitInfo.Error {
+		// The quota usage is computed before the transaction, like when
+		// finishing a commit.
+		openCommitInfo := &pfs.CommitInfo{Commit: importedCommit(repo, commitInfo.Commit, commitIDs)}
+		if commitInfo.ParentCommit != nil {
+			openCommitInfo.ParentCommit = importedCommit(repo, commitInfo.ParentCommit, commitIDs)
+		}
+		d.precomputeQuotas(ctx, openCommitInfo, []fileset.ID{id})
+	}
+	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		commitID := commitInfo.Commit.ID
+		var collides bool
+		existing := &pfs.CommitInfo{}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).GetByIndex(pfsdb.CommitsCommitSetIndex, commitID, existing, col.DefaultOptions(), func(string) error {
+			collides = true
+			return errutil.ErrBreak
+		}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+			return err
+		}
+		if collides {
+			commitIDs[commitID] = uuid.NewWithoutDashes()
+		}
+		newCommitInfo := proto.Clone(commitInfo).(*pfs.CommitInfo)
+		newCommitInfo.Commit = importedCommit(repo, commitInfo.Commit, commitIDs)
+		newCommitInfo.ChildCommits = nil
+		newCommitInfo.DirectProvenance = nil
+		// The imported repo has no provenance, so there is nothing for alias
+		// commits to alias.
+		if newCommitInfo.Origin.GetKind() == pfs.OriginKind_ALIAS {
+			newCommitInfo.Origin = &pfs.CommitOrigin{Kind: pfs.OriginKind_AUTO}
+		}
+		if newCommitInfo.Origin == nil {
+			newCommitInfo.Origin = &pfs.CommitOrigin{}
+		}
+		newCommitInfo.Origin.Imported = commitInfo.Commit
+		if commitInfo.ParentCommit != nil {
+			parentCommitInfo, err := d.resolveCommit(txnCtx.SqlTx, importedCommit(repo, commitInfo.ParentCommit, commitIDs))
+			if err != nil {
+				return errors.Wrapf(err, "parent of imported commit %s not found", commitInfo.Commit)
+			}
+			newCommitInfo.ParentCommit = parentCommitInfo.Commit
+			parentCommitInfo.ChildCommits = append(parentCommitInfo.ChildCommits, newCommitInfo.Commit)
+			if err := d.commits.ReadWrite(txnCtx.SqlTx).Put(pfsdb.CommitKey(parentCommitInfo.Commit), parentCommitInfo); err != nil {
+				return err
+			}
+		}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).Create(pfsdb.CommitKey(newCommitInfo.Commit), newCommitInfo); err != nil {
+			return err
+		}
+		if err := d.commitStore.AddFileSetTx(txnCtx.SqlTx, newCommitInfo.Commit, id); err != nil {
+			return err
+		}
+		if !newCommitInfo.Error {
+			if err := d.checkCommitQuotas(txnCtx, newCommitInfo); err != nil {
+				return err
+			}
+		}
+		return d.indexCommitMetadata(txnCtx, newCommitInfo.Commit, newCommitInfo.Metadata)
+	})
+}
+
+// importBranch creates or updates the branch in repo described by the
+// exported branchInfo. The branch's provenance isn't imported. Moving the head
+// of an existing branch is subject to its protection, like CreateBranch, and is
+// propagated to its subvenance.
+func (d *driver) importBranch(ctx context.Context, repo *pfs.Repo, branchInfo *pfs.BranchInfo, commitIDs map[string]string) error {
+	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		branch := repo.NewBranch(branchInfo.Branch.Name)
+		head, err := d.resolveCommit(txnCtx.SqlTx, importedCommit(repo, branchInfo.Head, commitIDs))
+		if err != nil {
+			return errors.Wrapf(err, "head of imported branch %s not found", branch)
+		}
+		newBranchInfo := &pfs.BranchInfo{}
+		if err := d.branches.ReadWrite(txnCtx.SqlTx).Upsert(pfsdb.BranchKey(branch), newBranchInfo, func() error {
+			if newBranchInfo.Branch == nil {
+				repoInfo := &pfs.RepoInfo{}
+				if err := d.repos.ReadWrite(txnCtx.SqlTx).Update(pfsdb.RepoKey(repo), repoInfo, func() error {
+					add(&repoInfo.Branches, branch)
+					return nil
+				}); err != nil {
+					return err
+				}
+				newBranchInfo.Branch = branch
+				newBranchInfo.Trigger = branchInfo.Trigger
+				newBranchInfo.Metadata = branchInfo.Metadata
+			} else if len(newBranchInfo.Provenance) > 0 {
+				return pfsserver.ErrCommitOnOutputBranch{Branch: branch}
+			} else if newBranchInfo.Head.ID != head.Commit.ID {
+				if err := d.checkBranchProtection(txnCtx, newBranchInfo, forbidCreateBranch(head.Commit)); err != nil {
+					return err
+				}
+			}
+			newBranchInfo.Head = head.Commit
+			return nil
+		}); err != nil {
+			return err
+		}
+		return txnCtx.PropagateBranch(branch)
+	})
+}
+
+// importedCommit returns the commit in repo that the exported commit was
+// imported as.
+func importedCommit(repo *pfs.Repo, commit *pfs.Commit, commitIDs map[string]string) *pfs.Commit {
+	id := commit.ID
+	if newID, ok := commitIDs[id]; ok {
+		id = newID
+	}
+	return repo.NewCommit(commit.Branch.Name, id)
+}
+
+func readBundleMessage(tr *tar.Reader, name string, msg proto.Message) error {
+	hdr, err := tr.Next()
+	if err != nil {
+		return errors.Wrapf(err, "invalid bundle: expected the %s entry", name)
+	}
+	if hdr.Name != name {
+		return errors.Errorf("invalid bundle: expected the %s entry, got %s", name, hdr.Name)
+	}
+	return readBundleData(tr, msg)
+}
+
+func readBundleData(tr *tar.Reader, msg proto.Message) error {
+	data, err := ioutil.ReadAll(tr)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(proto.Unmarshal(data, msg))
+}
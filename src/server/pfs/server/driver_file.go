@@ -1,6 +1,7 @@
 package server
 
 import (
+	"io"
 	"path"
 	"path/filepath"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
@@ -55,12 +57,14 @@ func (d *driver) modifyFile(ctx context.Context, commit *pfs.Commit, cb func(*fi
 }
 
 func (d *driver) oneOffModifyFile(ctx context.Context, renewer *renew.StringSet, branch *pfs.Branch, cb func(*fileset.UnorderedWriter) error, opts ...fileset.UnorderedWriterOption) error {
-	id, err := d.withUnorderedWriter(ctx, renewer, false, cb, opts...)
+	id, err := d.withUnorderedWriter(ctx, renewer, branch.Repo, false, cb, opts...)
 	if err != nil {
 		return err
 	}
-	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		commit, err := d.startCommit(txnCtx, nil, branch, "")
+	var commit *pfs.Commit
+	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		commit, err = d.startCommit(txnCtx, nil, branch, "")
 		if err != nil {
 			return err
 		}
@@ -68,7 +72,10 @@ func (d *driver) oneOffModifyFile(ctx context.Context, renewer *renew.StringSet,
 			return err
 		}
 		return d.finishCommit(txnCtx, commit, "", false, false)
-	})
+	}); err != nil {
+		return err
+	}
+	return nil
 }
 
 // withCommitWriter calls cb with an unordered writer. All data written to cb is added to the commit, or an error is returned.
@@ -78,15 +85,24 @@ func (d *driver) withCommitUnorderedWriter(ctx context.Context, renewer *renew.S
 		return err
 	}
 	renewer.Add(parentID.HexString())
-	id, err := d.withUnorderedWriter(ctx, renewer, false, cb, fileset.WithParentID(parentID))
+	id, err := d.withUnorderedWriter(ctx, renewer, commit.Branch.Repo, false, cb, fileset.WithParentID(parentID))
 	if err != nil {
 		return err
 	}
+	if err := d.checkQuotas(ctx, commit.Branch.Repo, []fileset.ID{*parentID, *id}); err != nil {
+		return err
+	}
 	return d.commitStore.AddFileSet(ctx, commit, *id)
 }
 
-func (d *driver) withUnorderedWriter(ctx context.Context, renewer *renew.StringSet, compact bool, cb func(*fileset.UnorderedWriter) error, opts ...fileset.UnorderedWriterOption) (*fileset.ID, error) {
+// withUnorderedWriter calls cb with an unordered writer for data written to
+// repo, which may be nil if the data isn't written to a specific repo, and
+// returns the ID of the written fileset.
+func (d *driver) withUnorderedWriter(ctx context.Context, renewer *renew.StringSet, repo *pfs.Repo, compact bool, cb func(*fileset.UnorderedWriter) error, opts ...fileset.UnorderedWriterOption) (*fileset.ID, error) {
 	opts = append([]fileset.UnorderedWriterOption{fileset.WithRenewal(defaultTTL, renewer), fileset.WithValidator(validate)}, opts...)
+	if compression := d.compression(repo); compression != nil {
+		opts = append(opts, fileset.WithCompression(compression.Algo, int(compression.Level)))
+	}
 	uw, err := d.storage.NewUnorderedWriter(ctx, opts...)
 	if err != nil {
 		return nil, err
@@ -102,7 +118,7 @@ func (d *driver) withUnorderedWriter(ctx context.Context, renewer *renew.StringS
 		renewer.Add(id.HexString())
 		return id, nil
 	}
-	compactedID, err := d.storage.Compact(ctx, []fileset.ID{*id}, defaultTTL)
+	compactedID, err := d.storage.CompactWithWriterOptions(ctx, []fileset.ID{*id}, defaultTTL, d.writerOptions(repo))
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +208,73 @@ func (d *driver) getFile(ctx context.Context, file *pfs.File) (Source, error) {
 	return NewErrOnEmpty(s, &pfsserver.ErrFileNotFound{File: file}), nil
 }
 
-func (d *driver) inspectFile(ctx context.Context, file *pfs.File) (*pfs.FileInfo, error) {
+// getFileRange writes size bytes of the content of file (all of the bytes
+// after offset if size is 0) starting at offset to w. Only the chunks that
+// overlap the range are read. The content of a file written under several
+// tags is the concatenation of its parts, in the order they are merged.
+func (d *driver) getFileRange(ctx context.Context, file *pfs.File, offset, size int64, w io.Writer) error {
+	if offset < 0 || size < 0 {
+		return errors.Errorf("invalid range: offset and size must not be negative")
+	}
+	p := cleanPath(file.Path)
+	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithPrefix(p), index.WithTag(file.Tag))
+	if err != nil {
+		return err
+	}
+	opts := []SourceOption{
+		WithFilter(func(fs fileset.FileSet) fileset.FileSet {
+			return fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+				return idx.Path == p
+			})
+		}),
+	}
+	s := NewSource(commitInfo, fs, opts...)
+	var dataRefs []*chunk.DataRef
+	var found bool
+	if err := s.Iterate(ctx, func(fi *pfs.FileInfo, f fileset.File) error {
+		if fi.File.Path != p || fi.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		found = true
+		dataRefs = append(dataRefs, f.Index().File.DataRefs...)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if !found {
+		return &pfsserver.ErrFileNotFound{File: file}
+	}
+	return d.storage.ChunkStorage().NewReader(ctx, chunk.RangeDataRefs(dataRefs, offset, size)).Get(w)
+}
+
+func (d *driver) inspectFile(ctx context.Context, file *pfs.File, history int64) (*pfs.FileInfo, error) {
+	if history == 0 {
+		return d.inspectFileAt(ctx, file)
+	}
+	var ret *pfs.FileInfo
+	var n int64
+	if err := d.fileHistory(ctx, file, history, func(ctx context.Context, file *pfs.File, cb func(*pfs.FileInfo) error) error {
+		fi, err := d.inspectFileAt(ctx, file)
+		if err != nil || fi == nil {
+			return err
+		}
+		return cb(fi)
+	}, func(fi *pfs.FileInfo) error {
+		n++
+		if history < 0 || n <= history {
+			ret = fi
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return nil, &pfsserver.ErrFileNotFound{File: file}
+	}
+	return ret, nil
+}
+
+func (d *driver) inspectFileAt(ctx context.Context, file *pfs.File) (*pfs.FileInfo, error) {
 	p := cleanPath(file.Path)
 	if p == "/" {
 		p = ""
@@ -223,9 +305,21 @@ func (d *driver) inspectFile(ctx context.Context, file *pfs.File) (*pfs.FileInfo
 	return ret, nil
 }
 
-func (d *driver) listFile(ctx context.Context, file *pfs.File, cb func(*pfs.FileInfo) error) error {
+// listFile calls cb with the files in the directory at file. If start is set,
+// only the files with paths at or after start are listed, which is not
+// supported with history.
+func (d *driver) listFile(ctx context.Context, file *pfs.File, history int64, start string, cb func(*pfs.FileInfo) error) error {
+	if history == 0 {
+		return d.listFileAt(ctx, file, start, cb)
+	}
+	return d.fileHistory(ctx, file, history, func(ctx context.Context, file *pfs.File, cb func(*pfs.FileInfo) error) error {
+		return d.listFileAt(ctx, file, "", cb)
+	}, cb)
+}
+
+func (d *driver) listFileAt(ctx context.Context, file *pfs.File, start string, cb func(*pfs.FileInfo) error) error {
 	name := cleanPath(file.Path)
-	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithPrefix(name), index.WithTag(file.Tag))
+	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithPrefix(name), index.WithRange(&index.PathRange{Lower: start}), index.WithTag(file.Tag))
 	if err != nil {
 		return err
 	}
@@ -247,6 +341,10 @@ func (d *driver) listFile(ctx context.Context, file *pfs.File, cb func(*pfs.File
 	}
 	s := NewSource(commitInfo, fs, opts...)
 	return s.Iterate(ctx, func(fi *pfs.FileInfo, _ fileset.File) error {
+		// Parent directories before start are still inserted by the source.
+		if fi.File.Path < start {
+			return nil
+		}
 		if pathIsChild(name, cleanPath(fi.File.Path)) {
 			return cb(fi)
 		}
@@ -254,7 +352,140 @@ func (d *driver) listFile(ctx context.Context, file *pfs.File, cb func(*pfs.File
 	})
 }
 
-func (d *driver) walkFile(ctx context.Context, file *pfs.File, cb func(*pfs.FileInfo) error) (retErr error) {
+// fileHistory calls cb with up to history versions (all versions if history
+// is negative) of each file returned by list at file.Commit, newest first. The
+// commit's ancestors are walked back through their diff filesets, and each
+// version is reported at the commit that introduced it. A file's history ends
+// at the commit that created it.
+func (d *driver) fileHistory(ctx context.Context, file *pfs.File, history int64, list func(context.Context, *pfs.File, func(*pfs.FileInfo) error) error, cb func(*pfs.FileInfo) error) error {
+	listAt := func(commit *pfs.Commit) (map[string]*pfs.FileInfo, []string, error) {
+		fis := make(map[string]*pfs.FileInfo)
+		var paths []string
+		f := proto.Clone(file).(*pfs.File)
+		f.Commit = commit
+		if err := list(ctx, f, func(fi *pfs.FileInfo) error {
+			fis[fi.File.Path] = fi
+			paths = append(paths, fi.File.Path)
+			return nil
+		}); err != nil && !pfsserver.IsFileNotFoundErr(err) {
+			return nil, nil, err
+		}
+		return fis, paths, nil
+	}
+	commitInfo, err := d.inspectCommit(ctx, file.Commit, pfs.CommitState_STARTED)
+	if err != nil {
+		return err
+	}
+	pending, paths, err := listAt(commitInfo.Commit)
+	if err != nil {
+		return err
+	}
+	versions := make(map[string][]*pfs.FileInfo)
+	for commitInfo != nil && len(pending) > 0 {
+		parentInfo, err := d.parentCommitInfo(ctx, commitInfo)
+		if err != nil {
+			return err
+		}
+		changed, err := d.changedPaths(ctx, commitInfo.Commit, file.Path)
+		if err != nil {
+			return err
+		}
+		var parentFis map[string]*pfs.FileInfo
+		if parentInfo != nil && len(changed) > 0 {
+			if parentFis, _, err = listAt(parentInfo.Commit); err != nil {
+				return err
+			}
+		}
+		for p, fi := range pending {
+			if parentInfo != nil && !pathChanged(changed, p) {
+				continue
+			}
+			fi.File.Commit = commitInfo.Commit
+			fi.Committed = commitInfo.Finished
+			versions[p] = append(versions[p], fi)
+			parentFi, ok := parentFis[p]
+			if !ok || (history > 0 && int64(len(versions[p])) >= history) {
+				delete(pending, p)
+				continue
+			}
+			pending[p] = parentFi
+		}
+		commitInfo = parentInfo
+	}
+	for _, p := range paths {
+		for _, fi := range versions[p] {
+			if err := cb(fi); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parentCommitInfo returns the closest ancestor of commitInfo that did not
+// error, or nil if there is none.
+func (d *driver) parentCommitInfo(ctx context.Context, commitInfo *pfs.CommitInfo) (*pfs.CommitInfo, error) {
+	for commitInfo.ParentCommit != nil {
+		var err error
+		commitInfo, err = d.inspectCommit(ctx, commitInfo.ParentCommit, pfs.CommitState_STARTED)
+		if err != nil {
+			return nil, err
+		}
+		if !commitInfo.Error {
+			return commitInfo, nil
+		}
+	}
+	return nil, nil
+}
+
+// changedPaths returns the paths under prefix that were written or deleted in
+// the commit's diff fileset.
+func (d *driver) changedPaths(ctx context.Context, commit *pfs.Commit, prefix string) (map[string]struct{}, error) {
+	id, err := d.commitStore.GetDiffFileSet(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	fs, err := d.storage.Open(ctx, []fileset.ID{*id}, index.WithPrefix(cleanPath(prefix)))
+	if err != nil {
+		return nil, err
+	}
+	changed := make(map[string]struct{})
+	for _, deletive := range []bool{false, true} {
+		if err := fs.Iterate(ctx, func(f fileset.File) error {
+			changed[f.Index().Path] = struct{}{}
+			return nil
+		}, deletive); err != nil {
+			return nil, err
+		}
+	}
+	return changed, nil
+}
+
+// pathChanged returns true if p, or any path under p if p is a directory, is
+// in changed.
+func pathChanged(changed map[string]struct{}, p string) bool {
+	if _, ok := changed[p]; ok {
+		return true
+	}
+	if !fileset.IsDir(p) {
+		return false
+	}
+	for c := range changed {
+		if strings.HasPrefix(c, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *driver) walkFile(ctx context.Context, file *pfs.File, history int64, cb func(*pfs.FileInfo) error) error {
+	if history == 0 {
+		return d.walkFileAt(ctx, file, cb)
+	}
+	return d.fileHistory(ctx, file, history, d.walkFileAt, cb)
+}
+
+func (d *driver) walkFileAt(ctx context.Context, file *pfs.File, cb func(*pfs.FileInfo) error) error {
 	p := cleanPath(file.Path)
 	if p == "/" {
 		p = ""
@@ -281,9 +512,11 @@ func (d *driver) walkFile(ctx context.Context, file *pfs.File, cb func(*pfs.File
 	return err
 }
 
-func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob string, cb func(*pfs.FileInfo) error) error {
+// globFile calls cb with the files matching glob. If start is set, only the
+// files with paths at or after start are listed.
+func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob string, start string, cb func(*pfs.FileInfo) error) error {
 	glob = cleanPath(glob)
-	commitInfo, fs, err := d.openCommit(ctx, commit, index.WithPrefix(globLiteralPrefix(glob)))
+	commitInfo, fs, err := d.openCommit(ctx, commit, index.WithPrefix(globLiteralPrefix(glob)), index.WithRange(&index.PathRange{Lower: start}))
 	if err != nil {
 		return err
 	}
@@ -300,6 +533,9 @@ func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob string,
 	}
 	s := NewSource(commitInfo, fs, opts...)
 	return s.Iterate(ctx, func(fi *pfs.FileInfo, _ fileset.File) error {
+		if fi.File.Path < start {
+			return nil
+		}
 		if mf(fi.File.Path) {
 			return cb(fi)
 		}
@@ -307,39 +543,91 @@ func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob string,
 	})
 }
 
-func (d *driver) diffFile(ctx context.Context, oldFile, newFile *pfs.File, cb func(oldFi, newFi *pfs.FileInfo) error) error {
+// diffFile calls cb with the files that differ between oldFile and newFile,
+// which may be in any commits of any repos. If oldFile is nil, newFile is
+// compared with the same path in the nearest ancestor of its commit that didn't
+// fail. If shallow is true, only the files directly under the paths are
+// reported, with the directories that contain differences.
+func (d *driver) diffFile(ctx context.Context, oldFile, newFile *pfs.File, shallow bool, cb func(oldFi, newFi *pfs.FileInfo) error) error {
+	differ, err := d.newDiffer(ctx, oldFile, newFile)
+	if err != nil {
+		return err
+	}
+	if !shallow {
+		return differ.Iterate(ctx, cb)
+	}
+	newName := cleanPath(newFile.Path)
+	oldName := newName
+	if oldFile != nil {
+		oldName = cleanPath(oldFile.Path)
+	}
+	return differ.Iterate(ctx, func(oldFi, newFi *pfs.FileInfo) error {
+		if oldFi != nil && !pathIsChild(oldName, cleanPath(oldFi.File.Path)) {
+			return nil
+		}
+		if newFi != nil && !pathIsChild(newName, cleanPath(newFi.File.Path)) {
+			return nil
+		}
+		return cb(oldFi, newFi)
+	})
+}
+
+// diffFileSummary returns a summary of the differences between oldFile and
+// newFile, which are resolved as in diffFile.
+func (d *driver) diffFileSummary(ctx context.Context, oldFile, newFile *pfs.File) (*pfs.DiffFileSummary, error) {
+	differ, err := d.newDiffer(ctx, oldFile, newFile)
+	if err != nil {
+		return nil, err
+	}
+	return differ.Summarize(ctx)
+}
+
+func (d *driver) newDiffer(ctx context.Context, oldFile, newFile *pfs.File) (*Differ, error) {
 	// TODO: move validation to the Validating API Server
 	// Validation
 	if newFile == nil {
-		return errors.New("file cannot be nil")
+		return nil, errors.New("file cannot be nil")
 	}
 	if newFile.Commit == nil {
-		return errors.New("file commit cannot be nil")
+		return nil, errors.New("file commit cannot be nil")
 	}
 	if newFile.Commit.Branch == nil {
-		return errors.New("file commit branch cannot be nil")
+		return nil, errors.New("file commit branch cannot be nil")
 	}
 	if newFile.Commit.Branch.Repo == nil {
-		return errors.New("file commit repo cannot be nil")
+		return nil, errors.New("file commit repo cannot be nil")
 	}
 	// Do READER authorization check for both newFile and oldFile
 	if oldFile != nil && oldFile.Commit != nil {
 		if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, oldFile.Commit.Branch.Repo, auth.Permission_REPO_READ); err != nil {
-			return err
+			return nil, err
 		}
 	}
 	if newFile != nil && newFile.Commit != nil {
 		if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, newFile.Commit.Branch.Repo, auth.Permission_REPO_READ); err != nil {
-			return err
+			return nil, err
 		}
 	}
 	newCommitInfo, err := d.inspectCommit(ctx, newFile.Commit, pfs.CommitState_STARTED)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if oldFile == nil {
+		// Failed commits (such as the output commits of failed jobs) are
+		// skipped, as their content isn't part of the branch's history.
+		parentCommit := newCommitInfo.ParentCommit
+		for parentCommit != nil {
+			parentCommitInfo, err := d.getCommit(ctx, parentCommit)
+			if err != nil {
+				return nil, err
+			}
+			if !parentCommitInfo.Error {
+				break
+			}
+			parentCommit = parentCommitInfo.ParentCommit
+		}
 		oldFile = &pfs.File{
-			Commit: newCommitInfo.ParentCommit,
+			Commit: parentCommit,
 			Path:   newFile.Path,
 		}
 	}
@@ -357,7 +645,7 @@ func (d *driver) diffFile(ctx context.Context, oldFile, newFile *pfs.File, cb fu
 	if oldCommit != nil {
 		oldCommitInfo, fs, err := d.openCommit(ctx, oldCommit, index.WithPrefix(oldName), index.WithTag(oldFile.Tag))
 		if err != nil {
-			return err
+			return nil, err
 		}
 		opts := []SourceOption{
 			WithFilter(func(fs fileset.FileSet) fileset.FileSet {
@@ -370,7 +658,7 @@ func (d *driver) diffFile(ctx context.Context, oldFile, newFile *pfs.File, cb fu
 	}
 	newCommitInfo, fs, err := d.openCommit(ctx, newCommit, index.WithPrefix(newName), index.WithTag(newFile.Tag))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	opts := []SourceOption{
 		WithFilter(func(fs fileset.FileSet) fileset.FileSet {
@@ -380,16 +668,17 @@ func (d *driver) diffFile(ctx context.Context, oldFile, newFile *pfs.File, cb fu
 		}),
 	}
 	new := NewSource(newCommitInfo, fs, opts...)
-	diff := NewDiffer(old, new)
-	return diff.Iterate(ctx, cb)
+	return NewDiffer(old, new), nil
 }
 
-// createFileSet creates a new temporary fileset and returns it.
-func (d *driver) createFileSet(ctx context.Context, cb func(*fileset.UnorderedWriter) error) (*fileset.ID, error) {
+// createFileSet creates a new temporary fileset and returns it. repo, if set,
+// is the repo the fileset is going to be added to, whose storage options (such
+// as compression) are used to write it.
+func (d *driver) createFileSet(ctx context.Context, repo *pfs.Repo, cb func(*fileset.UnorderedWriter) error) (*fileset.ID, error) {
 	var id *fileset.ID
 	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *renew.StringSet) error {
 		var err error
-		id, err = d.withUnorderedWriter(ctx, renewer, false, cb)
+		id, err = d.withUnorderedWriter(ctx, renewer, repo, false, cb)
 		return err
 	}); err != nil {
 		return nil, err
@@ -491,7 +780,7 @@ func (d *driver) getOrComputeTotal(ctx context.Context, commit *pfs.Commit) (*fi
 		parentCommit = commitInfo.ParentCommit
 	}
 	inputs = append(inputs, *id)
-	output, err := d.compactor.Compact(ctx, inputs, defaultTTL)
+	output, err := d.compactor.compact(ctx, inputs, defaultTTL, d.compression(commit.Branch.Repo))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"golang.org/x/net/context"
+)
+
+// forkRepo creates the repo dst, with a branch for each of branches of src, or
+// for all of its branches if branches is empty. Each branch starts at a commit
+// with the fileset of the head of the source branch, so no data is copied, and
+// the fork can be written to independently of src.
+func (d *driver) forkRepo(ctx context.Context, src, dst *pfs.Repo, branches []string, description string) error {
+	if src == nil || dst == nil {
+		return errors.New("source and destination repos must be specified")
+	}
+	if src.Type == "" {
+		src.Type = pfs.UserRepoType
+	}
+	if len(branches) == 0 {
+		repoInfo := &pfs.RepoInfo{}
+		if err := d.repos.ReadOnly(ctx).Get(pfsdb.RepoKey(src), repoInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return pfsserver.ErrRepoNotFound{Repo: src}
+			}
+			return err
+		}
+		for _, branch := range repoInfo.Branches {
+			branches = append(branches, branch.Name)
+		}
+	}
+	return d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *renew.StringSet) error {
+		// The filesets of the heads are computed outside of the transaction, as
+		// they may need to be compacted.
+		heads := make([]*pfs.CommitInfo, len(branches))
+		ids := make([]fileset.ID, len(branches))
+		for i, branch := range branches {
+			commitInfo, err := d.finishedHead(ctx, src.NewBranch(branch))
+			if err != nil {
+				return err
+			}
+			id, err := d.getFileSet(ctx, commitInfo.Commit)
+			if err != nil {
+				return err
+			}
+			renewer.Add(id.HexString())
+			heads[i], ids[i] = commitInfo, *id
+		}
+		return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+			if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, src, auth.Permission_REPO_READ); err != nil {
+				return err
+			}
+			if err := d.createRepo(txnCtx, dst, description, false); err != nil {
+				return err
+			}
+			repoInfo := &pfs.RepoInfo{}
+			if err := d.repos.ReadWrite(txnCtx.SqlTx).Update(pfsdb.RepoKey(dst), repoInfo, func() error {
+				repoInfo.ForkedFrom = src
+				return nil
+			}); err != nil {
+				return err
+			}
+			for i, branch := range branches {
+				if _, err := d.applyFileSetTx(txnCtx, dst.NewBranch(branch), ids[i], heads[i].Description, &pfs.CommitOrigin{
+					Kind:   pfs.OriginKind_USER,
+					Forked: heads[i].Commit,
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
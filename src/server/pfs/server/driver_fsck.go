@@ -3,13 +3,19 @@ package server
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
 	"github.com/jmoiron/sqlx"
 
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
@@ -124,20 +130,91 @@ func (e ErrMissingBranchHead) Error() string {
 	return fmt.Sprintf("consistency error: branch %s does not have a head commit", e.Branch)
 }
 
+// ErrBrokenChunk indicates that a chunk object failed verification by the
+// chunk scrubber, and could not be repaired.
+// This struct contains the files which reference the chunk.
+type ErrBrokenChunk struct {
+	Failure    chunk.ScrubFailure
+	References []*pfs.File
+}
+
+func (e ErrBrokenChunk) Error() string {
+	msg := fmt.Sprintf("storage error: the object for chunk %s (generation %d) was detected as %s at %v",
+		e.Failure.ChunkID, e.Failure.Gen, e.Failure.Reason, e.Failure.DetectedAt)
+	if len(e.References) == 0 {
+		return msg + ", and is not referenced by any commit"
+	}
+	var files []string
+	for i, file := range e.References {
+		if i == maxBrokenChunkReferences {
+			files = append(files, fmt.Sprintf("and %d more", len(e.References)-i))
+			break
+		}
+		files = append(files, fmt.Sprintf("%s:%s", file.Commit, file.Path))
+	}
+	return fmt.Sprintf("%s, and is referenced by %d files: %s", msg, len(e.References), strings.Join(files, ", "))
+}
+
+// maxBrokenChunkReferences is the maximum number of files that reference a
+// broken chunk which are listed in its error.
+const maxBrokenChunkReferences = 10
+
+// ErrBrokenFileSet indicates that a fileset stored for a commit has metadata
+// that doesn't resolve, or references chunks that are missing.
+type ErrBrokenFileSet struct {
+	Commit *pfs.Commit
+	// Kind is "total" or "diff".
+	Kind    string
+	FileSet fileset.ID
+	Err     error
+}
+
+func (e ErrBrokenFileSet) Error() string {
+	return fmt.Sprintf("storage error: the %s fileset %s of commit %s is broken: %v", e.Kind, e.FileSet.HexString(), e.Commit, e.Err)
+}
+
+// ErrUnrecoverableCommit indicates that the content of a commit can't be
+// recovered, because it has no intact total fileset, and either its diff
+// filesets are broken or the content of its parent can't be recovered.
+type ErrUnrecoverableCommit struct {
+	Commit *pfs.Commit
+}
+
+func (e ErrUnrecoverableCommit) Error() string {
+	return fmt.Sprintf("storage error: the content of commit %s cannot be recovered", e.Commit)
+}
+
+// ErrDanglingTrackerReference indicates that a tracker object references
+// objects that don't exist, so they could have been garbage collected while
+// still in use.
+type ErrDanglingTrackerReference struct {
+	ID string
+}
+
+func (e ErrDanglingTrackerReference) Error() string {
+	return fmt.Sprintf("storage error: the tracker object %s references objects that do not exist", e.ID)
+}
+
 // fsck verifies that pfs satisfies the following invariants:
 // 1. Branch provenance is transitive
 // 2. Head commit provenance has heads of branch's branch provenance
-// If fix is true it will attempt to fix as many of these issues as it can.
+// 3. Branch heads exist
+// 4. Commit filesets resolve and their chunks exist, and finished commits have one
+// 5. Chunk objects have not failed verification by the chunk scrubber
+// 6. Tracker references do not dangle
+// If fix is true it will attempt to fix as many of these issues as it can,
+// which are missing branch heads (moved to the branch's newest commit), and
+// broken or missing total filesets (recomputed from the diffs).
 func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse) error) error {
 	onError := func(err error) error { return cb(&pfs.FsckResponse{Error: err.Error()}) }
-
-	// TODO(global ids): no fixable fsck issues?
-	// onFix := func(fix string) error { return cb(&pfs.FsckResponse{Fix: fix}) }
+	onFix := func(fix string) error { return cb(&pfs.FsckResponse{Fix: fix}) }
 
 	// collect all the info for the branches and commits in pfs
 	branchInfos := make(map[string]*pfs.BranchInfo)
 	commitInfos := make(map[string]*pfs.CommitInfo)
-	newCommitInfos := make(map[string]*pfs.CommitInfo)
+	// newHeads maps the branches with missing heads to the commits that will
+	// be their heads, if fix is true.
+	newHeads := make(map[string]*pfs.Commit)
 	repoInfo := &pfs.RepoInfo{}
 	if err := d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions(), func(string) error {
 		commitInfo := &pfs.CommitInfo{}
@@ -198,7 +275,21 @@ func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse)
 			}); err != nil {
 				return err
 			}
+			if head := newestBranchCommit(bi.Branch, commitInfos); head != nil {
+				newHeads[pfsdb.BranchKey(bi.Branch)] = head
+			}
 		} else {
+			if _, ok := commitInfos[pfsdb.CommitKey(bi.Head)]; !ok {
+				if err := onError(ErrCommitInfoNotFound{
+					Location: fmt.Sprintf("head of branch %s", bi.Branch),
+					Commit:   bi.Head,
+				}); err != nil {
+					return err
+				}
+				if head := newestBranchCommit(bi.Branch, commitInfos); head != nil {
+					newHeads[pfsdb.BranchKey(bi.Branch)] = head
+				}
+			}
 			// we expect the branch's provenance to equal the HEAD commit's provenance
 			// i.e branch.Provenance contains the branch provBranch and
 			// provBranch.Head != nil implies branch.Head.Provenance contains
@@ -282,20 +373,263 @@ func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse)
 		}
 	}
 
+	if err := d.fsckFileSets(ctx, commitInfos, fix, onError, onFix); err != nil {
+		return err
+	}
+
+	if err := d.fsckChunks(ctx, commitInfos, onError); err != nil {
+		return err
+	}
+
+	if err := d.tracker.IterateDangling(ctx, func(id string) error {
+		return onError(ErrDanglingTrackerReference{ID: id})
+	}); err != nil {
+		return err
+	}
+
 	// TODO(global ids): is there any verification we can do for commitsets?
 
-	if fix {
-		return col.NewSQLTx(ctx, d.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
-			for _, ci := range newCommitInfos {
-				// We've observed users getting ErrExists from this create,
-				// which doesn't make a lot of sense, but we insulate against
-				// it anyways so it doesn't prevent the command from working.
-				if err := d.commits.ReadWrite(sqlTx).Create(pfsdb.CommitKey(ci.Commit), ci); err != nil && !col.IsErrExists(err) {
+	if fix && len(newHeads) > 0 {
+		if err := col.NewSQLTx(ctx, d.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
+			for key, head := range newHeads {
+				branchInfo := &pfs.BranchInfo{}
+				if err := d.branches.ReadWrite(sqlTx).Update(key, branchInfo, func() error {
+					branchInfo.Head = head
+					return nil
+				}); err != nil {
 					return err
 				}
 			}
 			return nil
-		})
+		}); err != nil {
+			return err
+		}
+		var keys []string
+		for key := range newHeads {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			head := newHeads[key]
+			if err := onFix(fmt.Sprintf("moved the head of branch %s to commit %s", head.Branch, head.ID)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// newestBranchCommit returns the most recently started commit on branch in
+// commitInfos, or nil if there are none.
+func newestBranchCommit(branch *pfs.Branch, commitInfos map[string]*pfs.CommitInfo) *pfs.Commit {
+	var newest *pfs.CommitInfo
+	for _, ci := range commitInfos {
+		if ci.Commit.Branch == nil || pfsdb.BranchKey(ci.Commit.Branch) != pfsdb.BranchKey(branch) {
+			continue
+		}
+		if newest == nil || timestampBefore(newest.Started, ci.Started) {
+			newest = ci
+		}
+	}
+	if newest == nil {
+		return nil
+	}
+	return newest.Commit
+}
+
+func timestampBefore(a, b *types.Timestamp) bool {
+	if a.GetSeconds() != b.GetSeconds() {
+		return a.GetSeconds() < b.GetSeconds()
+	}
+	return a.GetNanos() < b.GetNanos()
+}
+
+// fsckFileSets reports the filesets stored for the commits in commitInfos
+// whose metadata doesn't resolve or which reference missing chunks, and the
+// commits whose content can't be recovered because of them. Totals are
+// computed when they're first read, so a missing total is not an error, but if
+// fix is true broken totals are dropped and recomputed from the diffs, and the
+// totals of finished commits with no filesets at all are computed.
+func (d *driver) fsckFileSets(ctx context.Context, commitInfos map[string]*pfs.CommitInfo, fix bool, onError func(error) error, onFix func(string) error) error {
+	checker := d.storage.NewChecker()
+	var commitKeys []string
+	for key := range commitInfos {
+		commitKeys = append(commitKeys, key)
+	}
+	sort.Strings(commitKeys)
+	// The content of a commit without an intact total is computed from its
+	// diffs and the content of its parent, so it is unrecoverable if either
+	// is. They're stored by commit key.
+	intactTotal := make(map[string]bool)
+	diffBroken := make(map[string]bool)
+	var missing, recompute []*pfs.Commit
+	for _, key := range commitKeys {
+		commitInfo := commitInfos[key]
+		commit := commitInfo.Commit
+		total, diffs, err := d.commitStore.GetFileSetIDs(ctx, commit)
+		if err != nil {
+			return err
+		}
+		check := func(kind string, id fileset.ID) (bool, error) {
+			var broken bool
+			err := checker.Check(ctx, id, func(err error) error {
+				broken = true
+				return onError(ErrBrokenFileSet{Commit: commit, Kind: kind, FileSet: id, Err: err})
+			})
+			return broken, err
+		}
+		for _, id := range diffs {
+			broken, err := check("diff", id)
+			if err != nil {
+				return err
+			}
+			diffBroken[key] = diffBroken[key] || broken
+		}
+		var totalBroken bool
+		if total != nil {
+			if totalBroken, err = check("total", *total); err != nil {
+				return err
+			}
+			intactTotal[key] = !totalBroken
+		}
+		if !fix || commitInfo.Finished == nil || commitInfo.Origin.GetKind() == pfs.OriginKind_ALIAS {
+			continue
+		}
+		switch {
+		case totalBroken:
+			recompute = append(recompute, commit)
+		case total == nil && len(diffs) == 0:
+			missing = append(missing, commit)
+		}
+	}
+	unrecoverable := make(map[string]bool)
+	var isUnrecoverable func(key string) bool
+	isUnrecoverable = func(key string) bool {
+		if result, ok := unrecoverable[key]; ok {
+			return result
+		}
+		result := !intactTotal[key] && (diffBroken[key] || parentUnrecoverable(key, commitInfos, isUnrecoverable))
+		unrecoverable[key] = result
+		return result
+	}
+	for _, key := range commitKeys {
+		if isUnrecoverable(key) {
+			if err := onError(ErrUnrecoverableCommit{Commit: commitInfos[key].Commit}); err != nil {
+				return err
+			}
+		}
+	}
+	// Totals are dropped before any are recomputed, so that broken totals of
+	// ancestors aren't used to recompute their descendants.
+	for _, commit := range recompute {
+		if err := d.commitStore.DropTotalFileSet(ctx, commit); err != nil {
+			return err
+		}
+	}
+	fixTotal := func(commit *pfs.Commit, fixed string) error {
+		if isUnrecoverable(pfsdb.CommitKey(commit)) {
+			return nil
+		}
+		if _, err := d.getOrComputeTotal(ctx, commit); err != nil {
+			return onError(errors.Wrapf(err, "storage error: could not compute the total fileset of commit %s", commit))
+		}
+		return onFix(fmt.Sprintf("%s the total fileset of commit %s", fixed, commit))
+	}
+	for _, commit := range recompute {
+		if err := fixTotal(commit, "recomputed"); err != nil {
+			return err
+		}
+	}
+	for _, commit := range missing {
+		if err := fixTotal(commit, "computed the missing"); err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+// parentUnrecoverable returns true if the content of the parent of the commit
+// with key, which the commit's content is computed from, can't be recovered.
+// Errored parents are skipped, like when the content is computed.
+func parentUnrecoverable(key string, commitInfos map[string]*pfs.CommitInfo, isUnrecoverable func(string) bool) bool {
+	parent := commitInfos[key].ParentCommit
+	for parent != nil {
+		parentKey := pfsdb.CommitKey(parent)
+		parentInfo, ok := commitInfos[parentKey]
+		if !ok {
+			// Missing commits are reported as broken ancestry.
+			return false
+		}
+		if !parentInfo.Error {
+			return isUnrecoverable(parentKey)
+		}
+		parent = parentInfo.ParentCommit
+	}
+	return false
+}
+
+// fsckChunks reports the chunk objects which failed verification by the chunk
+// scrubber, along with the files in commitInfos which reference them.
+func (d *driver) fsckChunks(ctx context.Context, commitInfos map[string]*pfs.CommitInfo, onError func(error) error) error {
+	failures, err := d.storage.ChunkStorage().ScrubFailures(ctx)
+	if err != nil {
+		return err
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	references := make(map[string][]*pfs.File)
+	for _, failure := range failures {
+		references[string(failure.ChunkID)] = nil
+	}
+	var commitKeys []string
+	for key := range commitInfos {
+		commitKeys = append(commitKeys, key)
+	}
+	sort.Strings(commitKeys)
+	for _, key := range commitKeys {
+		commit := commitInfos[key].Commit
+		if err := d.fsckCommitChunks(ctx, commit, references); err != nil {
+			if err := onError(errors.Wrapf(err, "storage error: could not read the files in commit %s", commit)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, failure := range failures {
+		if err := onError(ErrBrokenChunk{
+			Failure:    failure,
+			References: references[string(failure.ChunkID)],
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fsckCommitChunks appends the files in commit to the references of the chunks
+// they point to, for the chunks in references.
+func (d *driver) fsckCommitChunks(ctx context.Context, commit *pfs.Commit, references map[string][]*pfs.File) error {
+	id, err := d.getFileSet(ctx, commit)
+	if err != nil {
+		return err
+	}
+	fs, err := d.storage.Open(ctx, []fileset.ID{*id})
+	if err != nil {
+		return err
+	}
+	return fs.Iterate(ctx, func(f fileset.File) error {
+		seen := make(map[string]bool)
+		for _, chunkID := range index.PointsTo(f.Index()) {
+			key := string(chunkID)
+			if _, ok := references[key]; !ok || seen[key] {
+				continue
+			}
+			seen[key] = true
+			references[key] = append(references[key], &pfs.File{
+				Commit: commit,
+				Path:   f.Index().Path,
+			})
+		}
+		return nil
+	})
+}
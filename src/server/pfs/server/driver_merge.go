@@ -0,0 +1,269 @@
+package server
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"golang.org/x/net/context"
+)
+
+// fileChange is a file changed on one side of a merge, new is nil if the file
+// was deleted.
+type fileChange struct {
+	base, new *pfs.FileInfo
+}
+
+// mergeBranch creates a commit on target which merges the changes made on
+// source since the common ancestor of their heads. Files changed differently
+// on both sides are resolved by strategy, with the FAIL strategy no commit is
+// created if there are conflicts.
+func (d *driver) mergeBranch(ctx context.Context, source, target *pfs.Branch, strategy pfs.MergeStrategy, description string) (*pfs.MergeBranchResponse, error) {
+	if source == nil || target == nil {
+		return nil, errors.New("source and target branches must be specified")
+	}
+	if source.Repo.Name != target.Repo.Name {
+		return nil, errors.Errorf("cannot merge branches of different repos %s and %s", source.Repo, target.Repo)
+	}
+	if source.Name == target.Name {
+		return nil, errors.Errorf("cannot merge branch %s into itself", source)
+	}
+	theirsInfo, err := d.finishedHead(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	oursInfo, err := d.finishedHead(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	base, err := d.mergeBase(ctx, oursInfo.Commit, theirsInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	response := &pfs.MergeBranchResponse{}
+	if base != nil && pfsdb.CommitKey(base) == pfsdb.CommitKey(theirsInfo.Commit) {
+		// Everything on source has already been merged.
+		response.Commit = oursInfo.Commit
+		return response, nil
+	}
+	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *renew.StringSet) error {
+		var baseSource Source = emptySource{}
+		if base != nil {
+			baseSource, err = d.commitSource(ctx, renewer, base)
+			if err != nil {
+				return err
+			}
+		}
+		oursSource, err := d.commitSource(ctx, renewer, oursInfo.Commit)
+		if err != nil {
+			return err
+		}
+		theirsSource, err := d.commitSource(ctx, renewer, theirsInfo.Commit)
+		if err != nil {
+			return err
+		}
+		ours, err := fileChanges(ctx, baseSource, oursSource)
+		if err != nil {
+			return err
+		}
+		theirs, err := fileChanges(ctx, baseSource, theirsSource)
+		if err != nil {
+			return err
+		}
+		// apply is the set of paths which take the version from source.
+		apply := make(map[string]struct{})
+		for path, theirChange := range theirs {
+			ourChange, ok := ours[path]
+			if !ok {
+				apply[path] = struct{}{}
+				continue
+			}
+			if sameFile(ourChange.new, theirChange.new) {
+				continue
+			}
+			response.Conflicts = append(response.Conflicts, &pfs.MergeConflict{
+				Path:   path,
+				Base:   theirChange.base,
+				Ours:   ourChange.new,
+				Theirs: theirChange.new,
+			})
+			if strategy == pfs.MergeStrategy_THEIRS {
+				apply[path] = struct{}{}
+			}
+		}
+		sort.Slice(response.Conflicts, func(i, j int) bool {
+			return response.Conflicts[i].Path < response.Conflicts[j].Path
+		})
+		if len(response.Conflicts) > 0 && strategy == pfs.MergeStrategy_FAIL {
+			return nil
+		}
+		id, err := d.mergeFileSet(ctx, renewer, oursInfo.Commit, theirsInfo.Commit, apply)
+		if err != nil {
+			return err
+		}
+		response.Commit, err = d.applyFileSet(ctx, target, oursInfo.Commit, source.Repo, *id, description, &pfs.CommitOrigin{
+			Kind:   pfs.OriginKind_USER,
+			Merged: theirsInfo.Commit,
+		})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// finishedHead returns the info of the head of branch, which must be finished.
+func (d *driver) finishedHead(ctx context.Context, branch *pfs.Branch) (*pfs.CommitInfo, error) {
+	commitInfo, err := d.inspectCommit(ctx, branch.NewCommit(""), pfs.CommitState_STARTED)
+	if err != nil {
+		return nil, err
+	}
+	if commitInfo.Finished == nil {
+		return nil, pfsserver.ErrCommitNotFinished{Commit: commitInfo.Commit}
+	}
+	return commitInfo, nil
+}
+
+// mergeBase returns the common ancestor of ours and theirs closest to ours,
+// or nil if they have no common ancestor. Both the parents of commits and the
+// commits merged into them are followed, so that changes which have already
+// been merged aren't merged again.
+func (d *driver) mergeBase(ctx context.Context, ours, theirs *pfs.Commit) (*pfs.Commit, error) {
+	ancestors := make(map[string]struct{})
+	if err := d.walkMergeAncestors(ctx, theirs, func(commit *pfs.Commit) (bool, error) {
+		ancestors[pfsdb.CommitKey(commit)] = struct{}{}
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+	var base *pfs.Commit
+	if err := d.walkMergeAncestors(ctx, ours, func(commit *pfs.Commit) (bool, error) {
+		if _, ok := ancestors[pfsdb.CommitKey(commit)]; ok {
+			base = commit
+			return false, nil
+		}
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+	return base, nil
+}
+
+// walkMergeAncestors calls cb on commit and its ancestors in breadth first
+// order, until cb returns false.
+func (d *driver) walkMergeAncestors(ctx context.Context, commit *pfs.Commit, cb func(*pfs.Commit) (bool, error)) error {
+	seen := make(map[string]struct{})
+	queue := []*pfs.Commit{commit}
+	for len(queue) > 0 {
+		commit, queue = queue[0], queue[1:]
+		if _, ok := seen[pfsdb.CommitKey(commit)]; ok {
+			continue
+		}
+		seen[pfsdb.CommitKey(commit)] = struct{}{}
+		commitInfo, err := d.getCommit(ctx, commit)
+		if err != nil {
+			return err
+		}
+		if cont, err := cb(commitInfo.Commit); err != nil || !cont {
+			return err
+		}
+		if commitInfo.ParentCommit != nil {
+			queue = append(queue, commitInfo.ParentCommit)
+		}
+		if commitInfo.Origin != nil && commitInfo.Origin.Merged != nil {
+			queue = append(queue, commitInfo.Origin.Merged)
+		}
+	}
+	return nil
+}
+
+func (d *driver) commitSource(ctx context.Context, renewer *renew.StringSet, commit *pfs.Commit) (Source, error) {
+	commitInfo, err := d.getCommit(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	id, err := d.getFileSet(ctx, commitInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	renewer.Add(id.HexString())
+	fs, err := d.storage.Open(ctx, []fileset.ID{*id})
+	if err != nil {
+		return nil, err
+	}
+	return NewSource(commitInfo, fs), nil
+}
+
+// fileChanges returns the files which differ between base and head, by path.
+func fileChanges(ctx context.Context, base, head Source) (map[string]*fileChange, error) {
+	changes := make(map[string]*fileChange)
+	if err := NewDiffer(base, head).Iterate(ctx, func(baseFi, headFi *pfs.FileInfo) error {
+		fi := headFi
+		if fi == nil {
+			fi = baseFi
+		}
+		if fi.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		changes[fi.File.Path] = &fileChange{base: baseFi, new: headFi}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// sameFile returns true if a and b are both deleted, or have the same content.
+func sameFile(a, b *pfs.FileInfo) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return bytes.Equal(a.Hash, b.Hash)
+}
+
+// mergeFileSet returns a fileset which replaces the files of ours in apply
+// with their contents in theirs.
+func (d *driver) mergeFileSet(ctx context.Context, renewer *renew.StringSet, ours, theirs *pfs.Commit, apply map[string]struct{}) (*fileset.ID, error) {
+	filter := func(fs fileset.FileSet) fileset.FileSet {
+		return fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+			_, ok := apply[idx.Path]
+			return ok
+		})
+	}
+	w := d.storage.NewWriter(ctx, d.writerOptions(ours.Branch.Repo)...)
+	if err := d.iterateCommitFiles(ctx, renewer, ours, func(f fileset.File) error {
+		return w.Delete(f.Index().Path, f.Index().File.Tag)
+	}, filter); err != nil {
+		return nil, err
+	}
+	if err := d.iterateCommitFiles(ctx, renewer, theirs, func(f fileset.File) error {
+		return w.Copy(f, f.Index().File.Tag)
+	}, filter); err != nil {
+		return nil, err
+	}
+	id, err := w.Close()
+	if err != nil {
+		return nil, err
+	}
+	renewer.Add(id.HexString())
+	return id, nil
+}
+
+func (d *driver) iterateCommitFiles(ctx context.Context, renewer *renew.StringSet, commit *pfs.Commit, cb func(fileset.File) error, filter func(fileset.FileSet) fileset.FileSet) error {
+	id, err := d.getFileSet(ctx, commit)
+	if err != nil {
+		return err
+	}
+	renewer.Add(id.HexString())
+	fs, err := d.storage.Open(ctx, []fileset.ID{*id})
+	if err != nil {
+		return err
+	}
+	return filter(fs).Iterate(ctx, cb)
+}
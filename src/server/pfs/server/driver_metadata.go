@@ -0,0 +1,167 @@
+package server
+
+import (
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"golang.org/x/net/context"
+)
+
+// setMetadata updates the metadata of the repo, branch or commit in request.
+func (d *driver) setMetadata(txnCtx *txncontext.TransactionContext, request *pfs.SetMetadataRequest) error {
+	for key := range request.Metadata {
+		if err := validateMetadataKey(key); err != nil {
+			return err
+		}
+	}
+	update := func(metadata *map[string]string) func() error {
+		return func() error {
+			*metadata = updateMetadata(*metadata, request.Metadata, request.RemoveKeys)
+			return nil
+		}
+	}
+	switch {
+	case request.Repo != nil && request.Branch == nil && request.Commit == nil:
+		repo := request.Repo
+		if repo.Type == "" {
+			repo.Type = pfs.UserRepoType
+		}
+		if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_WRITE); err != nil {
+			return err
+		}
+		repoInfo := &pfs.RepoInfo{}
+		if err := d.repos.ReadWrite(txnCtx.SqlTx).Update(pfsdb.RepoKey(repo), repoInfo, update(&repoInfo.Metadata)); err != nil {
+			if col.IsErrNotFound(err) {
+				return pfsserver.ErrRepoNotFound{Repo: repo}
+			}
+			return err
+		}
+		return d.indexRepoMetadata(txnCtx, repo, repoInfo.Metadata)
+	case request.Branch != nil && request.Repo == nil && request.Commit == nil:
+		branch := request.Branch
+		if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_WRITE); err != nil {
+			return err
+		}
+		branchInfo := &pfs.BranchInfo{}
+		return d.branches.ReadWrite(txnCtx.SqlTx).Update(pfsdb.BranchKey(branch), branchInfo, update(&branchInfo.Metadata))
+	case request.Commit != nil && request.Repo == nil && request.Branch == nil:
+		if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, request.Commit.Branch.Repo, auth.Permission_REPO_WRITE); err != nil {
+			return err
+		}
+		commitInfo, err := d.resolveCommit(txnCtx.SqlTx, request.Commit)
+		if err != nil {
+			return err
+		}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).Update(pfsdb.CommitKey(commitInfo.Commit), commitInfo, update(&commitInfo.Metadata)); err != nil {
+			return err
+		}
+		return d.indexCommitMetadata(txnCtx, commitInfo.Commit, commitInfo.Metadata)
+	default:
+		return errors.Errorf("exactly one of repo, branch and commit must be set")
+	}
+}
+
+func validateMetadataKey(key string) error {
+	if key == "" {
+		return errors.Errorf("metadata keys cannot be empty")
+	}
+	if strings.Contains(key, "=") {
+		return errors.Errorf("metadata key %q invalid: keys cannot contain '='", key)
+	}
+	return nil
+}
+
+// updateMetadata returns metadata with the pairs in set added and the keys in
+// remove removed.
+func updateMetadata(metadata, set map[string]string, remove []string) map[string]string {
+	result := make(map[string]string)
+	for key, value := range metadata {
+		result[key] = value
+	}
+	for key, value := range set {
+		result[key] = value
+	}
+	for _, key := range remove {
+		delete(result, key)
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// indexRepoMetadata replaces the metadata entries of repo with the pairs in
+// metadata.
+func (d *driver) indexRepoMetadata(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, metadata map[string]string) error {
+	if err := d.unindexMetadata(txnCtx, pfsdb.MetadataRepoKey(repo)); err != nil {
+		return err
+	}
+	for key, value := range metadata {
+		if err := d.putMetadataEntry(txnCtx, &pfs.MetadataEntry{Repo: repo, Key: key, Value: value}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexCommitMetadata replaces the metadata entries of commit with the pairs
+// in metadata.
+func (d *driver) indexCommitMetadata(txnCtx *txncontext.TransactionContext, commit *pfs.Commit, metadata map[string]string) error {
+	if err := d.unindexMetadata(txnCtx, pfsdb.MetadataCommitKey(commit)); err != nil {
+		return err
+	}
+	for key, value := range metadata {
+		if err := d.putMetadataEntry(txnCtx, &pfs.MetadataEntry{Commit: commit, Key: key, Value: value}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *driver) putMetadataEntry(txnCtx *txncontext.TransactionContext, entry *pfs.MetadataEntry) error {
+	return d.metadata.ReadWrite(txnCtx.SqlTx).Put(pfsdb.MetadataKey(entry), entry)
+}
+
+// unindexMetadata deletes the metadata entries of the repo or commit with the
+// MetadataObjectIndex value object.
+func (d *driver) unindexMetadata(txnCtx *txncontext.TransactionContext, object string) error {
+	return d.metadata.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.MetadataObjectIndex, object)
+}
+
+// withMetadata returns the set of MetadataObjectIndex values of the repos or
+// commits with all of the pairs in metadata, where pairKey is
+// pfsdb.MetadataRepoPairKey or pfsdb.MetadataCommitPairKey. It returns nil if
+// metadata is empty.
+func (d *driver) withMetadata(ctx context.Context, metadata map[string]string, pairKey func(string, string) string) (map[string]bool, error) {
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+	var result map[string]bool
+	entry := &pfs.MetadataEntry{}
+	for key, value := range metadata {
+		matches := make(map[string]bool)
+		if err := d.metadata.ReadOnly(ctx).GetByIndex(pfsdb.MetadataPairIndex, pairKey(key, value), entry, col.DefaultOptions(), func(string) error {
+			object := pfsdb.MetadataRepoKey(entry.Repo)
+			if entry.Commit != nil {
+				object = pfsdb.MetadataCommitKey(entry.Commit)
+			}
+			if result == nil || result[object] {
+				matches[object] = true
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		result = matches
+		if len(result) == 0 {
+			break
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// setBranchProtection replaces the protection of branch, an empty protection
+// removes it.
+func (d *driver) setBranchProtection(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, protection *pfs.BranchProtection) error {
+	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_MODIFY_BRANCH_PROTECTION); err != nil {
+		return err
+	}
+	if protection.StagingBranch != "" {
+		if protection.StagingBranch == branch.Name {
+			return errors.Errorf("branch %s cannot be its own staging branch", branch)
+		}
+		if err := ancestry.ValidateName(protection.StagingBranch); err != nil {
+			return err
+		}
+	}
+	for _, principal := range protection.Principals {
+		if principal == "" {
+			return errors.New("branch protection principals cannot be empty")
+		}
+	}
+	if proto.Equal(protection, &pfs.BranchProtection{}) {
+		protection = nil
+	}
+	branchInfo := &pfs.BranchInfo{}
+	return d.branches.ReadWrite(txnCtx.SqlTx).Update(pfsdb.BranchKey(branch), branchInfo, func() error {
+		// The trigger and provenance the branch already has are accepted for
+		// its principals, but must not move its head around its staging branch.
+		if protection != nil {
+			if reason := forbidAutomaticHead(branchInfo.Trigger, branchInfo.DirectProvenance)(protection, ""); reason != "" {
+				return pfsserver.ErrBranchProtected{Branch: branch, Reason: reason}
+			}
+		}
+		branchInfo.Protection = protection
+		return nil
+	})
+}
+
+// checkBranchProtection returns an ErrBranchProtected if the protection of
+// branchInfo forbids an operation by the caller. forbid returns the reason the
+// operation is forbidden for principal, or "" if it is allowed.
+func (d *driver) checkBranchProtection(txnCtx *txncontext.TransactionContext, branchInfo *pfs.BranchInfo, forbid func(protection *pfs.BranchProtection, principal string) string) error {
+	if branchInfo.Protection == nil {
+		return nil
+	}
+	principal, bypass, err := d.protectionCaller(txnCtx, branchInfo.Branch.Repo)
+	if err != nil {
+		return err
+	}
+	if bypass {
+		return nil
+	}
+	if reason := forbid(branchInfo.Protection, principal); reason != "" {
+		return pfsserver.ErrBranchProtected{Branch: branchInfo.Branch, Reason: reason}
+	}
+	return nil
+}
+
+// protectionCaller returns the principal of the caller, and whether it can
+// bypass branch protection in repo. If auth is not activated, the principal
+// is empty and branch protection can't be bypassed.
+func (d *driver) protectionCaller(txnCtx *txncontext.TransactionContext, repo *pfs.Repo) (string, bool, error) {
+	whoAmI, err := d.env.AuthServer().WhoAmI(txnCtx.ClientContext, &auth.WhoAmIRequest{})
+	if err != nil {
+		if auth.IsErrNotActivated(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_BYPASS_BRANCH_PROTECTION); err != nil {
+		if auth.IsErrNotAuthorized(err) {
+			return whoAmI.Username, false, nil
+		}
+		return "", false, err
+	}
+	return whoAmI.Username, true, nil
+}
+
+// forbidStartCommit forbids starting commits directly on branches with a
+// staging branch, and on branches whose head principal can't move.
+func forbidStartCommit(protection *pfs.BranchProtection, principal string) string {
+	if protection.StagingBranch != "" {
+		return fmt.Sprintf("commits must be started on branch %q and moved to it with CreateBranch", protection.StagingBranch)
+	}
+	return forbidMoveHead(protection, principal)
+}
+
+// forbidCreateBranch returns a function forbidding moving the head of a branch
+// to a commit which isn't on its staging branch, or by a principal which can't
+// move its head.
+func forbidCreateBranch(head *pfs.Commit) func(*pfs.BranchProtection, string) string {
+	return func(protection *pfs.BranchProtection, principal string) string {
+		if protection.StagingBranch != "" && head.Branch.Name != protection.StagingBranch {
+			return fmt.Sprintf("its head can only be moved to a commit on branch %q", protection.StagingBranch)
+		}
+		return forbidMoveHead(protection, principal)
+	}
+}
+
+// forbidAutomaticHead returns a function forbidding giving a branch the
+// trigger and provenance that move its head automatically, by a principal
+// which can't move its head, or to commits which aren't on its staging branch.
+// Only a trigger on the staging branch moves the head to its commits.
+func forbidAutomaticHead(trigger *pfs.Trigger, provenance []*pfs.Branch) func(*pfs.BranchProtection, string) string {
+	return func(protection *pfs.BranchProtection, principal string) string {
+		if protection.StagingBranch != "" {
+			if len(provenance) > 0 {
+				return fmt.Sprintf("its head can only be moved to a commit on branch %q, so it cannot have provenance", protection.StagingBranch)
+			}
+			if trigger != nil && trigger.Branch != protection.StagingBranch {
+				return fmt.Sprintf("its head can only be moved to a commit on branch %q, so it can only be triggered by it", protection.StagingBranch)
+			}
+		}
+		return forbidMoveHead(protection, principal)
+	}
+}
+
+func forbidMoveHead(protection *pfs.BranchProtection, principal string) string {
+	if principal == "" || len(protection.Principals) == 0 {
+		return ""
+	}
+	for _, p := range protection.Principals {
+		if p == principal {
+			return ""
+		}
+	}
+	return fmt.Sprintf("%s cannot move its head", principal)
+}
+
+func forbidDeleteBranch(protection *pfs.BranchProtection, _ string) string {
+	if protection.NoDelete {
+		return "it cannot be deleted"
+	}
+	return ""
+}
+
+func forbidRenameBranch(protection *pfs.BranchProtection, _ string) string {
+	if protection.NoDelete {
+		return "it cannot be renamed"
+	}
+	return ""
+}
+
+func forbidSquashCommit(protection *pfs.BranchProtection, principal string) string {
+	if protection.NoDelete {
+		return "commits on it cannot be squashed"
+	}
+	return forbidMoveHead(protection, principal)
+}
+
+// forbidForceFinish returns a function forbidding force-finishing a commit in
+// repo without an error, by any principal but the pipeline outputting to repo.
+// Jobs are stopped by force-finishing their output commits with an error, and
+// principals are only known when auth is activated, like in forbidMoveHead.
+func forbidForceFinish(repo *pfs.Repo, commitError bool) func(*pfs.BranchProtection, string) string {
+	return func(protection *pfs.BranchProtection, principal string) string {
+		if !protection.NoForce || commitError || principal == "" || principal == auth.PipelinePrefix+repo.Name {
+			return ""
+		}
+		return "commits on it cannot be force-finished"
+	}
+}
@@ -0,0 +1,368 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+func (d *driver) createQuota(txnCtx *txncontext.TransactionContext, quota *pfs.Quota, update bool) error {
+	if quota.Repo == nil {
+		return errors.New("repo cannot be nil")
+	}
+	if quota.SizeBytes < 0 || quota.FileCount < 0 {
+		return errors.Errorf("quota limits cannot be negative")
+	}
+	if err := d.repos.ReadWrite(txnCtx.SqlTx).Get(pfsdb.RepoKey(quota.Repo), &pfs.RepoInfo{}); err != nil {
+		return err
+	}
+	key := pfsdb.QuotaKey(quota.Repo, quota.Principal)
+	if update {
+		return d.quotas.ReadWrite(txnCtx.SqlTx).Put(key, quota)
+	}
+	if err := d.quotas.ReadWrite(txnCtx.SqlTx).Create(key, quota); err != nil {
+		if col.IsErrExists(err) {
+			return pfsserver.ErrQuotaExists{Quota: quota}
+		}
+		return err
+	}
+	return nil
+}
+
+func (d *driver) inspectQuota(ctx context.Context, repo *pfs.Repo, principal string) (*pfs.QuotaInfo, error) {
+	quota := &pfs.Quota{}
+	if err := d.quotas.ReadOnly(ctx).Get(pfsdb.QuotaKey(repo, principal), quota); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, pfsserver.ErrQuotaNotFound{Repo: repo, Principal: principal}
+		}
+		return nil, err
+	}
+	return d.quotaInfo(ctx, quota)
+}
+
+func (d *driver) listQuota(ctx context.Context, repo *pfs.Repo, cb func(*pfs.QuotaInfo) error) error {
+	quotas, err := d.getQuotas(ctx, repo)
+	if err != nil {
+		return err
+	}
+	for _, quota := range quotas {
+		info, err := d.quotaInfo(ctx, quota)
+		if err != nil {
+			return err
+		}
+		if err := cb(info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getQuotas returns the quotas on repo, or on all repos if repo is nil.
+func (d *driver) getQuotas(ctx context.Context, repo *pfs.Repo) ([]*pfs.Quota, error) {
+	var quotas []*pfs.Quota
+	quota := &pfs.Quota{}
+	collect := func(string) error {
+		quotas = append(quotas, proto.Clone(quota).(*pfs.Quota))
+		return nil
+	}
+	if repo == nil {
+		if err := d.quotas.ReadOnly(ctx).List(quota, col.DefaultOptions(), collect); err != nil {
+			return nil, err
+		}
+		return quotas, nil
+	}
+	if err := d.quotas.ReadOnly(ctx).GetByIndex(pfsdb.QuotasRepoIndex, pfsdb.RepoKey(repo), quota, col.DefaultOptions(), collect); err != nil {
+		return nil, err
+	}
+	return quotas, nil
+}
+
+// repoQuotaInfos returns the quotas on repo, and the usage of repo.
+func (d *driver) repoQuotaInfos(ctx context.Context, repo *pfs.Repo) ([]*pfs.QuotaInfo, error) {
+	var infos []*pfs.QuotaInfo
+	if err := d.listQuota(ctx, repo, func(info *pfs.QuotaInfo) error {
+		infos = append(infos, info)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return infos, nil
+}
+
+// quotaInfo returns the usage of the master branch of the quota's repo. Quotas
+// are enforced on the commits of every branch, see checkCommitQuotas.
+func (d *driver) quotaInfo(ctx context.Context, quota *pfs.Quota) (*pfs.QuotaInfo, error) {
+	info := &pfs.QuotaInfo{Quota: quota}
+	head, err := d.repoHead(ctx, quota.Repo)
+	if err != nil || head == nil {
+		return info, err
+	}
+	id, err := d.getFileSet(ctx, head)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := d.usage(ctx, []fileset.ID{*id}, true)
+	if err != nil {
+		return nil, err
+	}
+	info.SizeBytesUpperBound = usage.sizeBytes
+	info.FileCount = usage.fileCount
+	return info, nil
+}
+
+// repoHead returns the head of the master branch of repo, or nil if it has
+// no master branch.
+func (d *driver) repoHead(ctx context.Context, repo *pfs.Repo) (*pfs.Commit, error) {
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadOnly(ctx).Get(pfsdb.BranchKey(repo.NewBranch("master")), branchInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return branchInfo.Head, nil
+}
+
+// quotaUsageCacheSize is the number of fileset lists whose usage is cached.
+const quotaUsageCacheSize = 1000
+
+// quotaUsage is the usage of the data in a list of filesets.
+type quotaUsage struct {
+	sizeBytes int64
+	// fileCount is only set if counted is true.
+	fileCount int64
+	counted   bool
+}
+
+func newQuotaUsageCache() *lru.Cache {
+	cache, err := lru.New(quotaUsageCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return cache
+}
+
+// usage returns the upper bound of the size of the data in the filesets with
+// ids, and the number of files in them if count is true. Filesets are
+// immutable, so usage is cached by ids, which keeps quota checks and
+// InspectRepo from walking the same files again.
+func (d *driver) usage(ctx context.Context, ids []fileset.ID, count bool) (*quotaUsage, error) {
+	var keys []string
+	for _, id := range ids {
+		keys = append(keys, id.HexString())
+	}
+	key := strings.Join(keys, ",")
+	usage := &quotaUsage{}
+	if cached, ok := d.quotaUsage.Get(key); ok {
+		*usage = cached.(quotaUsage)
+	} else {
+		for _, id := range ids {
+			size, err := d.storage.SizeUpperBound(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			usage.sizeBytes += size
+		}
+	}
+	if count && !usage.counted {
+		var err error
+		if usage.fileCount, err = d.fileCount(ctx, ids); err != nil {
+			return nil, err
+		}
+		usage.counted = true
+	}
+	d.quotaUsage.Add(key, *usage)
+	return usage, nil
+}
+
+func (d *driver) fileCount(ctx context.Context, ids []fileset.ID) (int64, error) {
+	fs, err := d.storage.Open(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if err := fs.Iterate(ctx, func(fileset.File) error {
+		count++
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// checkQuotas returns an error if the data in the filesets with ids would
+// exceed a quota on repo that applies to the caller.
+// Sizes are checked against the upper bound of the size of the data, which
+// counts overwritten data.
+func (d *driver) checkQuotas(ctx context.Context, repo *pfs.Repo, ids []fileset.ID) error {
+	quotas, err := d.callerQuotas(ctx, repo)
+	if err != nil || len(quotas) == 0 {
+		return err
+	}
+	usage, err := d.usage(ctx, ids, countsFiles(quotas))
+	if err != nil {
+		return err
+	}
+	for _, quota := range quotas {
+		if (quota.SizeBytes > 0 && usage.sizeBytes > quota.SizeBytes) || (quota.FileCount > 0 && usage.fileCount > quota.FileCount) {
+			return pfsserver.ErrQuotaExceeded{
+				Quota:     quota,
+				SizeBytes: usage.sizeBytes,
+				FileCount: usage.fileCount,
+			}
+		}
+	}
+	return nil
+}
+
+// countsFiles returns true if any of quotas limits the file count.
+func countsFiles(quotas []*pfs.Quota) bool {
+	for _, quota := range quotas {
+		if quota.FileCount > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// checkCommitQuotas returns an error if the data in the open commit would
+// exceed a quota on its repo that applies to the caller. It's called within the
+// transaction which finishes the commit, so it doesn't compact the filesets of
+// the commit's parent.
+func (d *driver) checkCommitQuotas(txnCtx *txncontext.TransactionContext, commitInfo *pfs.CommitInfo) error {
+	quotas, err := d.callerQuotas(txnCtx.ClientContext, commitInfo.Commit.Branch.Repo)
+	if err != nil || len(quotas) == 0 {
+		return err
+	}
+	// The diff is read within the transaction, since it may have been added
+	// in the same transaction. The files are only counted here if
+	// precomputeCommitQuotas didn't count them for the same filesets, which it
+	// does on every path that finishes commits without an error.
+	diff, err := getDiff(txnCtx.SqlTx, commitInfo.Commit)
+	if err != nil {
+		return err
+	}
+	ids, err := d.commitQuotaFileSets(txnCtx.ClientContext, commitInfo, diff)
+	if err != nil {
+		return err
+	}
+	return d.checkQuotas(txnCtx.ClientContext, commitInfo.Commit.Branch.Repo, ids)
+}
+
+// precomputeCommitQuotas computes the usage checked by checkCommitQuotas
+// when commit is finished, so that the total of its parent isn't computed and
+// its files aren't counted while the transaction which finishes it is held
+// open.
+func (d *driver) precomputeCommitQuotas(ctx context.Context, commit *pfs.Commit) {
+	// Errors are reported when the commit is finished in the transaction.
+	commitInfo, err := d.getCommit(ctx, commit)
+	if err != nil || commitInfo.Finished != nil {
+		return
+	}
+	_, diff, err := d.commitStore.GetFileSetIDs(ctx, commitInfo.Commit)
+	if err != nil {
+		return
+	}
+	d.precomputeQuotas(ctx, commitInfo, diff)
+}
+
+// precomputeQuotas computes the usage checked by checkCommitQuotas for the
+// open commit, which has the diff.
+func (d *driver) precomputeQuotas(ctx context.Context, commitInfo *pfs.CommitInfo, diff []fileset.ID) {
+	if err := func() error {
+		quotas, err := d.callerQuotas(ctx, commitInfo.Commit.Branch.Repo)
+		if err != nil || len(quotas) == 0 {
+			return err
+		}
+		if commitInfo.ParentCommit != nil {
+			if _, err := d.getFileSet(ctx, commitInfo.ParentCommit); err != nil {
+				return err
+			}
+		}
+		ids, err := d.commitQuotaFileSets(ctx, commitInfo, diff)
+		if err != nil {
+			return err
+		}
+		_, err = d.usage(ctx, ids, countsFiles(quotas))
+		return err
+	}(); err != nil {
+		// The error is reported when the quotas are checked in the transaction.
+		log.Debugf("could not precompute the quota usage of commit %s: %v", commitInfo.Commit, err)
+	}
+}
+
+// commitQuotaFileSets returns the filesets with the data the open commit
+// would have with the diff.
+func (d *driver) commitQuotaFileSets(ctx context.Context, commitInfo *pfs.CommitInfo, diff []fileset.ID) ([]fileset.ID, error) {
+	var ids []fileset.ID
+	if commitInfo.ParentCommit != nil {
+		var err error
+		if ids, err = d.storedFileSets(ctx, commitInfo.ParentCommit); err != nil {
+			return nil, err
+		}
+	}
+	return append(ids, diff...), nil
+}
+
+// storedFileSets returns the filesets with the data of commit without
+// computing its total, like getOrComputeTotal would: its total if it has one,
+// or else its diff after the stored filesets of its nearest ancestor that
+// doesn't have an error.
+func (d *driver) storedFileSets(ctx context.Context, commit *pfs.Commit) ([]fileset.ID, error) {
+	var ids []fileset.ID
+	for first := true; commit != nil; first = false {
+		commitInfo, err := d.getCommit(ctx, commit)
+		if err != nil {
+			return nil, err
+		}
+		commit = commitInfo.ParentCommit
+		if !first && commitInfo.Error {
+			continue
+		}
+		total, diff, err := d.commitStore.GetFileSetIDs(ctx, commitInfo.Commit)
+		if err != nil {
+			return nil, err
+		}
+		if total != nil {
+			return append([]fileset.ID{*total}, ids...), nil
+		}
+		ids = append(diff, ids...)
+	}
+	return ids, nil
+}
+
+// callerQuotas returns the quotas on repo which apply to the caller.
+func (d *driver) callerQuotas(ctx context.Context, repo *pfs.Repo) ([]*pfs.Quota, error) {
+	quotas, err := d.getQuotas(ctx, repo)
+	if err != nil || len(quotas) == 0 {
+		return nil, err
+	}
+	var principal string
+	whoAmI, err := d.env.AuthServer().WhoAmI(ctx, &auth.WhoAmIRequest{})
+	if err != nil {
+		if !auth.IsErrNotActivated(err) {
+			return nil, err
+		}
+	} else {
+		principal = whoAmI.Username
+	}
+	var applicable []*pfs.Quota
+	for _, quota := range quotas {
+		if quota.Principal == "" || quota.Principal == principal {
+			applicable = append(applicable, quota)
+		}
+	}
+	return applicable, nil
+}
@@ -0,0 +1,401 @@
+package server
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// renamer renames branch in place if it's the renamed branch, or is in the
+// renamed repo, and returns true if it did.
+type renamer func(branch *pfs.Branch) bool
+
+func repoRenamer(repo *pfs.Repo, newName string) renamer {
+	key := pfsdb.RepoKey(repo)
+	return func(branch *pfs.Branch) bool {
+		if branch == nil || pfsdb.RepoKey(branch.Repo) != key {
+			return false
+		}
+		branch.Repo = &pfs.Repo{Name: newName, Type: branch.Repo.Type}
+		return true
+	}
+}
+
+func branchRenamer(branch *pfs.Branch, newName string) renamer {
+	key := pfsdb.BranchKey(branch)
+	return func(branch *pfs.Branch) bool {
+		if branch == nil || pfsdb.BranchKey(branch) != key {
+			return false
+		}
+		branch.Name = newName
+		return true
+	}
+}
+
+func (r renamer) commit(commit *pfs.Commit) bool {
+	return commit != nil && r(commit.Branch)
+}
+
+// branches renames the branches in bs, which is kept sorted.
+func (r renamer) branches(bs *[]*pfs.Branch) bool {
+	var renamed bool
+	var result []*pfs.Branch
+	for _, b := range *bs {
+		if r(b) {
+			renamed = true
+		}
+		add(&result, b)
+	}
+	*bs = result
+	return renamed
+}
+
+// name renames a branch of repo referred to by name, such as a trigger branch.
+func (r renamer) name(repo *pfs.Repo, name *string) bool {
+	if *name == "" {
+		return false
+	}
+	branch := repo.NewBranch(*name)
+	if !r(branch) {
+		return false
+	}
+	*name = branch.Name
+	return true
+}
+
+func (r renamer) branchInfo(branchInfo *pfs.BranchInfo) bool {
+	var renamed bool
+	// The branches in the trigger and protection are in the same repo, so they
+	// are renamed before the branch itself.
+	if branchInfo.Trigger != nil {
+		renamed = r.name(branchInfo.Branch.Repo, &branchInfo.Trigger.Branch) || renamed
+	}
+	if branchInfo.Protection != nil {
+		renamed = r.name(branchInfo.Branch.Repo, &branchInfo.Protection.StagingBranch) || renamed
+	}
+	renamed = r(branchInfo.Branch) || renamed
+	renamed = r.commit(branchInfo.Head) || renamed
+	renamed = r.branches(&branchInfo.Provenance) || renamed
+	renamed = r.branches(&branchInfo.Subvenance) || renamed
+	renamed = r.branches(&branchInfo.DirectProvenance) || renamed
+	return renamed
+}
+
+func (r renamer) commitInfo(commitInfo *pfs.CommitInfo) bool {
+	renamed := r.commit(commitInfo.Commit)
+	renamed = r.commit(commitInfo.ParentCommit) || renamed
+	for _, child := range commitInfo.ChildCommits {
+		renamed = r.commit(child) || renamed
+	}
+	renamed = r.branches(&commitInfo.DirectProvenance) || renamed
+	if origin := commitInfo.Origin; origin != nil {
+		renamed = r.commit(origin.Reverted) || renamed
+		renamed = r.commit(origin.CherryPicked) || renamed
+		renamed = r.commit(origin.Merged) || renamed
+		renamed = r.commit(origin.Forked) || renamed
+	}
+	return renamed
+}
+
+// renameRepo renames repo, along with the commits and branches in it and the
+// references to them in other repos. The role binding of repo is moved to its
+// new name, and the inputs of pipelines reading from it are updated.
+func (d *driver) renameRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, newName string) error {
+	// Validate arguments
+	if repo == nil {
+		return errors.New("repo cannot be nil")
+	}
+	if repo.Type == "" {
+		repo.Type = pfs.UserRepoType
+	}
+	if repo.Type != pfs.UserRepoType {
+		return errors.Errorf("cannot rename %s, only user repos can be renamed", repo)
+	}
+	if err := ancestry.ValidateName(newName); err != nil {
+		return err
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_DELETE); err != nil {
+		return err
+	}
+
+	repos := d.repos.ReadWrite(txnCtx.SqlTx)
+	repoInfo := &pfs.RepoInfo{}
+	if err := repos.Get(pfsdb.RepoKey(repo), repoInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrRepoNotFound{Repo: repo}
+		}
+		return err
+	}
+	// System repos share the name of the user repo of their pipeline, and
+	// pipelines can't be renamed.
+	otherRepo := &pfs.RepoInfo{}
+	if err := repos.GetByIndex(pfsdb.ReposNameIndex, repo.Name, otherRepo, col.DefaultOptions(), func(string) error {
+		if otherRepo.Repo.Type != repo.Type {
+			return errors.Errorf("cannot rename %s, it is the output repo of a pipeline", repo)
+		}
+		return nil
+	}); err != nil && !col.IsErrNotFound(err) {
+		return err
+	}
+	newRepo := &pfs.Repo{Name: newName, Type: repo.Type}
+	if err := repos.GetByIndex(pfsdb.ReposNameIndex, newName, otherRepo, col.DefaultOptions(), func(string) error {
+		return pfsserver.ErrRepoExists{Repo: newRepo}
+	}); err != nil && !col.IsErrNotFound(err) {
+		return err
+	}
+
+	r := repoRenamer(repo, newName)
+	if err := d.renameReferences(txnCtx, repo, repoInfo.Branches, r); err != nil {
+		return err
+	}
+	forks, err := d.forks(txnCtx, repo)
+	if err != nil {
+		return err
+	}
+	for _, fork := range forks {
+		forkInfo := &pfs.RepoInfo{}
+		if err := repos.Update(pfsdb.RepoKey(fork), forkInfo, func() error {
+			forkInfo.ForkedFrom = newRepo
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	if err := d.renameQuotas(txnCtx, repo, newRepo); err != nil {
+		return err
+	}
+	if err := repos.Delete(pfsdb.RepoKey(repo)); err != nil {
+		return err
+	}
+	repoInfo.Repo = newRepo
+	r.branches(&repoInfo.Branches)
+	if err := repos.Create(pfsdb.RepoKey(newRepo), repoInfo); err != nil {
+		return err
+	}
+	if err := d.unindexMetadata(txnCtx, pfsdb.MetadataRepoKey(repo)); err != nil {
+		return err
+	}
+	if err := d.indexRepoMetadata(txnCtx, newRepo, repoInfo.Metadata); err != nil {
+		return err
+	}
+
+	if err := d.env.AuthServer().RenameRoleBindingInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_REPO, Name: repo.Name}, newName); err != nil && !auth.IsErrNotActivated(err) {
+		return grpcutil.ScrubGRPC(err)
+	}
+	if d.env.PpsServer() != nil {
+		return d.env.PpsServer().RenameInputRepoInTransaction(txnCtx, repo.Name, newName)
+	}
+	return nil
+}
+
+// renameBranch renames branch, along with the commits on it and the references
+// to it in other branches and commits. The inputs of pipelines reading from it
+// are updated.
+func (d *driver) renameBranch(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, newName string) error {
+	// Validate arguments
+	if branch == nil {
+		return errors.New("branch cannot be nil")
+	}
+	if branch.Repo == nil {
+		return errors.New("branch repo cannot be nil")
+	}
+	if err := ancestry.ValidateName(newName); err != nil {
+		return err
+	}
+	if uuid.IsUUIDWithoutDashes(newName) {
+		return errors.Errorf("branch name cannot be a UUID V4")
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_DELETE_BRANCH, auth.Permission_REPO_CREATE_BRANCH); err != nil {
+		return err
+	}
+
+	if branch.Repo.Type != pfs.UserRepoType {
+		return errors.Errorf("cannot rename branch %s, only branches of user repos can be renamed", branch)
+	}
+	otherRepo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(txnCtx.SqlTx).GetByIndex(pfsdb.ReposNameIndex, branch.Repo.Name, otherRepo, col.DefaultOptions(), func(string) error {
+		if otherRepo.Repo.Type != branch.Repo.Type {
+			return errors.Errorf("cannot rename branch %s, it is in the output repo of a pipeline", branch)
+		}
+		return nil
+	}); err != nil && !col.IsErrNotFound(err) {
+		return err
+	}
+
+	branches := d.branches.ReadWrite(txnCtx.SqlTx)
+	branchInfo := &pfs.BranchInfo{}
+	if err := branches.Get(pfsdb.BranchKey(branch), branchInfo); err != nil {
+		return err
+	}
+	if err := d.checkBranchProtection(txnCtx, branchInfo, forbidRenameBranch); err != nil {
+		return err
+	}
+	newBranch := branch.Repo.NewBranch(newName)
+	if err := branches.Get(pfsdb.BranchKey(newBranch), &pfs.BranchInfo{}); err == nil {
+		return errors.Errorf("branch %s already exists", newBranch)
+	} else if !col.IsErrNotFound(err) {
+		return err
+	}
+
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(txnCtx.SqlTx).Get(pfsdb.RepoKey(branch.Repo), repoInfo); err != nil {
+		return err
+	}
+	r := branchRenamer(branch, newName)
+	if err := d.renameReferences(txnCtx, branch.Repo, repoInfo.Branches, r); err != nil {
+		return err
+	}
+	if err := d.repos.ReadWrite(txnCtx.SqlTx).Update(pfsdb.RepoKey(branch.Repo), repoInfo, func() error {
+		r.branches(&repoInfo.Branches)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if d.env.PpsServer() != nil {
+		return d.env.PpsServer().RenameInputBranchInTransaction(txnCtx, branch.Repo.Name, branch.Name, newName)
+	}
+	return nil
+}
+
+// renameReferences renames the branches and commits of repo with r, and the
+// references to them in the repos provenant or subvenant on branches. Branches
+// and commits which are renamed are moved to their new keys.
+func (d *driver) renameReferences(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, branches []*pfs.Branch, r renamer) error {
+	related := map[string]*pfs.Repo{pfsdb.RepoKey(repo): repo}
+	for _, branch := range branches {
+		branchInfo := &pfs.BranchInfo{}
+		if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(pfsdb.BranchKey(branch), branchInfo); err != nil {
+			return err
+		}
+		for _, b := range append(branchInfo.Provenance, branchInfo.Subvenance...) {
+			related[pfsdb.RepoKey(b.Repo)] = b.Repo
+		}
+	}
+	// The first commits of forks refer to the commits they were forked from.
+	forks, err := d.forks(txnCtx, repo)
+	if err != nil {
+		return err
+	}
+	for _, fork := range forks {
+		related[pfsdb.RepoKey(fork)] = fork
+	}
+	for _, repo := range related {
+		if err := d.renameBranchInfos(txnCtx, repo, r); err != nil {
+			return err
+		}
+		if err := d.renameCommitInfos(txnCtx, repo, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// forks returns the repos which were forked from repo.
+func (d *driver) forks(txnCtx *txncontext.TransactionContext, repo *pfs.Repo) ([]*pfs.Repo, error) {
+	var forks []*pfs.Repo
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(txnCtx.SqlTx).GetByIndex(pfsdb.ReposForkedFromIndex, pfsdb.RepoKey(repo), repoInfo, col.DefaultOptions(), func(string) error {
+		forks = append(forks, proto.Clone(repoInfo.Repo).(*pfs.Repo))
+		return nil
+	}); err != nil && !col.IsErrNotFound(err) {
+		return nil, err
+	}
+	return forks, nil
+}
+
+func (d *driver) renameBranchInfos(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, r renamer) error {
+	branches := d.branches.ReadWrite(txnCtx.SqlTx)
+	var branchInfos []*pfs.BranchInfo
+	branchInfo := &pfs.BranchInfo{}
+	if err := branches.GetByIndex(pfsdb.BranchesRepoIndex, pfsdb.RepoKey(repo), branchInfo, col.DefaultOptions(), func(string) error {
+		branchInfos = append(branchInfos, proto.Clone(branchInfo).(*pfs.BranchInfo))
+		return nil
+	}); err != nil && !col.IsErrNotFound(err) {
+		return err
+	}
+	for _, branchInfo := range branchInfos {
+		key := pfsdb.BranchKey(branchInfo.Branch)
+		if !r.branchInfo(branchInfo) {
+			continue
+		}
+		if newKey := pfsdb.BranchKey(branchInfo.Branch); newKey != key {
+			if err := branches.Delete(key); err != nil {
+				return err
+			}
+			key = newKey
+		}
+		if err := branches.Put(key, branchInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *driver) renameCommitInfos(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, r renamer) error {
+	commits := d.commits.ReadWrite(txnCtx.SqlTx)
+	var commitInfos []*pfs.CommitInfo
+	commitInfo := &pfs.CommitInfo{}
+	if err := commits.GetByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repo), commitInfo, col.DefaultOptions(), func(string) error {
+		commitInfos = append(commitInfos, proto.Clone(commitInfo).(*pfs.CommitInfo))
+		return nil
+	}); err != nil && !col.IsErrNotFound(err) {
+		return err
+	}
+	for _, commitInfo := range commitInfos {
+		commit := proto.Clone(commitInfo.Commit).(*pfs.Commit)
+		if !r.commitInfo(commitInfo) {
+			continue
+		}
+		if pfsdb.CommitKey(commitInfo.Commit) != pfsdb.CommitKey(commit) {
+			if err := commits.Delete(pfsdb.CommitKey(commit)); err != nil {
+				return err
+			}
+			if err := d.commitStore.RenameTx(txnCtx.SqlTx, commit, commitInfo.Commit); err != nil {
+				return err
+			}
+			if err := d.unindexMetadata(txnCtx, pfsdb.MetadataCommitKey(commit)); err != nil {
+				return err
+			}
+			if err := d.indexCommitMetadata(txnCtx, commitInfo.Commit, commitInfo.Metadata); err != nil {
+				return err
+			}
+		}
+		if err := commits.Put(pfsdb.CommitKey(commitInfo.Commit), commitInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renameQuotas moves the quotas on repo to newRepo.
+func (d *driver) renameQuotas(txnCtx *txncontext.TransactionContext, repo, newRepo *pfs.Repo) error {
+	quotas := d.quotas.ReadWrite(txnCtx.SqlTx)
+	var quotaInfos []*pfs.Quota
+	quota := &pfs.Quota{}
+	if err := quotas.GetByIndex(pfsdb.QuotasRepoIndex, pfsdb.RepoKey(repo), quota, col.DefaultOptions(), func(string) error {
+		quotaInfos = append(quotaInfos, proto.Clone(quota).(*pfs.Quota))
+		return nil
+	}); err != nil && !col.IsErrNotFound(err) {
+		return err
+	}
+	for _, quota := range quotaInfos {
+		if err := quotas.Delete(pfsdb.QuotaKey(repo, quota.Principal)); err != nil {
+			return err
+		}
+		quota.Repo = newRepo
+		if err := quotas.Create(pfsdb.QuotaKey(newRepo, quota.Principal), quota); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package server
+
+import (
+	"sort"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"golang.org/x/net/context"
+)
+
+// revertCommit creates a commit on branch which undoes the changes made by
+// commit, by restoring the files in its diff to their contents in its parent.
+// branch defaults to the branch of commit.
+func (d *driver) revertCommit(ctx context.Context, commit *pfs.Commit, branch *pfs.Branch, description string) (*pfs.Commit, error) {
+	var newCommit *pfs.Commit
+	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *renew.StringSet) error {
+		commitInfo, diffID, err := d.finishedCommitDiff(ctx, commit)
+		if err != nil {
+			return err
+		}
+		renewer.Add(diffID.HexString())
+		if branch == nil {
+			branch = commitInfo.Commit.Branch
+		}
+		id, err := d.invertDiff(ctx, commitInfo, *diffID, branch.Repo)
+		if err != nil {
+			return err
+		}
+		renewer.Add(id.HexString())
+		newCommit, err = d.applyFileSet(ctx, branch, nil, commitInfo.Commit.Branch.Repo, *id, description, &pfs.CommitOrigin{
+			Kind:     pfs.OriginKind_USER,
+			Reverted: commitInfo.Commit,
+		})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return newCommit, nil
+}
+
+// cherryPickCommit creates a commit on branch which applies the changes made
+// by commit. The diff of commit is reused as is, so no data is copied.
+func (d *driver) cherryPickCommit(ctx context.Context, commit *pfs.Commit, branch *pfs.Branch, description string) (*pfs.Commit, error) {
+	if branch == nil {
+		return nil, errors.New("branch cannot be nil")
+	}
+	var newCommit *pfs.Commit
+	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *renew.StringSet) error {
+		commitInfo, diffID, err := d.finishedCommitDiff(ctx, commit)
+		if err != nil {
+			return err
+		}
+		renewer.Add(diffID.HexString())
+		newCommit, err = d.applyFileSet(ctx, branch, nil, commitInfo.Commit.Branch.Repo, *diffID, description, &pfs.CommitOrigin{
+			Kind:         pfs.OriginKind_USER,
+			CherryPicked: commitInfo.Commit,
+		})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return newCommit, nil
+}
+
+// finishedCommitDiff returns the info of commit and its diff fileset, commit
+// must be finished.
+func (d *driver) finishedCommitDiff(ctx context.Context, commit *pfs.Commit) (*pfs.CommitInfo, *fileset.ID, error) {
+	commitInfo, err := d.inspectCommit(ctx, commit, pfs.CommitState_STARTED)
+	if err != nil {
+		return nil, nil, err
+	}
+	if commitInfo.Finished == nil {
+		return nil, nil, pfsserver.ErrCommitNotFinished{Commit: commitInfo.Commit}
+	}
+	if commitInfo.Error {
+		return nil, nil, pfsserver.ErrCommitError{Commit: commitInfo.Commit}
+	}
+	id, err := d.commitStore.GetDiffFileSet(ctx, commitInfo.Commit)
+	if err != nil {
+		return nil, nil, err
+	}
+	return commitInfo, id, nil
+}
+
+// invertDiff returns a fileset, for repo, which undoes the diff of commitInfo,
+// with id. Every file added or deleted by the diff is deleted, and replaced
+// with its contents in the parent of the commit, if any.
+func (d *driver) invertDiff(ctx context.Context, commitInfo *pfs.CommitInfo, id fileset.ID, repo *pfs.Repo) (*fileset.ID, error) {
+	diff, err := d.storage.Open(ctx, []fileset.ID{id})
+	if err != nil {
+		return nil, err
+	}
+	type pathTag struct{ path, tag string }
+	changed := make(map[pathTag]struct{})
+	collect := func(f fileset.File) error {
+		idx := f.Index()
+		changed[pathTag{idx.Path, idx.File.Tag}] = struct{}{}
+		return nil
+	}
+	if err := diff.Iterate(ctx, collect); err != nil {
+		return nil, err
+	}
+	if err := diff.Iterate(ctx, collect, true); err != nil {
+		return nil, err
+	}
+	var deletes []pathTag
+	for pt := range changed {
+		deletes = append(deletes, pt)
+	}
+	sort.Slice(deletes, func(i, j int) bool {
+		if deletes[i].path != deletes[j].path {
+			return deletes[i].path < deletes[j].path
+		}
+		return deletes[i].tag < deletes[j].tag
+	})
+	w := d.storage.NewWriter(ctx, d.writerOptions(repo)...)
+	for _, pt := range deletes {
+		if err := w.Delete(pt.path, pt.tag); err != nil {
+			return nil, err
+		}
+	}
+	parentCommit, err := d.nonErrorParent(ctx, commitInfo)
+	if err != nil {
+		return nil, err
+	}
+	if parentCommit != nil {
+		parentID, err := d.getFileSet(ctx, parentCommit)
+		if err != nil {
+			return nil, err
+		}
+		parent, err := d.storage.Open(ctx, []fileset.ID{*parentID})
+		if err != nil {
+			return nil, err
+		}
+		parent = fileset.NewIndexFilter(parent, func(idx *index.Index) bool {
+			_, ok := changed[pathTag{idx.Path, idx.File.Tag}]
+			return ok
+		})
+		if err := parent.Iterate(ctx, func(f fileset.File) error {
+			return w.Copy(f, f.Index().File.Tag)
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return w.Close()
+}
+
+// nonErrorParent returns the closest ancestor of commitInfo which isn't in an
+// error state, or nil if there isn't one. Errored commits don't contribute to
+// the contents of their children.
+func (d *driver) nonErrorParent(ctx context.Context, commitInfo *pfs.CommitInfo) (*pfs.Commit, error) {
+	parentCommit := commitInfo.ParentCommit
+	for parentCommit != nil {
+		parentInfo, err := d.getCommit(ctx, parentCommit)
+		if err != nil {
+			return nil, err
+		}
+		if !parentInfo.Error {
+			return parentInfo.Commit, nil
+		}
+		parentCommit = parentInfo.ParentCommit
+	}
+	return nil, nil
+}
+
+// applyFileSet finishes a new commit on branch containing the fileset with id,
+// and records origin in its info. Quotas are checked as the commit is
+// finished. If head is set, it fails if head is no longer the head of branch.
+// source is the repo that the data in the fileset was read from, which the
+// caller must be authorized to read.
+func (d *driver) applyFileSet(ctx context.Context, branch *pfs.Branch, head *pfs.Commit, source *pfs.Repo, id fileset.ID, description string, origin *pfs.CommitOrigin) (*pfs.Commit, error) {
+	var commit *pfs.Commit
+	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, source, auth.Permission_REPO_READ); err != nil {
+			return err
+		}
+		if head != nil {
+			isHead, err := d.isBranchHead(txnCtx, head)
+			if err != nil {
+				return err
+			}
+			if !isHead {
+				return errors.Errorf("the head of branch %s has moved from %s", branch, head.ID)
+			}
+		}
+		var err error
+		commit, err = d.applyFileSetTx(txnCtx, branch, id, description, origin)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
+
+// applyFileSetTx is identical to applyFileSet except it runs in the provided
+// transaction, and doesn't check the head of branch.
+func (d *driver) applyFileSetTx(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, id fileset.ID, description string, origin *pfs.CommitOrigin) (*pfs.Commit, error) {
+	commit, err := d.startCommit(txnCtx, nil, branch, description)
+	if err != nil {
+		return nil, err
+	}
+	commitInfo := &pfs.CommitInfo{}
+	if err := d.commits.ReadWrite(txnCtx.SqlTx).Update(pfsdb.CommitKey(commit), commitInfo, func() error {
+		commitInfo.Origin = origin
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := d.commitStore.AddFileSetTx(txnCtx.SqlTx, commit, id); err != nil {
+		return nil, err
+	}
+	if err := d.finishCommit(txnCtx, commit, "", false, false); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
@@ -34,6 +34,17 @@ func (d *driver) master(ctx context.Context) {
 			gc := chunk.NewGC(d.storage.ChunkStorage())
 			return gc.RunForever(ctx)
 		})
+		eg.Go(func() error {
+			return d.rewrapper.RunForever(ctx)
+		})
+		eg.Go(func() error {
+			return d.watchSizeTriggers(ctx)
+		})
+		if d.scrubber != nil {
+			eg.Go(func() error {
+				return d.scrubber.RunForever(ctx)
+			})
+		}
 		return eg.Wait()
 	}, backoff.NewInfiniteBackOff(), func(err error, _ time.Duration) error {
 		log.Errorf("error in pfs master: %v", err)
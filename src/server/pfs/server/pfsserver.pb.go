@@ -6,6 +6,7 @@ package server
 import (
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
+	chunk "github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -23,12 +24,15 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type CompactionTask struct {
-	Index                int64      `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	Inputs               []string   `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	Range                *PathRange `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Index  int64      `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Inputs []string   `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Range  *PathRange `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
+	// compression, if set, overrides the chunk storage's compression for the
+	// chunks written by the task.
+	Compression          *Compression `protobuf:"bytes,4,opt,name=compression,proto3" json:"compression,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *CompactionTask) Reset()         { *m = CompactionTask{} }
@@ -85,6 +89,13 @@ func (m *CompactionTask) GetRange() *PathRange {
 	return nil
 }
 
+func (m *CompactionTask) GetCompression() *Compression {
+	if m != nil {
+		return m.Compression
+	}
+	return nil
+}
+
 type CompactionTaskResult struct {
 	Index                int64    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -195,32 +206,93 @@ func (m *PathRange) GetUpper() string {
 	return ""
 }
 
+type Compression struct {
+	Algo                 chunk.CompressionAlgo `protobuf:"varint,1,opt,name=algo,proto3,enum=chunk.CompressionAlgo" json:"algo,omitempty"`
+	Level                int64                 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Compression) Reset()         { *m = Compression{} }
+func (m *Compression) String() string { return proto.CompactTextString(m) }
+func (*Compression) ProtoMessage()    {}
+func (*Compression) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a92e512e703e9c, []int{3}
+}
+func (m *Compression) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Compression) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Compression.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Compression) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Compression.Merge(m, src)
+}
+func (m *Compression) XXX_Size() int {
+	return m.Size()
+}
+func (m *Compression) XXX_DiscardUnknown() {
+	xxx_messageInfo_Compression.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Compression proto.InternalMessageInfo
+
+func (m *Compression) GetAlgo() chunk.CompressionAlgo {
+	if m != nil {
+		return m.Algo
+	}
+	return chunk.CompressionAlgo_NONE
+}
+
+func (m *Compression) GetLevel() int64 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CompactionTask)(nil), "pfsserver.CompactionTask")
 	proto.RegisterType((*CompactionTaskResult)(nil), "pfsserver.CompactionTaskResult")
 	proto.RegisterType((*PathRange)(nil), "pfsserver.PathRange")
+	proto.RegisterType((*Compression)(nil), "pfsserver.Compression")
 }
 
 func init() { proto.RegisterFile("server/pfs/server/pfsserver.proto", fileDescriptor_a5a92e512e703e9c) }
 
 var fileDescriptor_a5a92e512e703e9c = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0x4e, 0x2d, 0x2a,
-	0x4b, 0x2d, 0xd2, 0x2f, 0x48, 0x2b, 0xd6, 0x47, 0x30, 0x21, 0x2c, 0xbd, 0x82, 0xa2, 0xfc, 0x92,
-	0x7c, 0x21, 0x4e, 0xb8, 0x80, 0x52, 0x16, 0x17, 0x9f, 0x73, 0x7e, 0x6e, 0x41, 0x62, 0x72, 0x49,
-	0x66, 0x7e, 0x5e, 0x48, 0x62, 0x71, 0xb6, 0x90, 0x08, 0x17, 0x6b, 0x66, 0x5e, 0x4a, 0x6a, 0x85,
-	0x04, 0xa3, 0x02, 0xa3, 0x06, 0x73, 0x10, 0x84, 0x23, 0x24, 0xc6, 0xc5, 0x96, 0x99, 0x57, 0x50,
-	0x5a, 0x52, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x19, 0x04, 0xe5, 0x09, 0x69, 0x71, 0xb1, 0x16,
-	0x25, 0xe6, 0xa5, 0xa7, 0x4a, 0x30, 0x2b, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0xe8, 0x21, 0xec, 0x0a,
-	0x48, 0x2c, 0xc9, 0x08, 0x02, 0xc9, 0x05, 0x41, 0x94, 0x28, 0xd9, 0x70, 0x89, 0xa0, 0xda, 0x15,
-	0x94, 0x5a, 0x5c, 0x9a, 0x53, 0x82, 0xc3, 0x46, 0x3e, 0x2e, 0xa6, 0xcc, 0x14, 0x09, 0x26, 0x05,
-	0x46, 0x0d, 0xce, 0x20, 0xa6, 0xcc, 0x14, 0x25, 0x73, 0x2e, 0x4e, 0xb8, 0x89, 0x20, 0x2d, 0x39,
-	0xf9, 0xe5, 0xa9, 0x45, 0x60, 0x2d, 0x9c, 0x41, 0x10, 0x0e, 0x48, 0xb4, 0xb4, 0xa0, 0x20, 0xb5,
-	0x08, 0xaa, 0x0b, 0xc2, 0x71, 0x72, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0xa3, 0xcc, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0x0b, 0x12, 0x93, 0x33, 0x2a, 0x53, 0x52, 0x8b, 0x90, 0x59, 0x65, 0x46, 0xfa, 0xc5, 0x45, 0xc9,
-	0xfa, 0x18, 0x81, 0x98, 0xc4, 0x06, 0x0e, 0x3b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d,
-	0xaf, 0x30, 0x7a, 0x60, 0x01, 0x00, 0x00,
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x6a, 0xeb, 0x30,
+	0x14, 0x86, 0x91, 0x9d, 0x04, 0xac, 0x40, 0x06, 0x13, 0x82, 0xb9, 0x43, 0xf0, 0xf5, 0x64, 0x32,
+	0x58, 0x90, 0x3b, 0xe4, 0x0e, 0x5d, 0xda, 0xd0, 0xb9, 0x45, 0x74, 0xea, 0xa6, 0xd8, 0xa7, 0xb6,
+	0x88, 0x23, 0x09, 0x49, 0x4e, 0xdb, 0xf7, 0xe9, 0xc3, 0x74, 0xec, 0x23, 0x94, 0x3c, 0x49, 0x89,
+	0x14, 0x12, 0x97, 0xd2, 0x45, 0x9c, 0xff, 0x9c, 0xff, 0x93, 0x7e, 0x74, 0xf0, 0x5f, 0x03, 0x7a,
+	0x0f, 0x9a, 0xa8, 0x27, 0x43, 0x2e, 0xa5, 0xaf, 0x0a, 0xa5, 0xa5, 0x95, 0x71, 0x74, 0x6e, 0xfc,
+	0xc9, 0xb8, 0xb0, 0xa0, 0x05, 0x6b, 0x89, 0xb1, 0x52, 0xb3, 0x1a, 0x48, 0xd9, 0x74, 0x62, 0xeb,
+	0x4f, 0x6f, 0xcf, 0xde, 0x10, 0x9e, 0xac, 0xe5, 0x4e, 0xb1, 0xd2, 0x72, 0x29, 0x1e, 0x98, 0xd9,
+	0xc6, 0x53, 0x3c, 0xe4, 0xa2, 0x82, 0x97, 0x04, 0xa5, 0x28, 0x0f, 0xa9, 0x17, 0xf1, 0x0c, 0x8f,
+	0xb8, 0x50, 0x9d, 0x35, 0x49, 0x90, 0x86, 0x79, 0x44, 0x4f, 0x2a, 0x5e, 0xe0, 0xa1, 0x66, 0xa2,
+	0x86, 0x24, 0x4c, 0x51, 0x3e, 0x5e, 0x4e, 0x8b, 0x4b, 0xa0, 0x7b, 0x66, 0x1b, 0x7a, 0x9c, 0x51,
+	0x6f, 0x89, 0xff, 0xe3, 0x71, 0x29, 0x77, 0x4a, 0x83, 0x31, 0x5c, 0x8a, 0x64, 0xe0, 0x88, 0x59,
+	0x8f, 0x58, 0x5f, 0xa6, 0xb4, 0x6f, 0xcd, 0xae, 0xf0, 0xf4, 0x7b, 0x4a, 0x0a, 0xa6, 0x6b, 0xed,
+	0x2f, 0x59, 0x27, 0x38, 0xe0, 0x55, 0x12, 0xa4, 0x28, 0x8f, 0x68, 0xc0, 0xab, 0x6c, 0x85, 0xa3,
+	0x73, 0x96, 0x23, 0xd2, 0xca, 0x67, 0xd0, 0x0e, 0x89, 0xa8, 0x17, 0xc7, 0x6e, 0xa7, 0x14, 0xe8,
+	0x13, 0xe5, 0x45, 0x76, 0x87, 0xc7, 0xbd, 0x48, 0xf1, 0x02, 0x0f, 0x58, 0x5b, 0x4b, 0x47, 0x4e,
+	0x96, 0xb3, 0xc2, 0x7f, 0x64, 0xcf, 0x71, 0xdd, 0xd6, 0x92, 0x3a, 0x8f, 0x7b, 0x06, 0xf6, 0xd0,
+	0xba, 0x0b, 0x43, 0xea, 0xc5, 0xcd, 0xed, 0xfb, 0x61, 0x8e, 0x3e, 0x0e, 0x73, 0xf4, 0x79, 0x98,
+	0xa3, 0xc7, 0x55, 0xcd, 0x6d, 0xd3, 0x6d, 0x8a, 0x52, 0xee, 0x88, 0x62, 0x65, 0xf3, 0x5a, 0x81,
+	0xee, 0x57, 0xfb, 0x25, 0x31, 0xba, 0x24, 0x3f, 0x96, 0xbe, 0x19, 0xb9, 0xe5, 0xfd, 0xfb, 0x1a,
+	0x00, 0x48, 0x4a, 0x13, 0x0c, 0x10, 0x02, 0x00, 0x00,
 }
 
 func (m *CompactionTask) Marshal() (dAtA []byte, err error) {
@@ -247,6 +319,18 @@ func (m *CompactionTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Compression != nil {
+		{
+			size, err := m.Compression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfsserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Range != nil {
 		{
 			size, err := m.Range.MarshalToSizedBuffer(dAtA[:i])
@@ -356,6 +440,43 @@ func (m *PathRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Compression) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Compression) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Compression) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Level != 0 {
+		i = encodeVarintPfsserver(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Algo != 0 {
+		i = encodeVarintPfsserver(dAtA, i, uint64(m.Algo))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPfsserver(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPfsserver(v)
 	base := offset
@@ -386,6 +507,10 @@ func (m *CompactionTask) Size() (n int) {
 		l = m.Range.Size()
 		n += 1 + l + sovPfsserver(uint64(l))
 	}
+	if m.Compression != nil {
+		l = m.Compression.Size()
+		n += 1 + l + sovPfsserver(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -431,6 +556,24 @@ func (m *PathRange) Size() (n int) {
 	return n
 }
 
+func (m *Compression) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Algo != 0 {
+		n += 1 + sovPfsserver(uint64(m.Algo))
+	}
+	if m.Level != 0 {
+		n += 1 + sovPfsserver(uint64(m.Level))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovPfsserver(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -553,6 +696,42 @@ func (m *CompactionTask) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfsserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfsserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfsserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Compression == nil {
+				m.Compression = &Compression{}
+			}
+			if err := m.Compression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfsserver(dAtA[iNdEx:])
@@ -792,6 +971,95 @@ func (m *PathRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Compression) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfsserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Compression: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Compression: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algo", wireType)
+			}
+			m.Algo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfsserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algo |= chunk.CompressionAlgo(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfsserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfsserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfsserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPfsserver(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -52,9 +52,10 @@ func (s *source) Iterate(ctx context.Context, cb func(*pfs.FileInfo, fileset.Fil
 		file := s.commitInfo.Commit.NewFile(idx.Path)
 		file.Tag = idx.File.Tag
 		fi := &pfs.FileInfo{
-			File:      file,
-			FileType:  pfs.FileType_FILE,
-			Committed: s.commitInfo.Finished,
+			File:       file,
+			FileType:   pfs.FileType_FILE,
+			Committed:  s.commitInfo.Finished,
+			Attributes: idx.File.Attributes,
 		}
 		if fileset.IsDir(idx.Path) {
 			fi.FileType = pfs.FileType_DIR
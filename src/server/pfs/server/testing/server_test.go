@@ -2,6 +2,7 @@ package testing
 
 import (
 	"archive/tar"
+	"bufio"
 	"bytes"
 	"context"
 	"fmt"
@@ -21,17 +22,23 @@ import (
 
 	units "github.com/docker/go-units"
 	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/clientsdk"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
 	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
@@ -1071,6 +1078,66 @@ func TestPFS(suite *testing.T) {
 		require.Equal(t, commit3, commitInfo.Commit)
 	})
 
+	suite.Run("TimeSyntax", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+
+		// Commits are given explicit timestamps, the commit i is started and
+		// finished at noon on June i.
+		commits := pfsdb.Commits(env.ServiceEnv.GetDBClient(), env.ServiceEnv.GetPostgresListener())
+		for i := 1; i <= 3; i++ {
+			require.NoError(t, env.PachClient.PutFile(client.NewCommit(repo, "master", ""), "file", strings.NewReader(fmt.Sprintf("%d", i))))
+			head, err := env.PachClient.InspectCommit(repo, "master", "")
+			require.NoError(t, err)
+			ts, err := types.TimestampProto(time.Date(2021, time.June, i, 12, 0, 0, 0, time.UTC))
+			require.NoError(t, err)
+			require.NoError(t, col.NewSQLTx(env.Context, env.ServiceEnv.GetDBClient(), func(tx *sqlx.Tx) error {
+				commitInfo := &pfs.CommitInfo{}
+				return commits.ReadWrite(tx).Update(pfsdb.CommitKey(head.Commit), commitInfo, func() error {
+					commitInfo.Started = ts
+					commitInfo.Finished = ts
+					return nil
+				})
+			}))
+		}
+
+		for ref, expected := range map[string]string{
+			"@2021-06-01T12:00:00Z":        "1",
+			"@2021-06-02":                  "1",
+			"@2021-06-02T11:59:59.999Z":    "1",
+			"@2021-06-02T12:00Z":           "2",
+			"@2021-06-02T14:00:00+02:00":   "2",
+			"@2021-06-03T12:00:00Z":        "3",
+			"master@2021-06-02T12:00:00Z":  "2",
+			"master@2021-06-03T13:00:00Z":  "3",
+			"master^@2021-06-03T13:00:00Z": "2",
+		} {
+			branch := "master"
+			if !strings.HasPrefix(ref, "@") {
+				branch = ""
+			}
+			var buffer bytes.Buffer
+			require.NoError(t, env.PachClient.GetFile(client.NewCommit(repo, branch, ref), "file", &buffer), ref)
+			require.Equal(t, expected, buffer.String(), ref)
+		}
+
+		commitInfo, err := env.PachClient.InspectCommit(repo, "master", "@"+time.Now().Add(time.Hour).Format(time.RFC3339))
+		require.NoError(t, err)
+		headInfo, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, headInfo.Commit.ID, commitInfo.Commit.ID)
+
+		_, err = env.PachClient.InspectCommit(repo, "master", "@2021-06-01")
+		require.YesError(t, err)
+		_, err = env.PachClient.InspectCommit(repo, "master", "@2000-01-01")
+		require.YesError(t, err)
+		_, err = env.PachClient.InspectCommit(repo, "master", "@yesterday")
+		require.YesError(t, err)
+	})
+
 	// Provenance implements the following DAG
 	//  A ─▶ B ─▶ C ─▶ D
 	//            ▲
@@ -2760,234 +2827,191 @@ func TestPFS(suite *testing.T) {
 	})
 
 	suite.Run("PutFileSplit", func(t *testing.T) {
-		// TODO(2.0 optional): Implement put file split.
-		t.Skip("Put file split not implemented in V2")
-		//	t.Parallel()
-		//  env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
-		//
-		//	if testing.Short() {
-		//		t.Skip("Skipping integration tests in short mode")
-		//	}
-		//
-		//	repo := "test"
-		//	require.NoError(t, env.PachClient.CreateRepo(repo))
-		//	commit, err := env.PachClient.StartCommit(repo, "master")
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "none", pfs.Delimiter_NONE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line2", pfs.Delimiter_LINE, 2, 0, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line3", pfs.Delimiter_LINE, 0, 8, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json2", pfs.Delimiter_JSON, 2, 0, 0, false, strings.NewReader("{}{}{}{}"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json3", pfs.Delimiter_JSON, 0, 4, 0, false, strings.NewReader("{}{}{}{}"))
-		//	require.NoError(t, err)
-		//
-		//	files, err := env.PachClient.ListFileAll(repo, commit.ID, "line2")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(8), fileInfo.SizeBytes)
-		//	}
-		//
-		//	require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
-		//	commit2, err := env.PachClient.StartCommit(repo, "master")
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit2.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit2.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-		//	require.NoError(t, err)
-		//
-		//	files, err = env.PachClient.ListFileAll(repo, commit2.ID, "line")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 9, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
-		//
-		//	require.NoError(t, env.PachClient.FinishCommit(repo, commit2.ID))
-		//	fileInfo, err := env.PachClient.InspectFile(repo, commit.ID, "none")
-		//	require.NoError(t, err)
-		//	require.Equal(t, pfs.FileType_FILE, fileInfo.FileType)
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "line")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 6, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit2.ID, "line")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 9, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "line2")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(8), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "line3")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(8), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "json")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 20, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(2), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit2.ID, "json")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 30, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(2), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "json2")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "json3")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		putFileSplit := func(commit *pfs.Commit, path string, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes int64, data string) {
+			require.NoError(t, env.PachClient.PutFile(commit, path, strings.NewReader(data),
+				client.WithAppendPutFile(),
+				client.WithSplitPutFile(delimiter),
+				client.WithTargetFileDatumsPutFile(targetFileDatums),
+				client.WithTargetFileBytesPutFile(targetFileBytes),
+			))
+		}
+		require.NoError(t, env.PachClient.PutFile(commit, "none", strings.NewReader("foo\nbar\nbuz\n")))
+		putFileSplit(commit, "line", pfs.Delimiter_LINE, 0, 0, "foo\nbar\nbuz\n")
+		putFileSplit(commit, "line", pfs.Delimiter_LINE, 0, 0, "foo\nbar\nbuz\n")
+		putFileSplit(commit, "line2", pfs.Delimiter_LINE, 2, 0, "foo\nbar\nbuz\nfiz\n")
+		putFileSplit(commit, "line3", pfs.Delimiter_LINE, 0, 8, "foo\nbar\nbuz\nfiz\n")
+		putFileSplit(commit, "json", pfs.Delimiter_JSON, 0, 0, "{}{}{}{}{}{}{}{}{}{}")
+		putFileSplit(commit, "json", pfs.Delimiter_JSON, 0, 0, "{}{}{}{}{}{}{}{}{}{}")
+		putFileSplit(commit, "json2", pfs.Delimiter_JSON, 2, 0, "{}{}{}{}")
+		putFileSplit(commit, "json3", pfs.Delimiter_JSON, 0, 4, "{}{}{}{}")
+
+		checkFiles := func(commit *pfs.Commit, path string, count int, size int64) {
+			files, err := env.PachClient.ListFileAll(commit, path)
+			require.NoError(t, err)
+			require.Equal(t, count, len(files))
+			for _, fileInfo := range files {
+				require.Equal(t, size, fileInfo.SizeBytes)
+			}
+		}
+		checkFiles(commit, "line2", 2, 8)
+
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.Branch.Name, commit.ID))
+		commit2, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		putFileSplit(commit2, "line", pfs.Delimiter_LINE, 0, 0, "foo\nbar\nbuz\n")
+		putFileSplit(commit2, "json", pfs.Delimiter_JSON, 0, 0, "{}{}{}{}{}{}{}{}{}{}")
+		checkFiles(commit2, "line", 9, 4)
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit2.Branch.Name, commit2.ID))
+
+		fileInfo, err := env.PachClient.InspectFile(commit, "none")
+		require.NoError(t, err)
+		require.Equal(t, pfs.FileType_FILE, fileInfo.FileType)
+		checkFiles(commit, "line", 6, 4)
+		checkFiles(commit2, "line", 9, 4)
+		checkFiles(commit, "line2", 2, 8)
+		checkFiles(commit, "line3", 2, 8)
+		checkFiles(commit, "json", 20, 2)
+		checkFiles(commit2, "json", 30, 2)
+		checkFiles(commit, "json2", 2, 4)
+		checkFiles(commit, "json3", 2, 4)
+
+		// Without append, a split put replaces the files in the directory.
+		commit3, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit3, "line", strings.NewReader("foo\n"), client.WithSplitPutFile(pfs.Delimiter_LINE)))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit3.Branch.Name, commit3.ID))
+		checkFiles(commit3, "line", 1, 4)
 	})
 
 	suite.Run("PutFileSplitBig", func(t *testing.T) {
-		// TODO(2.0 optional): Implement put file split.
-		t.Skip("Put file split not implemented in V2")
-		//	t.Parallel()
-		//  env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
-		//
-		//	if testing.Short() {
-		//		t.Skip("Skipping integration tests in short mode")
-		//	}
-		//
-		//	// create repos
-		//	repo := "test"
-		//	require.NoError(t, env.PachClient.CreateRepo(repo))
-		//	commit, err := env.PachClient.StartCommit(repo, "master")
-		//	require.NoError(t, err)
-		//	w, err := env.PachClient.PutFileSplitWriter(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false)
-		//	require.NoError(t, err)
-		//	for i := 0; i < 1000; i++ {
-		//		_, err = w.Write([]byte("foo\n"))
-		//		require.NoError(t, err)
-		//	}
-		//	require.NoError(t, w.Close())
-		//	require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
-		//	files, err := env.PachClient.ListFileAll(repo, commit.ID, "line")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 1000, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		// create repos
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		r, w := io.Pipe()
+		go func() {
+			for i := 0; i < 1000; i++ {
+				if _, err := w.Write([]byte("foo\n")); err != nil {
+					w.CloseWithError(err)
+					return
+				}
+			}
+			w.Close()
+		}()
+		require.NoError(t, env.PachClient.PutFile(commit, "line", r, client.WithSplitPutFile(pfs.Delimiter_LINE)))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.Branch.Name, commit.ID))
+		files, err := env.PachClient.ListFileAll(commit, "line")
+		require.NoError(t, err)
+		require.Equal(t, 1000, len(files))
+		for _, fileInfo := range files {
+			require.Equal(t, int64(4), fileInfo.SizeBytes)
+		}
 	})
 
 	suite.Run("PutFileSplitCSV", func(t *testing.T) {
-		// TODO(2.0 optional): Implement put file split.
-		t.Skip("Put file split not implemented in V2")
-		//	t.Parallel()
-		//  env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
-		//
-		//	// create repos
-		//	repo := "test"
-		//	require.NoError(t, env.PachClient.CreateRepo(repo))
-		//	_, err := env.PachClient.PutFileSplit(repo, "master", "data", pfs.Delimiter_CSV, 0, 0, 0, false,
-		//		// Weird, but this is actually two lines ("is\na" is quoted, so one cell)
-		//		strings.NewReader("this,is,a,test\n"+
-		//			"\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n"))
-		//	require.NoError(t, err)
-		//	fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/data")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(fileInfos))
-		//	var contents bytes.Buffer
-		//	env.PachClient.GetFile(repo, "master", "/data/0000000000000000", &contents)
-		//	require.Equal(t, "this,is,a,test\n", contents.String())
-		//	contents.Reset()
-		//	env.PachClient.GetFile(repo, "master", "/data/0000000000000001", &contents)
-		//	require.Equal(t, "\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n", contents.String())
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		// create repos
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(commit, "data",
+			// Weird, but this is actually two lines ("is\na" is quoted, so one cell)
+			strings.NewReader("this,is,a,test\n"+
+				"\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n"),
+			client.WithSplitPutFile(pfs.Delimiter_CSV)))
+		fileInfos, err := env.PachClient.ListFileAll(commit, "/data")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(fileInfos))
+		var contents bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(commit, "/data/0000000000000000", &contents))
+		require.Equal(t, "this,is,a,test\n", contents.String())
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(commit, "/data/0000000000000001", &contents))
+		require.Equal(t, "\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n", contents.String())
+
+		// With a header record, the header is repeated in every file.
+		require.NoError(t, env.PachClient.PutFile(commit, "header", strings.NewReader("a,b\n1,2\n3,4\n"),
+			client.WithSplitPutFile(pfs.Delimiter_CSV),
+			client.WithHeaderRecordsPutFile(1)))
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(commit, "/header/0000000000000001", &contents))
+		require.Equal(t, "a,b\n3,4\n", contents.String())
 	})
 
 	suite.Run("PutFileSplitSQL", func(t *testing.T) {
-		// TODO(2.0 optional): Implement put file split.
-		t.Skip("Put file split not implemented in V2")
-		//	t.Parallel()
-		//  env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
-		//
-		//	// create repos
-		//	repo := "test"
-		//	require.NoError(t, env.PachClient.CreateRepo(repo))
-		//
-		//	_, err := env.PachClient.PutFileSplit(repo, "master", "/sql", pfs.Delimiter_SQL, 0, 0, 0,
-		//		false, strings.NewReader(tu.TestPGDump))
-		//	require.NoError(t, err)
-		//	fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/sql")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 5, len(fileInfos))
-		//
-		//	// Get one of the SQL records & validate it
-		//	var contents bytes.Buffer
-		//	env.PachClient.GetFile(repo, "master", "/sql/0000000000000000", &contents)
-		//	// Validate that the recieved pgdump file creates the cars table
-		//	require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
-		//	// Validate the SQL header more generally by passing the output of GetFile
-		//	// back through the SQL library & confirm that it parses correctly but only
-		//	// has one row
-		//	pgReader := sql.NewPGDumpReader(bufio.NewReader(bytes.NewReader(contents.Bytes())))
-		//	record, err := pgReader.ReadRow()
-		//	require.NoError(t, err)
-		//	require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
-		//	_, err = pgReader.ReadRow()
-		//	require.YesError(t, err)
-		//	require.True(t, errors.Is(err, io.EOF))
-		//
-		//	// Create a new commit that overwrites all existing data & puts it back with
-		//	// --header-records=1
-		//	commit, err := env.PachClient.StartCommit(repo, "master")
-		//	require.NoError(t, err)
-		//	require.NoError(t, env.PachClient.DeleteFile(repo, commit.ID, "/sql"))
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "/sql", pfs.Delimiter_SQL, 0, 0, 1,
-		//		false, strings.NewReader(tu.TestPGDump))
-		//	require.NoError(t, err)
-		//	require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
-		//	fileInfos, err = env.PachClient.ListFileAll(repo, "master", "/sql")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 4, len(fileInfos))
-		//
-		//	// Get one of the SQL records & validate it
-		//	contents.Reset()
-		//	env.PachClient.GetFile(repo, "master", "/sql/0000000000000003", &contents)
-		//	// Validate a that the recieved pgdump file creates the cars table
-		//	require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
-		//	// Validate the SQL header more generally by passing the output of GetFile
-		//	// back through the SQL library & confirm that it parses correctly but only
-		//	// has one row
-		//	pgReader = sql.NewPGDumpReader(bufio.NewReader(strings.NewReader(contents.String())))
-		//	record, err = pgReader.ReadRow()
-		//	require.NoError(t, err)
-		//	require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
-		//	record, err = pgReader.ReadRow()
-		//	require.NoError(t, err)
-		//	require.Equal(t, "Toyota\tCorolla\t2005\tgreatest car ever made\n", string(record))
-		//	_, err = pgReader.ReadRow()
-		//	require.YesError(t, err)
-		//	require.True(t, errors.Is(err, io.EOF))
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		// create repos
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		master := client.NewCommit(repo, "master", "")
+
+		require.NoError(t, env.PachClient.PutFile(master, "/sql", strings.NewReader(tu.TestPGDump),
+			client.WithSplitPutFile(pfs.Delimiter_SQL)))
+		fileInfos, err := env.PachClient.ListFileAll(master, "/sql")
+		require.NoError(t, err)
+		require.Equal(t, 5, len(fileInfos))
+
+		// Get one of the SQL records & validate it
+		var contents bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(master, "/sql/0000000000000000", &contents))
+		// Validate that the recieved pgdump file creates the cars table
+		require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
+		// Validate the SQL header more generally by passing the output of GetFile
+		// back through the SQL library & confirm that it parses correctly but only
+		// has one row
+		pgReader := sql.NewPGDumpReader(bufio.NewReader(bytes.NewReader(contents.Bytes())))
+		record, err := pgReader.ReadRow()
+		require.NoError(t, err)
+		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
+		_, err = pgReader.ReadRow()
+		require.YesError(t, err)
+		require.True(t, errors.Is(err, io.EOF))
+
+		// Create a new commit that overwrites all existing data & puts it back with
+		// --header-records=1
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit, "/sql", strings.NewReader(tu.TestPGDump),
+			client.WithSplitPutFile(pfs.Delimiter_SQL),
+			client.WithHeaderRecordsPutFile(1)))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.Branch.Name, commit.ID))
+		fileInfos, err = env.PachClient.ListFileAll(master, "/sql")
+		require.NoError(t, err)
+		require.Equal(t, 4, len(fileInfos))
+
+		// Get one of the SQL records & validate it
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(master, "/sql/0000000000000003", &contents))
+		// Validate a that the recieved pgdump file creates the cars table
+		require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
+		// Validate the SQL header more generally by passing the output of GetFile
+		// back through the SQL library & confirm that it parses correctly but only
+		// has one row
+		pgReader = sql.NewPGDumpReader(bufio.NewReader(strings.NewReader(contents.String())))
+		record, err = pgReader.ReadRow()
+		require.NoError(t, err)
+		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
+		record, err = pgReader.ReadRow()
+		require.NoError(t, err)
+		require.Equal(t, "Toyota\tCorolla\t2005\tgreatest car ever made\n", string(record))
+		_, err = pgReader.ReadRow()
+		require.YesError(t, err)
+		require.True(t, errors.Is(err, io.EOF))
 	})
 
 	suite.Run("DiffFile", func(t *testing.T) {
@@ -4054,74 +4078,810 @@ func TestPFS(suite *testing.T) {
 		require.Equal(t, c.ID, masterInfo.Head.ID)
 	})
 
-	suite.Run("CommitState", func(t *testing.T) {
+	suite.Run("RevertCommit", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
 
-		// two input repos, one with many commits (logs), and one with few (schema)
-		require.NoError(t, env.PachClient.CreateRepo("A"))
-		require.NoError(t, env.PachClient.CreateRepo("B"))
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(master, "a", strings.NewReader("foo")))
+		require.NoError(t, env.PachClient.PutFile(master, "b", strings.NewReader("bar")))
+		// The bad commit overwrites a, adds c and deletes b
+		bad, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(bad, "a", strings.NewReader("oops")))
+		require.NoError(t, env.PachClient.PutFile(bad, "c", strings.NewReader("oops")))
+		require.NoError(t, env.PachClient.DeleteFile(bad, "b"))
+		require.NoError(t, env.PachClient.FinishCommit(repo, bad.Branch.Name, bad.ID))
+		// A later commit is preserved by the revert
+		require.NoError(t, env.PachClient.PutFile(master, "d", strings.NewReader("baz")))
 
-		require.NoError(t, env.PachClient.CreateBranch("B", "master", "", "", []*pfs.Branch{client.NewBranch("A", "master")}))
+		revert, err := env.PachClient.RevertCommit(bad, nil, "")
+		require.NoError(t, err)
+		commitInfo, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, revert.ID, commitInfo.Commit.ID)
+		require.Equal(t, bad.ID, commitInfo.Origin.Reverted.ID)
 
-		// Start a commit on A/master, this will create a non-ready commit on B/master.
-		_, err := env.PachClient.StartCommit("A", "master")
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(master, "a", &buf))
+		require.Equal(t, "foo", buf.String())
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(master, "b", &buf))
+		require.Equal(t, "bar", buf.String())
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(master, "d", &buf))
+		require.Equal(t, "baz", buf.String())
+		_, err = env.PachClient.InspectFile(master, "c")
+		require.YesError(t, err)
+
+		// The reverted commit is still in the history
+		commitInfos, err := env.PachClient.ListCommit(client.NewRepo(repo), master, nil, 0)
 		require.NoError(t, err)
+		require.Equal(t, 5, len(commitInfos))
 
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
-		defer cancel()
-		_, err = env.PachClient.PfsAPIClient.InspectCommit(ctx, &pfs.InspectCommitRequest{
-			Commit: client.NewCommit("B", "master", ""),
-			Wait:   pfs.CommitState_READY,
-		})
+		// Unfinished commits can't be reverted
+		openCommit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = env.PachClient.RevertCommit(openCommit, nil, "")
 		require.YesError(t, err)
+		require.True(t, pfsserver.IsCommitNotFinishedErr(err))
+	})
 
-		// Finish the commit on A/master, that will make the B/master ready.
-		require.NoError(t, env.PachClient.FinishCommit("A", "master", ""))
+	suite.Run("CherryPickCommit", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
 
-		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
-		defer cancel()
-		_, err = env.PachClient.PfsAPIClient.InspectCommit(ctx, &pfs.InspectCommitRequest{
-			Commit: client.NewCommit("B", "master", ""),
-			Wait:   pfs.CommitState_READY,
-		})
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(master, "a", strings.NewReader("foo")))
+		fix, err := env.PachClient.StartCommit(repo, "master")
 		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(fix, "b", strings.NewReader("fix")))
+		require.NoError(t, env.PachClient.DeleteFile(fix, "a"))
+		require.NoError(t, env.PachClient.FinishCommit(repo, fix.Branch.Name, fix.ID))
 
-		// Create a new branch C/master with A/master as provenance. It should start out ready.
-		require.NoError(t, env.PachClient.CreateRepo("C"))
-		require.NoError(t, env.PachClient.CreateBranch("C", "master", "", "", []*pfs.Branch{client.NewBranch("A", "master")}))
+		release := client.NewCommit(repo, "release", "")
+		require.NoError(t, env.PachClient.PutFile(release, "a", strings.NewReader("bar")))
+		require.NoError(t, env.PachClient.PutFile(release, "c", strings.NewReader("baz")))
+		cherryPick, err := env.PachClient.CherryPickCommit(fix, client.NewBranch(repo, "release"), "backport fix")
+		require.NoError(t, err)
+		commitInfo, err := env.PachClient.InspectCommit(repo, "release", "")
+		require.NoError(t, err)
+		require.Equal(t, cherryPick.ID, commitInfo.Commit.ID)
+		require.Equal(t, fix.ID, commitInfo.Origin.CherryPicked.ID)
+		require.Equal(t, "backport fix", commitInfo.Description)
 
-		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
-		defer cancel()
-		_, err = env.PachClient.PfsAPIClient.InspectCommit(ctx, &pfs.InspectCommitRequest{
-			Commit: client.NewCommit("C", "master", ""),
-			Wait:   pfs.CommitState_READY,
-		})
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(release, "b", &buf))
+		require.Equal(t, "fix", buf.String())
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(release, "c", &buf))
+		require.Equal(t, "baz", buf.String())
+		_, err = env.PachClient.InspectFile(release, "a")
+		require.YesError(t, err)
+
+		// Changes can be applied to branches in other repos
+		require.NoError(t, env.PachClient.CreateRepo("other"))
+		_, err = env.PachClient.CherryPickCommit(fix, client.NewBranch("other", "master"), "")
 		require.NoError(t, err)
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(client.NewCommit("other", "master", ""), "b", &buf))
+		require.Equal(t, "fix", buf.String())
 	})
 
-	suite.Run("SubscribeStates", func(t *testing.T) {
+	suite.Run("MergeBranch", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
 
-		require.NoError(t, env.PachClient.CreateRepo("A"))
-		require.NoError(t, env.PachClient.CreateRepo("B"))
-		require.NoError(t, env.PachClient.CreateRepo("C"))
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		master := client.NewCommit(repo, "master", "")
+		feature := client.NewCommit(repo, "feature", "")
+		require.NoError(t, env.PachClient.PutFile(master, "a", strings.NewReader("a")))
+		require.NoError(t, env.PachClient.PutFile(master, "b", strings.NewReader("b")))
+		require.NoError(t, env.PachClient.PutFile(master, "c", strings.NewReader("c")))
+		require.NoError(t, env.PachClient.CreateBranch(repo, "feature", "master", "", nil))
+
+		require.NoError(t, env.PachClient.PutFile(feature, "a", strings.NewReader("feature")))
+		require.NoError(t, env.PachClient.DeleteFile(feature, "b"))
+		require.NoError(t, env.PachClient.PutFile(feature, "d", strings.NewReader("feature")))
+		require.NoError(t, env.PachClient.PutFile(master, "c", strings.NewReader("master")))
+		require.NoError(t, env.PachClient.PutFile(master, "e", strings.NewReader("master")))
+
+		checkFile := func(commit *pfs.Commit, path, expected string) {
+			var buf bytes.Buffer
+			require.NoError(t, env.PachClient.GetFile(commit, path, &buf))
+			require.Equal(t, expected, buf.String())
+		}
+		// There are no conflicts, so the changes on both branches are kept
+		resp, err := env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergeStrategy_FAIL)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(resp.Conflicts))
+		commitInfo, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, resp.Commit.ID, commitInfo.Commit.ID)
+		require.NotNil(t, commitInfo.Origin.Merged)
+		checkFile(master, "a", "feature")
+		checkFile(master, "c", "master")
+		checkFile(master, "d", "feature")
+		checkFile(master, "e", "master")
+		_, err = env.PachClient.InspectFile(master, "b")
+		require.YesError(t, err)
 
-		require.NoError(t, env.PachClient.CreateBranch("B", "master", "", "", []*pfs.Branch{client.NewBranch("A", "master")}))
-		require.NoError(t, env.PachClient.FinishCommit("B", "master", ""))
-		require.NoError(t, env.PachClient.CreateBranch("C", "master", "", "", []*pfs.Branch{client.NewBranch("B", "master")}))
-		require.NoError(t, env.PachClient.FinishCommit("C", "master", ""))
+		// Merging again does nothing
+		resp, err = env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergeStrategy_FAIL)
+		require.NoError(t, err)
+		require.Equal(t, commitInfo.Commit.ID, resp.Commit.ID)
 
-		ctx, cancel := context.WithCancel(env.PachClient.Ctx())
-		defer cancel()
-		pachClient := env.PachClient.WithCtx(ctx)
+		// Conflicting changes fail the merge
+		require.NoError(t, env.PachClient.PutFile(feature, "a", strings.NewReader("theirs")))
+		require.NoError(t, env.PachClient.PutFile(master, "a", strings.NewReader("ours")))
+		resp, err = env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergeStrategy_FAIL)
+		require.NoError(t, err)
+		require.Nil(t, resp.Commit)
+		require.Equal(t, 1, len(resp.Conflicts))
+		require.Equal(t, "/a", resp.Conflicts[0].Path)
+		checkFile(master, "a", "ours")
 
-		var readyCommits int64
-		go func() {
-			pachClient.SubscribeCommit(client.NewRepo("B"), "master", "", pfs.CommitState_READY, func(ci *pfs.CommitInfo) error {
-				atomic.AddInt64(&readyCommits, 1)
-				return nil
+		resp, err = env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergeStrategy_OURS)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Commit)
+		require.Equal(t, 1, len(resp.Conflicts))
+		checkFile(master, "a", "ours")
+
+		require.NoError(t, env.PachClient.PutFile(feature, "a", strings.NewReader("theirs again")))
+		resp, err = env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergeStrategy_THEIRS)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Commit)
+		require.Equal(t, 1, len(resp.Conflicts))
+		checkFile(master, "a", "theirs again")
+	})
+
+	suite.Run("RenameRepo", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("in"))
+		require.NoError(t, env.PachClient.CreateRepo("out"))
+		require.NoError(t, env.PachClient.CreateBranch("out", "master", "", "", []*pfs.Branch{client.NewBranch("in", "master")}))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("in", "master", ""), "foo", strings.NewReader("foo")))
+		commitInfo, err := env.PachClient.InspectCommit("in", "master", "")
+		require.NoError(t, err)
+
+		require.NoError(t, env.PachClient.RenameRepo("in", "renamed"))
+		_, err = env.PachClient.InspectRepo("in")
+		require.YesError(t, err)
+		repoInfo, err := env.PachClient.InspectRepo("renamed")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(repoInfo.Branches))
+		require.Equal(t, "renamed", repoInfo.Branches[0].Repo.Name)
+
+		// Commits keep their IDs and data
+		renamedInfo, err := env.PachClient.InspectCommit("renamed", "master", "")
+		require.NoError(t, err)
+		require.Equal(t, commitInfo.Commit.ID, renamedInfo.Commit.ID)
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(renamedInfo.Commit, "foo", &buf))
+		require.Equal(t, "foo", buf.String())
+
+		branchInfo, err := env.PachClient.InspectBranch("out", "master")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(branchInfo.Provenance))
+		require.Equal(t, "renamed", branchInfo.Provenance[0].Repo.Name)
+		outInfo, err := env.PachClient.InspectCommit("out", "master", "")
+		require.NoError(t, err)
+		require.Equal(t, "renamed", outInfo.DirectProvenance[0].Repo.Name)
+
+		// New commits propagate along the renamed provenance
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("renamed", "master", ""), "bar", strings.NewReader("bar")))
+		commitInfo, err = env.PachClient.InspectCommit("renamed", "master", "")
+		require.NoError(t, err)
+		outInfo, err = env.PachClient.InspectCommit("out", "master", "")
+		require.NoError(t, err)
+		require.Equal(t, commitInfo.Commit.ID, outInfo.Commit.ID)
+
+		require.YesError(t, env.PachClient.RenameRepo("renamed", "out"))
+		require.YesError(t, env.PachClient.RenameRepo("in", "foo"))
+	})
+
+	suite.Run("RenameBranch", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("in"))
+		require.NoError(t, env.PachClient.CreateRepo("out"))
+		require.NoError(t, env.PachClient.CreateBranch("out", "master", "", "", []*pfs.Branch{client.NewBranch("in", "master")}))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("in", "master", ""), "foo", strings.NewReader("foo")))
+		require.NoError(t, env.PachClient.CreateBranchTrigger("in", "staging", "", "", &pfs.Trigger{
+			Branch:  "master",
+			Commits: 1,
+		}))
+		commitInfo, err := env.PachClient.InspectCommit("in", "master", "")
+		require.NoError(t, err)
+
+		require.NoError(t, env.PachClient.RenameBranch("in", "master", "main"))
+		_, err = env.PachClient.InspectBranch("in", "master")
+		require.YesError(t, err)
+		renamedInfo, err := env.PachClient.InspectCommit("in", "main", "")
+		require.NoError(t, err)
+		require.Equal(t, commitInfo.Commit.ID, renamedInfo.Commit.ID)
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(renamedInfo.Commit, "foo", &buf))
+		require.Equal(t, "foo", buf.String())
+
+		branchInfo, err := env.PachClient.InspectBranch("out", "master")
+		require.NoError(t, err)
+		require.Equal(t, "main", branchInfo.Provenance[0].Name)
+		branchInfo, err = env.PachClient.InspectBranch("in", "staging")
+		require.NoError(t, err)
+		require.Equal(t, "main", branchInfo.Trigger.Branch)
+
+		require.YesError(t, env.PachClient.RenameBranch("in", "main", "staging"))
+		require.YesError(t, env.PachClient.RenameBranch("in", "master", "foo"))
+	})
+
+	suite.Run("ForkRepo", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("in"))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("in", "master", ""), "foo", strings.NewReader("foo")))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("in", "dev", ""), "bar", strings.NewReader("bar")))
+		masterInfo, err := env.PachClient.InspectCommit("in", "master", "")
+		require.NoError(t, err)
+
+		require.NoError(t, env.PachClient.ForkRepo("in", "fork"))
+		repoInfo, err := env.PachClient.InspectRepo("fork")
+		require.NoError(t, err)
+		require.Equal(t, "in", repoInfo.ForkedFrom.Name)
+		require.Equal(t, 2, len(repoInfo.Branches))
+
+		forkInfo, err := env.PachClient.InspectCommit("fork", "master", "")
+		require.NoError(t, err)
+		require.Equal(t, masterInfo.Commit.ID, forkInfo.Origin.Forked.ID)
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(forkInfo.Commit, "foo", &buf))
+		require.Equal(t, "foo", buf.String())
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(client.NewCommit("fork", "dev", ""), "bar", &buf))
+		require.Equal(t, "bar", buf.String())
+
+		// The repos can be written to independently
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("fork", "master", ""), "baz", strings.NewReader("baz")))
+		require.NoError(t, env.PachClient.DeleteFile(client.NewCommit("in", "master", ""), "foo"))
+		files, err := env.PachClient.ListFileAll(client.NewCommit("fork", "master", ""), "")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(files))
+		files, err = env.PachClient.ListFileAll(client.NewCommit("in", "master", ""), "")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(files))
+
+		// Only the given branches are forked
+		require.NoError(t, env.PachClient.ForkRepo("in", "fork2", "dev"))
+		repoInfo, err = env.PachClient.InspectRepo("fork2")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(repoInfo.Branches))
+		require.Equal(t, "dev", repoInfo.Branches[0].Name)
+
+		// Renaming the source is reflected in its forks
+		require.NoError(t, env.PachClient.RenameRepo("in", "renamed"))
+		repoInfo, err = env.PachClient.InspectRepo("fork")
+		require.NoError(t, err)
+		require.Equal(t, "renamed", repoInfo.ForkedFrom.Name)
+
+		require.YesError(t, env.PachClient.ForkRepo("renamed", "fork"))
+		require.YesError(t, env.PachClient.ForkRepo("in", "fork3"))
+		require.YesError(t, env.PachClient.ForkRepo("renamed", "fork3", "missing"))
+	})
+
+	suite.Run("Metadata", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		_, err := env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:     client.NewRepo("in"),
+			Metadata: map[string]string{"team": "vision"},
+		})
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.CreateRepo("other"))
+		require.NoError(t, env.PachClient.SetRepoMetadata("other", map[string]string{"team": "nlp"}))
+		repoInfos, err := env.PachClient.ListRepoByMetadata(map[string]string{"team": "vision"})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(repoInfos))
+		require.Equal(t, "in", repoInfos[0].Repo.Name)
+
+		commit1, err := env.PachClient.PfsAPIClient.StartCommit(env.PachClient.Ctx(), &pfs.StartCommitRequest{
+			Branch:   client.NewBranch("in", "master"),
+			Metadata: map[string]string{"stage": "raw"},
+		})
+		require.NoError(t, err)
+		_, err = env.PachClient.PfsAPIClient.FinishCommit(env.PachClient.Ctx(), &pfs.FinishCommitRequest{
+			Commit:   commit1,
+			Metadata: map[string]string{"model": "1"},
+		})
+		require.NoError(t, err)
+		commitInfo, err := env.PachClient.InspectCommit("in", "master", commit1.ID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"stage": "raw", "model": "1"}, commitInfo.Metadata)
+
+		commit2, err := env.PachClient.StartCommit("in", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.FinishCommit("in", "master", commit2.ID))
+		require.NoError(t, env.PachClient.SetCommitMetadata("in", "master", commit2.ID, map[string]string{"model": "2", "stage": "raw"}))
+		commitInfos, err := env.PachClient.ListCommitByMetadata(client.NewRepo("in"), map[string]string{"stage": "raw"})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(commitInfos))
+		commitInfos, err = env.PachClient.ListCommitByMetadata(client.NewRepo("in"), map[string]string{"stage": "raw", "model": "2"})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(commitInfos))
+		require.Equal(t, commit2.ID, commitInfos[0].Commit.ID)
+
+		// Removed keys no longer match
+		require.NoError(t, env.PachClient.SetCommitMetadata("in", "master", commit1.ID, nil, "stage"))
+		commitInfos, err = env.PachClient.ListCommitByMetadata(client.NewRepo("in"), map[string]string{"stage": "raw"})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(commitInfos))
+		commitInfo, err = env.PachClient.InspectCommit("in", "master", commit1.ID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"model": "1"}, commitInfo.Metadata)
+
+		require.NoError(t, env.PachClient.SetBranchMetadata("in", "master", map[string]string{"owner": "alice"}))
+		branchInfo, err := env.PachClient.InspectBranch("in", "master")
+		require.NoError(t, err)
+		require.Equal(t, "alice", branchInfo.Metadata["owner"])
+
+		// Filters follow renamed repos
+		require.NoError(t, env.PachClient.RenameRepo("in", "renamed"))
+		repoInfos, err = env.PachClient.ListRepoByMetadata(map[string]string{"team": "vision"})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(repoInfos))
+		require.Equal(t, "renamed", repoInfos[0].Repo.Name)
+		commitInfos, err = env.PachClient.ListCommitByMetadata(client.NewRepo("renamed"), map[string]string{"model": "2"})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(commitInfos))
+
+		require.YesError(t, env.PachClient.SetRepoMetadata("renamed", map[string]string{"a=b": "c"}))
+		require.YesError(t, env.PachClient.SetRepoMetadata("missing", map[string]string{"a": "b"}))
+	})
+
+	suite.Run("FileAttributes", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("in"))
+		commit := client.NewCommit("in", "master", "")
+		require.NoError(t, env.PachClient.PutFile(commit, "foo", strings.NewReader("foo"),
+			client.WithAttributesPutFile(map[string]string{"content-type": "text/plain", "label": "a"})))
+		fileInfo, err := env.PachClient.InspectFile(commit, "foo")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"content-type": "text/plain", "label": "a"}, fileInfo.Attributes)
+
+		// Appends merge attributes across commits
+		require.NoError(t, env.PachClient.PutFile(commit, "foo", strings.NewReader("foo"),
+			client.WithAppendPutFile(), client.WithAttributesPutFile(map[string]string{"label": "b"})))
+		fileInfo, err = env.PachClient.InspectFile(commit, "foo")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"content-type": "text/plain", "label": "b"}, fileInfo.Attributes)
+
+		// Copies keep the attributes of the source
+		require.NoError(t, env.PachClient.CopyFile(commit, "bar", commit, "foo"))
+		fileInfos, err := env.PachClient.ListFileAll(commit, "")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(fileInfos))
+		for _, fileInfo := range fileInfos {
+			require.Equal(t, "b", fileInfo.Attributes["label"])
+		}
+
+		// Overwrites clear the attributes
+		require.NoError(t, env.PachClient.PutFile(commit, "foo", strings.NewReader("foo")))
+		fileInfo, err = env.PachClient.InspectFile(commit, "foo")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(fileInfo.Attributes))
+	})
+
+	suite.Run("Pagination", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		for _, repo := range []string{"a", "b", "c"} {
+			require.NoError(t, env.PachClient.CreateRepo(repo))
+		}
+		var repos []string
+		var continuation string
+		for {
+			repoInfos, next, err := env.PachClient.ListRepoPage(2, continuation)
+			require.NoError(t, err)
+			require.True(t, len(repoInfos) <= 2)
+			for _, repoInfo := range repoInfos {
+				repos = append(repos, repoInfo.Repo.Name)
+			}
+			if next == "" {
+				break
+			}
+			continuation = next
+		}
+		require.ElementsEqual(t, []string{"a", "b", "c"}, repos)
+
+		commit := client.NewCommit("a", "master", "")
+		paths := []string{"/dir/", "/f0", "/f1", "/f2", "/f3"}
+		require.NoError(t, env.PachClient.PutFile(commit, "dir/x", strings.NewReader("x")))
+		require.NoError(t, env.PachClient.PutFile(commit, "dir/y", strings.NewReader("y")))
+		for i := 0; i < 4; i++ {
+			require.NoError(t, env.PachClient.PutFile(commit, fmt.Sprintf("f%d", i), strings.NewReader("f")))
+		}
+		listPaths := func(page func(string) ([]*pfs.FileInfo, string, error)) []string {
+			var paths []string
+			var continuation string
+			for {
+				fileInfos, next, err := page(continuation)
+				require.NoError(t, err)
+				require.True(t, len(fileInfos) <= 2)
+				for _, fileInfo := range fileInfos {
+					paths = append(paths, fileInfo.File.Path)
+				}
+				if next == "" {
+					return paths
+				}
+				continuation = next
+			}
+		}
+		require.Equal(t, paths, listPaths(func(continuation string) ([]*pfs.FileInfo, string, error) {
+			return env.PachClient.ListFilePage(commit, "", 2, continuation)
+		}))
+		require.Equal(t, []string{"/f0", "/f1", "/f2", "/f3"}, listPaths(func(continuation string) ([]*pfs.FileInfo, string, error) {
+			return env.PachClient.GlobFilePage(commit, "/f*", 2, continuation)
+		}))
+
+		commitInfos, err := env.PachClient.ListCommitByRepo(client.NewRepo("a"))
+		require.NoError(t, err)
+		var commits []string
+		continuation = ""
+		for {
+			page, next, err := env.PachClient.ListCommitPage(client.NewRepo("a"), 2, continuation)
+			require.NoError(t, err)
+			require.True(t, len(page) <= 2)
+			for _, commitInfo := range page {
+				commits = append(commits, commitInfo.Commit.ID)
+			}
+			if next == "" {
+				break
+			}
+			continuation = next
+		}
+		require.Equal(t, len(commitInfos), len(commits))
+		for i, commitInfo := range commitInfos {
+			require.Equal(t, commitInfo.Commit.ID, commits[i])
+		}
+
+		// Listing files with history can't be paginated
+		listClient, err := env.PachClient.PfsAPIClient.ListFile(env.PachClient.Ctx(), &pfs.ListFileRequest{File: commit.NewFile(""), History: -1, PageSize: 2})
+		require.NoError(t, err)
+		_, err = listClient.Recv()
+		require.YesError(t, err)
+		require.Matches(t, "cannot paginate", err.Error())
+	})
+
+	suite.Run("GetFileRange", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("in"))
+		commit := client.NewCommit("in", "master", "")
+		// Append across commits so that the file spans several data refs
+		var data []byte
+		for i := 0; i < 3; i++ {
+			part := []byte(strings.Repeat(strconv.Itoa(i), 2*units.MB))
+			data = append(data, part...)
+			require.NoError(t, env.PachClient.PutFile(commit, "file", bytes.NewReader(part), client.WithAppendPutFile()))
+		}
+		for _, r := range []struct{ offset, size int64 }{
+			{0, 0},
+			{0, 10},
+			{units.MB, 3 * units.MB},
+			{int64(len(data)) - 8, 8},
+			{int64(len(data)) - 8, 100},
+			{int64(len(data)), 0},
+		} {
+			expected := data[r.offset:]
+			if r.size > 0 && r.offset+r.size < int64(len(data)) {
+				expected = data[r.offset : r.offset+r.size]
+			}
+			buf := &bytes.Buffer{}
+			require.NoError(t, env.PachClient.GetFileRange(commit, "file", r.offset, r.size, buf))
+			require.True(t, bytes.Equal(expected, buf.Bytes()))
+		}
+
+		rs, err := env.PachClient.GetFileReadSeeker(commit, "file")
+		require.NoError(t, err)
+		_, err = rs.Seek(-8, io.SeekEnd)
+		require.NoError(t, err)
+		footer, err := ioutil.ReadAll(rs)
+		require.NoError(t, err)
+		require.True(t, bytes.Equal(data[len(data)-8:], footer))
+
+		// A file written under several tags is read in tag order
+		data = nil
+		for _, tag := range []string{"2", "0", "1"} {
+			require.NoError(t, env.PachClient.PutFile(commit, "tagged", strings.NewReader(strings.Repeat(tag, units.MB)), client.WithAppendPutFile(), client.WithTagPutFile(tag)))
+		}
+		for _, tag := range []string{"0", "1", "2"} {
+			data = append(data, []byte(strings.Repeat(tag, units.MB))...)
+		}
+		buf := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.GetFile(commit, "tagged", buf))
+		require.True(t, bytes.Equal(data, buf.Bytes()))
+		for _, r := range []struct{ offset, size int64 }{
+			{0, 0},
+			{units.MB - 4, 8},
+			{units.MB / 2, 2 * units.MB},
+			{2 * units.MB, 0},
+		} {
+			expected := data[r.offset:]
+			if r.size > 0 {
+				expected = data[r.offset : r.offset+r.size]
+			}
+			buf := &bytes.Buffer{}
+			require.NoError(t, env.PachClient.GetFileRange(commit, "tagged", r.offset, r.size, buf))
+			require.True(t, bytes.Equal(expected, buf.Bytes()))
+		}
+
+		require.YesError(t, env.PachClient.GetFileRange(commit, "missing", 0, 0, &bytes.Buffer{}))
+		require.YesError(t, env.PachClient.GetFileRange(commit, "file", -1, 0, &bytes.Buffer{}))
+	})
+
+	suite.Run("DiffFileAcrossBranches", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		masterCommit := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(masterCommit, "/a", strings.NewReader("a\n")))
+		require.NoError(t, env.PachClient.PutFile(masterCommit, "/dir/b", strings.NewReader("b\n")))
+		require.NoError(t, env.PachClient.PutFile(masterCommit, "/dir/c", strings.NewReader("c\n")))
+		require.NoError(t, env.PachClient.CreateBranch(repo, "staging", "master", "", nil))
+		stagingCommit := client.NewCommit(repo, "staging", "")
+		require.NoError(t, env.PachClient.PutFile(stagingCommit, "/a", strings.NewReader("not a\n")))
+		require.NoError(t, env.PachClient.DeleteFile(stagingCommit, "/dir/b"))
+		require.NoError(t, env.PachClient.PutFile(stagingCommit, "/dir/d", strings.NewReader("dddd\n")))
+
+		newFis, oldFis, err := env.PachClient.DiffFileAll(stagingCommit, "/", masterCommit, "/", false)
+		require.NoError(t, err)
+		var newPaths, oldPaths []string
+		for _, fi := range newFis {
+			newPaths = append(newPaths, fi.File.Path)
+		}
+		for _, fi := range oldFis {
+			oldPaths = append(oldPaths, fi.File.Path)
+		}
+		require.ElementsEqual(t, []string{"/", "/a", "/dir/", "/dir/d"}, newPaths)
+		require.ElementsEqual(t, []string{"/", "/a", "/dir/", "/dir/b"}, oldPaths)
+
+		newFis, oldFis, err = env.PachClient.DiffFileAll(stagingCommit, "/", masterCommit, "/", true)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(newFis))
+		require.Equal(t, 3, len(oldFis))
+		require.Equal(t, "/dir/", newFis[2].File.Path)
+
+		summary, err := env.PachClient.DiffFileSummary(stagingCommit, "/", masterCommit, "/")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), summary.Added)
+		require.Equal(t, int64(1), summary.Modified)
+		require.Equal(t, int64(1), summary.Deleted)
+		require.Equal(t, int64(5), summary.AddedBytes)
+		require.Equal(t, int64(2), summary.DeletedBytes)
+		require.Equal(t, int64(4), summary.ModifiedBytesDelta)
+	})
+
+	suite.Run("ExportImportRepo", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		masterCommit := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(masterCommit, "/a", strings.NewReader("a\n")))
+		require.NoError(t, env.PachClient.PutFile(masterCommit, "/b", strings.NewReader("b\n")))
+		require.NoError(t, env.PachClient.DeleteFile(masterCommit, "/a"))
+		require.NoError(t, env.PachClient.PutFile(masterCommit, "/c", strings.NewReader("c\n")))
+
+		buf := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.ExportRepo(repo, nil, buf))
+		// The commit IDs are already used by the exported repo, so the
+		// imported commits get new IDs.
+		resp, err := env.PachClient.ImportRepo("imported", buf)
+		require.NoError(t, err)
+		require.Equal(t, "imported", resp.Repo.Name)
+		require.Equal(t, int64(4), resp.Commits)
+		require.Equal(t, 4, len(resp.CommitIds))
+		// The chunks of the bundle are stored by this cluster already.
+		require.Equal(t, int64(3), resp.Chunks)
+		require.Equal(t, resp.Chunks, resp.DeduplicatedChunks)
+
+		cis, err := env.PachClient.ListCommit(client.NewRepo("imported"), nil, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, 4, len(cis))
+		fis, err := env.PachClient.ListFileAll(client.NewCommit("imported", "master", ""), "/")
+		require.NoError(t, err)
+		var paths []string
+		for _, fi := range fis {
+			paths = append(paths, fi.File.Path)
+		}
+		require.ElementsEqual(t, []string{"/b", "/c"}, paths)
+		out := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.GetFile(client.NewCommit("imported", "master", ""), "/c", out))
+		require.Equal(t, "c\n", out.String())
+
+		// Importing a full bundle into an existing repo fails.
+		buf.Reset()
+		require.NoError(t, env.PachClient.ExportRepo(repo, nil, buf))
+		_, err = env.PachClient.ImportRepo("imported", buf)
+		require.YesError(t, err)
+	})
+
+	suite.Run("ExportImportRepoIncremental", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		masterCommit := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(masterCommit, "/a", strings.NewReader("a\n")))
+		baseInfo, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		buf := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.ExportRepo(repo, nil, buf))
+		resp, err := env.PachClient.ImportRepo("imported", buf)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.CommitIds))
+
+		// The incremental bundle is imported relative to the new ID of its
+		// base commit, and only has the chunks added since the base commit.
+		require.NoError(t, env.PachClient.PutFile(masterCommit, "/b", strings.NewReader("b\n")))
+		headInfo, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		buf.Reset()
+		require.NoError(t, env.PachClient.ExportRepo(repo, baseInfo.Commit, buf))
+		bundle := buf.Bytes()
+		resp, err = env.PachClient.ImportRepo("imported", bytes.NewReader(bundle))
+		require.NoError(t, err)
+		require.Equal(t, int64(1), resp.Commits)
+		require.Equal(t, int64(1), resp.Chunks)
+		require.Equal(t, resp.Chunks, resp.DeduplicatedChunks)
+		require.Equal(t, 1, len(resp.CommitIds))
+
+		cis, err := env.PachClient.ListCommit(client.NewRepo("imported"), nil, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(cis))
+		importedInfo, err := env.PachClient.InspectCommit("imported", "master", "")
+		require.NoError(t, err)
+		require.Equal(t, resp.CommitIds[headInfo.Commit.ID], importedInfo.Commit.ID)
+		require.Equal(t, headInfo.Commit.ID, importedInfo.Origin.Imported.ID)
+		parentInfo, err := env.PachClient.InspectCommit("imported", importedInfo.ParentCommit.Branch.Name, importedInfo.ParentCommit.ID)
+		require.NoError(t, err)
+		require.Equal(t, baseInfo.Commit.ID, parentInfo.Origin.Imported.ID)
+		fis, err := env.PachClient.ListFileAll(importedInfo.Commit, "/")
+		require.NoError(t, err)
+		var paths []string
+		for _, fi := range fis {
+			paths = append(paths, fi.File.Path)
+		}
+		require.ElementsEqual(t, []string{"/a", "/b"}, paths)
+
+		// Importing the bundle again skips the commits it already imported.
+		resp, err = env.PachClient.ImportRepo("imported", bytes.NewReader(bundle))
+		require.NoError(t, err)
+		require.Equal(t, int64(0), resp.Commits)
+		cis, err = env.PachClient.ListCommit(client.NewRepo("imported"), nil, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(cis))
+
+		// Moving the head of a protected branch is forbidden, and moving the
+		// head of a branch is propagated to its subvenance.
+		require.NoError(t, env.PachClient.PutFile(masterCommit, "/c", strings.NewReader("c\n")))
+		buf.Reset()
+		require.NoError(t, env.PachClient.ExportRepo(repo, headInfo.Commit, buf))
+		bundle = buf.Bytes()
+		importedHeadID := importedInfo.Commit.ID
+		require.NoError(t, env.PachClient.ProtectBranch("imported", "master", &pfs.BranchProtection{StagingBranch: "staging"}))
+		_, err = env.PachClient.ImportRepo("imported", bytes.NewReader(bundle))
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchProtectedErr(err), err.Error())
+		importedInfo, err = env.PachClient.InspectCommit("imported", "master", "")
+		require.NoError(t, err)
+		require.Equal(t, importedHeadID, importedInfo.Commit.ID)
+		require.NoError(t, env.PachClient.ProtectBranch("imported", "master", &pfs.BranchProtection{}))
+		require.NoError(t, env.PachClient.CreateRepo("out"))
+		require.NoError(t, env.PachClient.CreateBranch("out", "master", "", "", []*pfs.Branch{client.NewBranch("imported", "master")}))
+		_, err = env.PachClient.ImportRepo("imported", bytes.NewReader(bundle))
+		require.NoError(t, err)
+		importedInfo, err = env.PachClient.InspectCommit("imported", "master", "")
+		require.NoError(t, err)
+		outInfo, err := env.PachClient.InspectCommit("out", "master", "")
+		require.NoError(t, err)
+		require.Equal(t, importedInfo.Commit.ID, outInfo.Commit.ID)
+		fis, err = env.PachClient.ListFileAll(importedInfo.Commit, "/")
+		require.NoError(t, err)
+		require.Equal(t, 3, len(fis))
+
+		// An incremental bundle can't be imported without its base commit.
+		require.NoError(t, env.PachClient.CreateRepo("other"))
+		_, err = env.PachClient.ImportRepo("other", bytes.NewReader(bundle))
+		require.YesError(t, err)
+		require.Matches(t, "does not have the base commit", err.Error())
+	})
+
+	suite.Run("CommitState", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		// two input repos, one with many commits (logs), and one with few (schema)
+		require.NoError(t, env.PachClient.CreateRepo("A"))
+		require.NoError(t, env.PachClient.CreateRepo("B"))
+
+		require.NoError(t, env.PachClient.CreateBranch("B", "master", "", "", []*pfs.Branch{client.NewBranch("A", "master")}))
+
+		// Start a commit on A/master, this will create a non-ready commit on B/master.
+		_, err := env.PachClient.StartCommit("A", "master")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		_, err = env.PachClient.PfsAPIClient.InspectCommit(ctx, &pfs.InspectCommitRequest{
+			Commit: client.NewCommit("B", "master", ""),
+			Wait:   pfs.CommitState_READY,
+		})
+		require.YesError(t, err)
+
+		// Finish the commit on A/master, that will make the B/master ready.
+		require.NoError(t, env.PachClient.FinishCommit("A", "master", ""))
+
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		_, err = env.PachClient.PfsAPIClient.InspectCommit(ctx, &pfs.InspectCommitRequest{
+			Commit: client.NewCommit("B", "master", ""),
+			Wait:   pfs.CommitState_READY,
+		})
+		require.NoError(t, err)
+
+		// Create a new branch C/master with A/master as provenance. It should start out ready.
+		require.NoError(t, env.PachClient.CreateRepo("C"))
+		require.NoError(t, env.PachClient.CreateBranch("C", "master", "", "", []*pfs.Branch{client.NewBranch("A", "master")}))
+
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		_, err = env.PachClient.PfsAPIClient.InspectCommit(ctx, &pfs.InspectCommitRequest{
+			Commit: client.NewCommit("C", "master", ""),
+			Wait:   pfs.CommitState_READY,
+		})
+		require.NoError(t, err)
+	})
+
+	suite.Run("SubscribeStates", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("A"))
+		require.NoError(t, env.PachClient.CreateRepo("B"))
+		require.NoError(t, env.PachClient.CreateRepo("C"))
+
+		require.NoError(t, env.PachClient.CreateBranch("B", "master", "", "", []*pfs.Branch{client.NewBranch("A", "master")}))
+		require.NoError(t, env.PachClient.FinishCommit("B", "master", ""))
+		require.NoError(t, env.PachClient.CreateBranch("C", "master", "", "", []*pfs.Branch{client.NewBranch("B", "master")}))
+		require.NoError(t, env.PachClient.FinishCommit("C", "master", ""))
+
+		ctx, cancel := context.WithCancel(env.PachClient.Ctx())
+		defer cancel()
+		pachClient := env.PachClient.WithCtx(ctx)
+
+		var readyCommits int64
+		go func() {
+			pachClient.SubscribeCommit(client.NewRepo("B"), "master", "", pfs.CommitState_READY, func(ci *pfs.CommitInfo) error {
+				atomic.AddInt64(&readyCommits, 1)
+				return nil
 			})
 		}()
 		go func() {
@@ -4491,48 +5251,92 @@ func TestPFS(suite *testing.T) {
 		require.Equal(t, "bar\n", buf.String())
 	})
 
-	suite.Run("FileHistory", func(t *testing.T) {
-		// TODO: There is no notion of file history in V2. We could potentially implement this, but
-		// we would need to spend some time thinking about the performance characteristics.
-		t.Skip("File history is not implemented in V2")
-		//	t.Parallel()
-		//  env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
-		//
-		//	var err error
-		//
-		//	repo := "test"
-		//	require.NoError(t, env.PachClient.CreateRepo(repo))
-		//	numCommits := 10
-		//	for i := 0; i < numCommits; i++ {
-		//		_, err = env.PachClient.PutFile(repo, "master", "file", strings.NewReader("foo\n"))
-		//		require.NoError(t, err)
-		//	}
-		//	fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", -1)
-		//	require.NoError(t, err)
-		//	require.Equal(t, numCommits, len(fileInfos))
-		//
-		//	for i := 1; i < numCommits; i++ {
-		//		fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", int64(i))
-		//		require.NoError(t, err)
-		//		require.Equal(t, i, len(fileInfos))
-		//	}
-		//
-		//	require.NoError(t, env.PachClient.DeleteFile(repo, "master", "file"))
-		//	for i := 0; i < numCommits; i++ {
-		//		_, err = env.PachClient.PutFile(repo, "master", "file", strings.NewReader("foo\n"))
-		//		require.NoError(t, err)
-		//		_, err = env.PachClient.PutFile(repo, "master", "unrelated", strings.NewReader("foo\n"))
-		//		require.NoError(t, err)
-		//	}
-		//	fileInfos, err = env.PachClient.ListFileHistory(repo, "master", "file", -1)
-		//	require.NoError(t, err)
-		//	require.Equal(t, numCommits, len(fileInfos))
-		//
-		//	for i := 1; i < numCommits; i++ {
-		//		fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", int64(i))
-		//		require.NoError(t, err)
-		//		require.Equal(t, i, len(fileInfos))
-		//	}
+	suite.Run("FileHistory", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit := client.NewCommit(repo, "master", "")
+		listFileHistory := func(history int64) []*pfs.FileInfo {
+			var fileInfos []*pfs.FileInfo
+			require.NoError(t, env.PachClient.ListFileHistory(commit, "file", history, func(fi *pfs.FileInfo) error {
+				fileInfos = append(fileInfos, fi)
+				return nil
+			}))
+			return fileInfos
+		}
+		numCommits := 10
+		for i := 0; i < numCommits; i++ {
+			require.NoError(t, env.PachClient.PutFile(commit, "file", strings.NewReader(fmt.Sprintf("foo%d\n", i))))
+		}
+		fileInfos := listFileHistory(-1)
+		require.Equal(t, numCommits, len(fileInfos))
+		for i := 1; i < numCommits; i++ {
+			fileInfos := listFileHistory(int64(i))
+			require.Equal(t, i, len(fileInfos))
+		}
+
+		require.NoError(t, env.PachClient.DeleteFile(commit, "file"))
+		for i := 0; i < numCommits; i++ {
+			require.NoError(t, env.PachClient.PutFile(commit, "file", strings.NewReader(fmt.Sprintf("foo%d\n", i))))
+			require.NoError(t, env.PachClient.PutFile(commit, "unrelated", strings.NewReader(fmt.Sprintf("foo%d\n", i))))
+		}
+		fileInfos = listFileHistory(-1)
+		require.Equal(t, numCommits, len(fileInfos))
+		for i := 1; i < numCommits; i++ {
+			fileInfos := listFileHistory(int64(i))
+			require.Equal(t, i, len(fileInfos))
+		}
+		// Each version should point at the commit that wrote it, newest first.
+		for i, fi := range fileInfos {
+			var buf bytes.Buffer
+			require.NoError(t, env.PachClient.GetFile(fi.File.Commit, "file", &buf))
+			require.Equal(t, fmt.Sprintf("foo%d\n", numCommits-i-1), buf.String())
+		}
+	})
+
+	suite.Run("InspectFileHistory", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(commit, "dir/file", strings.NewReader("foo")))
+		first, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit, "dir/file", strings.NewReader("bar")))
+		second, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit, "other", strings.NewReader("baz")))
+		head, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+
+		fi, err := env.PachClient.InspectFileHistory(commit, "dir/file", 0)
+		require.NoError(t, err)
+		require.Equal(t, head.Commit.ID, fi.File.Commit.ID)
+		require.Equal(t, int64(3), fi.SizeBytes)
+
+		fi, err = env.PachClient.InspectFileHistory(commit, "dir/file", 1)
+		require.NoError(t, err)
+		require.Equal(t, second.Commit.ID, fi.File.Commit.ID)
+
+		fi, err = env.PachClient.InspectFileHistory(commit, "dir/file", 2)
+		require.NoError(t, err)
+		require.Equal(t, first.Commit.ID, fi.File.Commit.ID)
+
+		fi, err = env.PachClient.InspectFileHistory(commit, "dir/file", -1)
+		require.NoError(t, err)
+		require.Equal(t, first.Commit.ID, fi.File.Commit.ID)
+
+		// Directories change whenever a file under them does.
+		fi, err = env.PachClient.InspectFileHistory(commit, "dir", 1)
+		require.NoError(t, err)
+		require.Equal(t, second.Commit.ID, fi.File.Commit.ID)
+
+		_, err = env.PachClient.InspectFileHistory(commit, "nonexistent", 1)
+		require.YesError(t, err)
 	})
 
 	suite.Run("UpdateRepo", func(t *testing.T) {
@@ -4755,6 +5559,255 @@ func TestPFS(suite *testing.T) {
 		require.NoError(t, env.PachClient.DeleteRepo(output1, false))
 	})
 
+	suite.Run("FsckFileSets", func(subsuite *testing.T) {
+		subsuite.Parallel()
+		// setup creates a repo with two commits on master, reads the head so
+		// that its total fileset is computed, and returns the head.
+		setup := func(t *testing.T, env *testpachd.RealEnv) *pfs.Commit {
+			repo := "test"
+			require.NoError(t, env.PachClient.CreateRepo(repo))
+			master := client.NewCommit(repo, "master", "")
+			require.NoError(t, env.PachClient.PutFile(master, "file1", strings.NewReader("foo\n")))
+			require.NoError(t, env.PachClient.PutFile(master, "file2", strings.NewReader("bar\n")))
+			commitInfo, err := env.PachClient.InspectCommit(repo, "master", "")
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, env.PachClient.GetFile(commitInfo.Commit, "file1", &buf))
+			return commitInfo.Commit
+		}
+		fsck := func(t *testing.T, env *testpachd.RealEnv, fix bool) (errs, fixes []string) {
+			require.NoError(t, env.PachClient.Fsck(fix, func(resp *pfs.FsckResponse) error {
+				if resp.Error != "" {
+					errs = append(errs, resp.Error)
+				} else {
+					fixes = append(fixes, resp.Fix)
+				}
+				return nil
+			}))
+			return errs, fixes
+		}
+		requireContains := func(t *testing.T, msgs []string, substr string) {
+			for _, msg := range msgs {
+				if strings.Contains(msg, substr) {
+					return
+				}
+			}
+			t.Fatalf("no message in %v contains %q", msgs, substr)
+		}
+		// deleteFileSets deletes the metadata of the filesets of commit
+		// selected by query, and returns their IDs.
+		deleteFileSets := func(t *testing.T, env *testpachd.RealEnv, commit *pfs.Commit, query string) []string {
+			db := env.ServiceEnv.GetDBClient()
+			var ids []string
+			require.NoError(t, db.Select(&ids, query, pfsdb.CommitKey(commit)))
+			require.True(t, len(ids) > 0)
+			for _, id := range ids {
+				_, err := db.Exec(`DELETE FROM storage.filesets WHERE id = $1`, id)
+				require.NoError(t, err)
+			}
+			return ids
+		}
+		const (
+			selectTotal = `SELECT fileset_id FROM pfs.commit_totals WHERE commit_id = $1`
+			selectDiffs = `SELECT fileset_id FROM pfs.commit_diffs WHERE commit_id = $1`
+		)
+
+		subsuite.Run("MissingHead", func(t *testing.T) {
+			t.Parallel()
+			env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+			head := setup(t, env)
+			branches := pfsdb.Branches(env.ServiceEnv.GetDBClient(), env.ServiceEnv.GetPostgresListener())
+			require.NoError(t, col.NewSQLTx(env.Context, env.ServiceEnv.GetDBClient(), func(tx *sqlx.Tx) error {
+				branchInfo := &pfs.BranchInfo{}
+				return branches.ReadWrite(tx).Update(pfsdb.BranchKey(head.Branch), branchInfo, func() error {
+					branchInfo.Head = nil
+					return nil
+				})
+			}))
+			require.YesError(t, env.PachClient.FsckFastExit())
+			errs, fixes := fsck(t, env, true)
+			requireContains(t, errs, "does not have a head commit")
+			requireContains(t, fixes, fmt.Sprintf("moved the head of branch %s to commit %s", head.Branch, head.ID))
+			require.NoError(t, env.PachClient.FsckFastExit())
+			branchInfo, err := env.PachClient.InspectBranch(head.Branch.Repo.Name, head.Branch.Name)
+			require.NoError(t, err)
+			require.Equal(t, head.ID, branchInfo.Head.ID)
+		})
+
+		subsuite.Run("BrokenTotal", func(t *testing.T) {
+			t.Parallel()
+			env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+			head := setup(t, env)
+			deleteFileSets(t, env, head, selectTotal)
+			require.YesError(t, env.PachClient.FsckFastExit())
+			errs, _ := fsck(t, env, false)
+			requireContains(t, errs, "total fileset")
+			errs, fixes := fsck(t, env, true)
+			for _, err := range errs {
+				require.False(t, strings.Contains(err, "cannot be recovered"), err)
+			}
+			requireContains(t, fixes, fmt.Sprintf("recomputed the total fileset of commit %s", head))
+			require.NoError(t, env.PachClient.FsckFastExit())
+			var buf bytes.Buffer
+			require.NoError(t, env.PachClient.GetFile(head, "file2", &buf))
+			require.Equal(t, "bar\n", buf.String())
+		})
+
+		subsuite.Run("Unrecoverable", func(t *testing.T) {
+			t.Parallel()
+			env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+			head := setup(t, env)
+			deleteFileSets(t, env, head, selectTotal)
+			deleteFileSets(t, env, head, selectDiffs)
+			// A child without any filesets of its own is computed from head.
+			child, err := env.PachClient.StartCommit(head.Branch.Repo.Name, head.Branch.Name)
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.FinishCommit(child.Branch.Repo.Name, child.Branch.Name, child.ID))
+			errs, fixes := fsck(t, env, true)
+			requireContains(t, errs, fmt.Sprintf("the content of commit %s cannot be recovered", head))
+			requireContains(t, errs, fmt.Sprintf("the content of commit %s cannot be recovered", child))
+			for _, fix := range fixes {
+				require.False(t, strings.Contains(fix, head.ID), fix)
+				require.False(t, strings.Contains(fix, child.ID), fix)
+			}
+		})
+
+		subsuite.Run("MissingTotal", func(t *testing.T) {
+			t.Parallel()
+			env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+			head := setup(t, env)
+			child, err := env.PachClient.StartCommit(head.Branch.Repo.Name, head.Branch.Name)
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.FinishCommit(child.Branch.Repo.Name, child.Branch.Name, child.ID))
+			// The empty child has no filesets until its total is computed.
+			errs, fixes := fsck(t, env, true)
+			require.Equal(t, 0, len(errs), errs)
+			requireContains(t, fixes, fmt.Sprintf("computed the missing total fileset of commit %s", child))
+			db := env.ServiceEnv.GetDBClient()
+			var ids []string
+			require.NoError(t, db.Select(&ids, selectTotal, pfsdb.CommitKey(child)))
+			require.Equal(t, 1, len(ids))
+		})
+	})
+
+	suite.Run("Quota", func(subsuite *testing.T) {
+		subsuite.Parallel()
+
+		subsuite.Run("Size", func(t *testing.T) {
+			t.Parallel()
+			env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+			repo := "test"
+			require.NoError(t, env.PachClient.CreateRepo(repo))
+			require.NoError(t, env.PachClient.CreateQuota(repo, "", 1000, 0, false))
+			master := client.NewCommit(repo, "master", "")
+			data := strings.Repeat("a", 600)
+			require.NoError(t, env.PachClient.PutFile(master, "file1", strings.NewReader(data)))
+			err := env.PachClient.PutFile(master, "file2", strings.NewReader(data))
+			require.YesError(t, err)
+			require.True(t, pfsserver.IsQuotaExceededErr(err), err.Error())
+			// Overwritten data counts against the quota.
+			err = env.PachClient.PutFile(master, "file1", strings.NewReader(data))
+			require.YesError(t, err)
+			require.True(t, pfsserver.IsQuotaExceededErr(err), err.Error())
+			info, err := env.PachClient.InspectQuota(repo, "")
+			require.NoError(t, err)
+			require.Equal(t, int64(600), info.SizeBytesUpperBound)
+			require.Equal(t, int64(1), info.FileCount)
+			// Raising the limit allows the write.
+			require.NoError(t, env.PachClient.CreateQuota(repo, "", 2000, 0, true))
+			require.NoError(t, env.PachClient.PutFile(master, "file2", strings.NewReader(data)))
+		})
+
+		subsuite.Run("FileCount", func(t *testing.T) {
+			t.Parallel()
+			env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+			repo := "test"
+			require.NoError(t, env.PachClient.CreateRepo(repo))
+			require.NoError(t, env.PachClient.CreateQuota(repo, "", 0, 2, false))
+			master := client.NewCommit(repo, "master", "")
+			require.NoError(t, env.PachClient.PutFile(master, "file1", strings.NewReader("1")))
+			require.NoError(t, env.PachClient.PutFile(master, "file2", strings.NewReader("2")))
+			// Overwriting a file doesn't change the file count.
+			require.NoError(t, env.PachClient.PutFile(master, "file1", strings.NewReader("3")))
+			err := env.PachClient.PutFile(master, "file3", strings.NewReader("4"))
+			require.YesError(t, err)
+			require.True(t, pfsserver.IsQuotaExceededErr(err), err.Error())
+			// Deleting a file makes room for another.
+			require.NoError(t, env.PachClient.DeleteFile(master, "file2"))
+			require.NoError(t, env.PachClient.PutFile(master, "file3", strings.NewReader("4")))
+			repoInfo, err := env.PachClient.InspectRepo(repo)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(repoInfo.Details.Quotas))
+			require.Equal(t, int64(2), repoInfo.Details.Quotas[0].FileCount)
+		})
+
+		subsuite.Run("FinishCommit", func(t *testing.T) {
+			t.Parallel()
+			env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+			repo := "test"
+			require.NoError(t, env.PachClient.CreateRepo(repo))
+			master := client.NewCommit(repo, "master", "")
+			require.NoError(t, env.PachClient.PutFile(master, "file1", strings.NewReader("1")))
+			require.NoError(t, env.PachClient.CreateQuota(repo, "", 0, 2, false))
+			infos, err := env.PachClient.ListQuota(repo)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(infos))
+			require.Equal(t, int64(1), infos[0].FileCount)
+			// Filesets added to a commit are checked when it's finished.
+			commit, err := env.PachClient.StartCommit(repo, "master")
+			require.NoError(t, err)
+			resp, err := env.PachClient.WithCreateFileSetClient(func(mf client.ModifyFile) error {
+				require.NoError(t, mf.PutFile("file2", strings.NewReader("2")))
+				require.NoError(t, mf.PutFile("file3", strings.NewReader("3")))
+				return nil
+			})
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.AddFileSet(repo, commit.Branch.Name, commit.ID, resp.FileSetId))
+			err = env.PachClient.FinishCommit(repo, commit.Branch.Name, commit.ID)
+			require.YesError(t, err)
+			require.True(t, pfsserver.IsQuotaExceededErr(err), err.Error())
+			require.True(t, strings.Contains(err.Error(), "the commit would contain 3 files, the limit is 2 files"), err.Error())
+			// Finishing the commit with an error doesn't check quotas.
+			_, err = env.PachClient.PfsAPIClient.FinishCommit(env.PachClient.Ctx(), &pfs.FinishCommitRequest{Commit: commit, Error: true})
+			require.NoError(t, err)
+		})
+
+		subsuite.Run("Transaction", func(t *testing.T) {
+			t.Parallel()
+			env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+			repo := "test"
+			require.NoError(t, env.PachClient.CreateRepo(repo))
+			require.NoError(t, env.PachClient.PutFile(client.NewCommit(repo, "master", ""), "file1", strings.NewReader("1")))
+			require.NoError(t, env.PachClient.CreateQuota(repo, "", 0, 2, false))
+			// Quotas limit the commits of every branch, not only master.
+			commit, err := env.PachClient.StartCommit(repo, "dev")
+			require.NoError(t, err)
+			resp, err := env.PachClient.WithCreateFileSetClient(func(mf client.ModifyFile) error {
+				for _, p := range []string{"file1", "file2", "file3"} {
+					require.NoError(t, mf.PutFile(p, strings.NewReader(p)))
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.AddFileSet(repo, commit.Branch.Name, commit.ID, resp.FileSetId))
+			// Commits finished in transactions are checked too.
+			_, err = env.PachClient.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
+				return builder.FinishCommit(repo, commit.Branch.Name, commit.ID)
+			})
+			require.YesError(t, err)
+			require.True(t, pfsserver.IsQuotaExceededErr(err), err.Error())
+			// The usage is reported for master.
+			info, err := env.PachClient.InspectQuota(repo, "")
+			require.NoError(t, err)
+			require.Equal(t, int64(1), info.FileCount)
+			require.NoError(t, env.PachClient.CreateQuota(repo, "", 0, 3, true))
+			_, err = env.PachClient.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
+				return builder.FinishCommit(repo, commit.Branch.Name, commit.ID)
+			})
+			require.NoError(t, err)
+		})
+	})
+
 	suite.Run("PutFileAtomic", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
@@ -5035,14 +6088,27 @@ func TestPFS(suite *testing.T) {
 	})
 
 	// TestTrigger tests branch triggers
-	// TODO(2.0 required): This test does not work with V2. It is not clear what the issue is yet. Something noteworthy is that the output
-	// size does not increase for the second to last commit, which may have something to do with compaction (the trigger won't kick
-	// off since it is based on the output size). The output size calculation is a bit questionable due to the compaction delay (a
-	// file may be accounted for in the size even if it is deleted).
 	suite.Run("Trigger", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
 		c := env.PachClient
+		// Size triggers are evaluated in the background once the commits are
+		// compacted, waitForTrigger waits for branch to move off of head.
+		waitForTrigger := func(t *testing.T, repo, branch, head string) string {
+			var newHead string
+			require.NoErrorWithinTRetry(t, time.Minute, func() error {
+				bi, err := c.InspectBranch(repo, branch)
+				if err != nil {
+					return err
+				}
+				if bi.Head.ID == head {
+					return errors.Errorf("%s@%s has not been triggered", repo, branch)
+				}
+				newHead = bi.Head.ID
+				return nil
+			})
+			return newHead
+		}
 
 		t.Run("Simple", func(t *testing.T) {
 			require.NoError(t, c.CreateRepo("test"))
@@ -5054,8 +6120,6 @@ func TestPFS(suite *testing.T) {
 		})
 
 		t.Run("SizeWithProvenance", func(t *testing.T) {
-			// TODO(2.0 required): fix size-based triggering (likely broken due to async compaction)
-			t.Skip("Triggering based on size does not work with V2, needs investigation")
 			require.NoError(t, c.CreateRepo("in"))
 			require.NoError(t, c.CreateBranchTrigger("in", "trigger", "", "", &pfs.Trigger{
 				Branch: "master",
@@ -5065,7 +6129,7 @@ func TestPFS(suite *testing.T) {
 			bis, err := c.ListBranch("in")
 			require.NoError(t, err)
 			require.Equal(t, 1, len(bis))
-			require.Nil(t, bis[0].Head)
+			inHead := bis[0].Head
 
 			// Create a downstream branch
 			require.NoError(t, c.CreateRepo("out"))
@@ -5074,38 +6138,39 @@ func TestPFS(suite *testing.T) {
 				Branch: "master",
 				Size_:  "1K",
 			}))
+			bi, err := c.InspectBranch("out", "master")
+			require.NoError(t, err)
+			outHead := bi.Head
+			bi, err = c.InspectBranch("out", "trigger")
+			require.NoError(t, err)
+			outTriggerHead := bi.Head
 
 			// Write a small file, too small to trigger
 			require.NoError(t, c.PutFile(inCommit, "file", strings.NewReader("small")))
-			bi, err := c.InspectBranch("in", "trigger")
+			bi, err = c.InspectBranch("in", "trigger")
 			require.NoError(t, err)
-			require.Nil(t, bi.Head)
+			require.Equal(t, inHead, bi.Head)
 			bi, err = c.InspectBranch("out", "master")
 			require.NoError(t, err)
-			require.Nil(t, bi.Head)
+			require.Equal(t, outHead, bi.Head)
 			bi, err = c.InspectBranch("out", "trigger")
 			require.NoError(t, err)
-			require.Nil(t, bi.Head)
+			require.Equal(t, outTriggerHead, bi.Head)
 
 			require.NoError(t, c.PutFile(inCommit, "file", strings.NewReader(strings.Repeat("a", units.KB))))
-
-			bi, err = c.InspectBranch("in", "trigger")
-			require.NoError(t, err)
-			require.NotNil(t, bi.Head)
+			waitForTrigger(t, "in", "trigger", inHead.ID)
 
 			// Output branch should have a commit now
 			bi, err = c.InspectBranch("out", "master")
 			require.NoError(t, err)
-			require.NotNil(t, bi.Head)
+			require.NotEqual(t, outHead, bi.Head)
 
 			// Put a file that will cause the trigger to go off
 			require.NoError(t, c.PutFile(client.NewCommit("out", "master", ""), "file", strings.NewReader(strings.Repeat("a", units.KB))))
 			require.NoError(t, env.PachClient.FinishCommit("out", "master", ""))
 
 			// Output trigger should have triggered
-			bi, err = c.InspectBranch("out", "trigger")
-			require.NoError(t, err)
-			require.NotNil(t, bi.Head)
+			waitForTrigger(t, "out", "trigger", outTriggerHead.ID)
 		})
 
 		t.Run("Cron", func(t *testing.T) {
@@ -5187,8 +6252,6 @@ func TestPFS(suite *testing.T) {
 		})
 
 		t.Run("Or", func(t *testing.T) {
-			// TODO(2.0 required): fix size-based triggering (likely broken due to async compaction)
-			t.Skip("Triggering based on size does not work with V2, needs investigation")
 			require.NoError(t, c.CreateRepo("or"))
 			require.NoError(t, c.CreateBranchTrigger("or", "trigger", "", "", &pfs.Trigger{
 				Branch:   "master",
@@ -5210,10 +6273,7 @@ func TestPFS(suite *testing.T) {
 			require.Equal(t, head, bi.Head.ID)
 			// This one triggers because we hit 100 bytes
 			require.NoError(t, c.PutFile(orCommit, "file3", strings.NewReader(strings.Repeat("a", 50))))
-			bi, err = c.InspectBranch("or", "trigger")
-			require.NoError(t, err)
-			require.NotEqual(t, head, bi.Head.ID)
-			head = bi.Head.ID
+			head = waitForTrigger(t, "or", "trigger", head)
 
 			// This one doesn't trigger
 			require.NoError(t, c.PutFile(orCommit, "file4", strings.NewReader(strings.Repeat("a", 1))))
@@ -5247,8 +6307,6 @@ func TestPFS(suite *testing.T) {
 		})
 
 		t.Run("And", func(t *testing.T) {
-			// TODO(2.0 required): fix size-based triggering (likely broken due to async compaction)
-			t.Skip("Triggering based on size does not work with V2, needs investigation")
 			require.NoError(t, c.CreateRepo("and"))
 			require.NoError(t, c.CreateBranchTrigger("and", "trigger", "", "", &pfs.Trigger{
 				Branch:   "master",
@@ -5257,26 +6315,27 @@ func TestPFS(suite *testing.T) {
 				Size_:    "100",
 				Commits:  3,
 			}))
+			bi, err := c.InspectBranch("and", "trigger")
+			require.NoError(t, err)
+			head := bi.Head.ID
+
 			andCommit := client.NewCommit("and", "master", "")
 			// Doesn't trigger because all 3 conditions must be met
 			require.NoError(t, c.PutFile(andCommit, "file1", strings.NewReader(strings.Repeat("a", 100))))
-			bi, err := c.InspectBranch("and", "trigger")
+			bi, err = c.InspectBranch("and", "trigger")
 			require.NoError(t, err)
-			require.Nil(t, bi.Head)
+			require.Equal(t, head, bi.Head.ID)
 
 			// Still doesn't trigger
 			require.NoError(t, c.PutFile(andCommit, "file2", strings.NewReader(strings.Repeat("a", 100))))
 			bi, err = c.InspectBranch("and", "trigger")
 			require.NoError(t, err)
-			require.Nil(t, bi.Head)
+			require.Equal(t, head, bi.Head.ID)
 
 			// Finally triggers because we have 3 commits, 100 bytes and Cron
 			// Spec (since epoch) is satisfied.
 			require.NoError(t, c.PutFile(andCommit, "file3", strings.NewReader(strings.Repeat("a", 100))))
-			bi, err = c.InspectBranch("and", "trigger")
-			require.NoError(t, err)
-			require.NotNil(t, bi.Head)
-			head := bi.Head.ID
+			head = waitForTrigger(t, "and", "trigger", head)
 
 			// Doesn't trigger because all 3 conditions must be met
 			require.NoError(t, c.PutFile(andCommit, "file4", strings.NewReader(strings.Repeat("a", 100))))
@@ -5300,14 +6359,10 @@ func TestPFS(suite *testing.T) {
 
 			// Finally triggers, all triggers have been met
 			require.NoError(t, c.PutFile(andCommit, "file7", strings.NewReader(strings.Repeat("a", 100))))
-			bi, err = c.InspectBranch("and", "trigger")
-			require.NoError(t, err)
-			require.NotEqual(t, head, bi.Head.ID)
+			waitForTrigger(t, "and", "trigger", head)
 		})
 
 		t.Run("Chain", func(t *testing.T) {
-			// TODO(2.0 required): fix size-based triggering (likely broken due to async compaction)
-			t.Skip("Triggering based on size does not work with V2, needs investigation")
 			// a triggers b which triggers c
 			require.NoError(t, c.CreateRepo("chain"))
 			require.NoError(t, c.CreateBranchTrigger("chain", "b", "", "", &pfs.Trigger{
@@ -5318,25 +6373,29 @@ func TestPFS(suite *testing.T) {
 				Branch: "b",
 				Size_:  "200",
 			}))
+			bi, err := c.InspectBranch("chain", "b")
+			require.NoError(t, err)
+			bHead := bi.Head.ID
+			bi, err = c.InspectBranch("chain", "c")
+			require.NoError(t, err)
+			cHead := bi.Head.ID
+
 			aCommit := client.NewCommit("chain", "a", "")
 			// Triggers nothing
 			require.NoError(t, c.PutFile(aCommit, "file1", strings.NewReader(strings.Repeat("a", 50))))
-			bi, err := c.InspectBranch("chain", "b")
+			bi, err = c.InspectBranch("chain", "b")
 			require.NoError(t, err)
-			require.Nil(t, bi.Head)
+			require.Equal(t, bHead, bi.Head.ID)
 			bi, err = c.InspectBranch("chain", "c")
 			require.NoError(t, err)
-			require.Nil(t, bi.Head)
+			require.Equal(t, cHead, bi.Head.ID)
 
 			// Triggers b, but not c
 			require.NoError(t, c.PutFile(aCommit, "file2", strings.NewReader(strings.Repeat("a", 50))))
-			bi, err = c.InspectBranch("chain", "b")
-			require.NoError(t, err)
-			require.NotNil(t, bi.Head)
-			bHead := bi.Head.ID
+			bHead = waitForTrigger(t, "chain", "b", bHead)
 			bi, err = c.InspectBranch("chain", "c")
 			require.NoError(t, err)
-			require.Nil(t, bi.Head)
+			require.Equal(t, cHead, bi.Head.ID)
 
 			// Triggers nothing
 			require.NoError(t, c.PutFile(aCommit, "file3", strings.NewReader(strings.Repeat("a", 50))))
@@ -5346,19 +6405,12 @@ func TestPFS(suite *testing.T) {
 			require.Equal(t, bHead, bi.Head.ID)
 			bi, err = c.InspectBranch("chain", "c")
 			require.NoError(t, err)
-			require.Nil(t, bi.Head)
+			require.Equal(t, cHead, bi.Head.ID)
 
 			// Triggers a and c
 			require.NoError(t, c.PutFile(aCommit, "file4", strings.NewReader(strings.Repeat("a", 50))))
-			bi, err = c.InspectBranch("chain", "b")
-			require.NoError(t, err)
-			require.NotNil(t, bi.Head)
-			require.NotEqual(t, bHead, bi.Head.ID)
-			bHead = bi.Head.ID
-			bi, err = c.InspectBranch("chain", "c")
-			require.NoError(t, err)
-			require.NotNil(t, bi.Head)
-			cHead := bi.Head.ID
+			bHead = waitForTrigger(t, "chain", "b", bHead)
+			cHead = waitForTrigger(t, "chain", "c", cHead)
 
 			// Triggers nothing
 			require.NoError(t, c.PutFile(aCommit, "file5", strings.NewReader(strings.Repeat("a", 50))))
@@ -5373,35 +6425,101 @@ func TestPFS(suite *testing.T) {
 		})
 
 		t.Run("BranchMovement", func(t *testing.T) {
-			// TODO(2.0 required): fix size-based triggering (likely broken due to async compaction)
-			t.Skip("Triggering based on size does not work with V2, needs investigation")
 			require.NoError(t, c.CreateRepo("branch-movement"))
 			require.NoError(t, c.CreateBranchTrigger("branch-movement", "c", "", "", &pfs.Trigger{
 				Branch: "b",
 				Size_:  "100",
 			}))
+			bi, err := c.InspectBranch("branch-movement", "c")
+			require.NoError(t, err)
+			cHead := bi.Head.ID
 			moveCommit := client.NewCommit("branch-movement", "a", "")
 
 			require.NoError(t, c.PutFile(moveCommit, "file1", strings.NewReader(strings.Repeat("a", 50))))
 			require.NoError(t, c.CreateBranch("branch-movement", "b", "a", "", nil))
-			bi, err := c.InspectBranch("branch-movement", "c")
+			bi, err = c.InspectBranch("branch-movement", "c")
 			require.NoError(t, err)
-			require.Nil(t, bi.Head)
+			require.Equal(t, cHead, bi.Head.ID)
 
 			require.NoError(t, c.PutFile(moveCommit, "file2", strings.NewReader(strings.Repeat("a", 50))))
 			require.NoError(t, c.CreateBranch("branch-movement", "b", "a", "", nil))
-			bi, err = c.InspectBranch("branch-movement", "c")
-			require.NoError(t, err)
-			require.NotNil(t, bi.Head)
-			cHead := bi.Head.ID
+			cHead = waitForTrigger(t, "branch-movement", "c", cHead)
 
 			require.NoError(t, c.PutFile(moveCommit, "file3", strings.NewReader(strings.Repeat("a", 50))))
 			require.NoError(t, c.CreateBranch("branch-movement", "b", "a", "", nil))
 			bi, err = c.InspectBranch("branch-movement", "c")
 			require.NoError(t, err)
-			require.NotNil(t, bi.Head)
 			require.Equal(t, cHead, bi.Head.ID)
 		})
+
+		t.Run("Glob", func(t *testing.T) {
+			require.NoError(t, c.CreateRepo("glob"))
+			require.NoError(t, c.CreateBranchTrigger("glob", "trigger", "", "", &pfs.Trigger{
+				Branch: "master",
+				Glob:   "/configs/**",
+			}))
+			bi, err := c.InspectBranch("glob", "trigger")
+			require.NoError(t, err)
+			head := bi.Head.ID
+
+			globCommit := client.NewCommit("glob", "master", "")
+			// Doesn't trigger, no config has changed
+			require.NoError(t, c.PutFile(globCommit, "/data/file1", strings.NewReader("foo")))
+			bi, err = c.InspectBranch("glob", "trigger")
+			require.NoError(t, err)
+			require.Equal(t, head, bi.Head.ID)
+
+			// Triggers, a config was added
+			require.NoError(t, c.PutFile(globCommit, "/configs/a", strings.NewReader("foo")))
+			bi, err = c.InspectBranch("glob", "trigger")
+			require.NoError(t, err)
+			require.NotEqual(t, head, bi.Head.ID)
+			head = bi.Head.ID
+
+			// Doesn't trigger, the config is unchanged
+			require.NoError(t, c.PutFile(globCommit, "/configs/a", strings.NewReader("foo")))
+			bi, err = c.InspectBranch("glob", "trigger")
+			require.NoError(t, err)
+			require.Equal(t, head, bi.Head.ID)
+
+			// Triggers, a config was deleted
+			require.NoError(t, c.DeleteFile(globCommit, "/configs/a"))
+			bi, err = c.InspectBranch("glob", "trigger")
+			require.NoError(t, err)
+			require.NotEqual(t, head, bi.Head.ID)
+		})
+
+		t.Run("Description", func(t *testing.T) {
+			require.NoError(t, c.CreateRepo("description"))
+			require.NoError(t, c.CreateBranchTrigger("description", "trigger", "", "", &pfs.Trigger{
+				Branch:      "master",
+				Description: "^release",
+			}))
+			bi, err := c.InspectBranch("description", "trigger")
+			require.NoError(t, err)
+			head := bi.Head.ID
+
+			commitWithDescription := func(description string) {
+				commit, err := c.PfsAPIClient.StartCommit(c.Ctx(), &pfs.StartCommitRequest{
+					Branch:      client.NewBranch("description", "master"),
+					Description: description,
+				})
+				require.NoError(t, err)
+				require.NoError(t, c.PutFile(commit, "file", strings.NewReader(description)))
+				require.NoError(t, c.FinishCommit("description", commit.Branch.Name, commit.ID))
+			}
+			// Doesn't trigger
+			commitWithDescription("fix typo")
+			bi, err = c.InspectBranch("description", "trigger")
+			require.NoError(t, err)
+			require.Equal(t, head, bi.Head.ID)
+
+			// Triggers
+			commitWithDescription("release 1.0")
+			bi, err = c.InspectBranch("description", "trigger")
+			require.NoError(t, err)
+			require.NotEqual(t, head, bi.Head.ID)
+		})
 	})
 
 	// TriggerValidation tests branch trigger validation
@@ -5447,6 +6565,16 @@ func TestPFS(suite *testing.T) {
 			Branch:   "master",
 			CronSpec: "this is not a cron spec",
 		}))
+		// Glob doesn't parse
+		require.YesError(t, c.CreateBranchTrigger("repo", "trigger", "", "", &pfs.Trigger{
+			Branch: "master",
+			Glob:   "/configs/[",
+		}))
+		// Description doesn't parse
+		require.YesError(t, c.CreateBranchTrigger("repo", "trigger", "", "", &pfs.Trigger{
+			Branch:      "master",
+			Description: "(",
+		}))
 		// Can't use a trigger and provenance together
 		require.NoError(t, c.CreateRepo("in"))
 		_, err := c.PfsAPIClient.CreateBranch(c.Ctx(),
@@ -5515,6 +6643,48 @@ func TestPFS(suite *testing.T) {
 		require.NoError(t, env.PachClient.FinishCommit(repo, commit1.Branch.Name, commit1.ID))
 	})
 
+	suite.Run("CompressionOverrides", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, func(config *serviceenv.Configuration) {
+			config.StorageCompressionRepoOverrides = "zstd=zstd:3,gzip=gzip-best-speed"
+		}, tu.NewTestDBConfig(t))
+		data := bytes.Repeat([]byte("compressible,data\n"), 10000)
+		checkRefs := func(commit *pfs.Commit, expected chunk.CompressionAlgo) {
+			refs := chunkRefs(t, env, commit)
+			require.True(t, len(refs) > 0)
+			for _, ref := range refs {
+				require.Equal(t, expected, ref.CompressionAlgo)
+			}
+		}
+		for repo, expected := range map[string]chunk.CompressionAlgo{
+			"zstd":    chunk.CompressionAlgo_ZSTD,
+			"gzip":    chunk.CompressionAlgo_GZIP_BEST_SPEED,
+			"default": chunk.CompressionAlgo_NONE,
+		} {
+			require.NoError(t, env.PachClient.CreateRepo(repo))
+			// One-off put file, without an open commit.
+			master := client.NewCommit(repo, "master", "")
+			require.NoError(t, env.PachClient.PutFile(master, "/one-off", bytes.NewReader(data)))
+			checkRefs(master, expected)
+			// Put file into an open commit.
+			commit, err := env.PachClient.StartCommit(repo, "master")
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.PutFile(commit, "/open", bytes.NewReader(data)))
+			require.NoError(t, env.PachClient.FinishCommit(repo, commit.Branch.Name, commit.ID))
+			checkRefs(commit, expected)
+			// A fileset created for a commit in the repo.
+			commit, err = env.PachClient.StartCommit(repo, "master")
+			require.NoError(t, err)
+			resp, err := env.PachClient.WithCreateFileSetClientForCommit(commit, func(mf client.ModifyFile) error {
+				return mf.PutFile("/fileset", bytes.NewReader(data))
+			})
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.AddFileSet(repo, commit.Branch.Name, commit.ID, resp.FileSetId))
+			require.NoError(t, env.PachClient.FinishCommit(repo, commit.Branch.Name, commit.ID))
+			checkRefs(commit, expected)
+		}
+	})
+
 	suite.Run("ModifyFileGRPC", func(subsuite *testing.T) {
 		subsuite.Parallel()
 
@@ -5748,6 +6918,33 @@ var (
 	randMu   sync.Mutex
 )
 
+// chunkRefs returns the refs of the chunks with the data of the files in
+// commit, read directly from the storage of env.
+func chunkRefs(t *testing.T, env *testpachd.RealEnv, commit *pfs.Commit) []*chunk.Ref {
+	ctx := env.PachClient.Ctx()
+	db := env.ServiceEnv.GetDBClient()
+	objClient, err := obj.NewClient(env.ServiceEnv.Config().StorageBackend, env.ServiceEnv.Config().StorageRoot)
+	require.NoError(t, err)
+	tracker := track.NewPostgresTracker(db)
+	// "master" is the name of the key that PFS wraps chunk encryption keys with.
+	chunks := chunk.NewStorage(objClient, kv.NewMemCache(10), db, tracker, chunk.WithKeyStore(chunk.NewPostgresKeyStore(db), "master"))
+	storage := fileset.NewStorage(fileset.NewPostgresStore(db), tracker, chunks)
+	resp, err := env.PachClient.PfsAPIClient.GetFileSet(ctx, &pfs.GetFileSetRequest{Commit: commit})
+	require.NoError(t, err)
+	id, err := fileset.ParseID(resp.FileSetId)
+	require.NoError(t, err)
+	fs, err := storage.Open(ctx, []fileset.ID{*id})
+	require.NoError(t, err)
+	var refs []*chunk.Ref
+	require.NoError(t, fs.Iterate(ctx, func(f fileset.File) error {
+		for _, dataRef := range f.Index().File.DataRefs {
+			refs = append(refs, dataRef.Ref)
+		}
+		return nil
+	}))
+	return refs
+}
+
 func writeObj(t *testing.T, c obj.Client, path, content string) {
 	err := c.Put(context.Background(), path, strings.NewReader(content))
 	require.NoError(t, err)
@@ -1,17 +1,28 @@
 package server
 
 import (
+	"context"
+	"database/sql"
+	"regexp"
+	"sync"
 	"time"
 
 	units "github.com/docker/go-units"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/robfig/cron"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
@@ -52,7 +63,9 @@ func (d *driver) triggerCommit(
 				return nil, err
 			}
 
-			if newHead != nil {
+			// The branch is already at newHead if the trigger fired when newHead
+			// was finished, and it's evaluated again after compaction.
+			if newHead != nil && bi.Head.ID != newHead.Commit.ID {
 				oldHead := &pfs.CommitInfo{}
 				if err := d.commits.ReadWrite(txnCtx.SqlTx).Get(pfsdb.CommitKey(bi.Head), oldHead); err != nil {
 					return nil, err
@@ -63,8 +76,11 @@ func (d *driver) triggerCommit(
 					return nil, err
 				}
 
+				// The protection of the branch isn't checked, since only
+				// principals allowed to move its head can give it a trigger
+				// (see createBranch).
 				if triggered {
-					aliasCommit, err := d.aliasCommit(txnCtx, newHead.Commit, bi.Branch)
+					aliasCommit, err := d.aliasCommit(txnCtx, newHead.Commit, bi.Branch, true)
 					if err != nil {
 						return nil, err
 					}
@@ -97,18 +113,16 @@ func (d *driver) isTriggered(txnCtx *txncontext.TransactionContext, t *pfs.Trigg
 		}
 	}
 	if t.Size_ != "" {
-		_, err := units.FromHumanSize(t.Size_)
+		size, err := units.FromHumanSize(t.Size_)
 		if err != nil {
 			// Shouldn't be possible to error here since we validate on ingress
 			return false, errors.EnsureStack(err)
 		}
-		// TODO(2.0 required): the size of a commit isn't known when finishing the
-		// commit due to async compaction, so commitInfo.Details is nil here.
-		// var oldSize uint64
-		// if oldHead != nil {
-		// 	oldSize = oldHead.Details.SizeBytes
-		// }
-		// merge(int64(newHead.Details.SizeBytes-oldSize) >= size)
+		triggered, err := d.isSizeTriggered(txnCtx, size, oldHead, newHead)
+		if err != nil {
+			return false, err
+		}
+		merge(triggered)
 	}
 	if t.CronSpec != "" {
 		// Shouldn't be possible to error here since we validate on ingress
@@ -132,6 +146,27 @@ func (d *driver) isTriggered(txnCtx *txncontext.TransactionContext, t *pfs.Trigg
 		}
 		merge(schedule.Next(oldTime).Before(newTime))
 	}
+	if t.Glob != "" {
+		triggered, err := d.isGlobTriggered(txnCtx, t.Glob, oldHead, newHead)
+		if err != nil {
+			return false, err
+		}
+		merge(triggered)
+	}
+	if t.Description != "" {
+		// Shouldn't be possible to error here since we validate on ingress
+		re, err := regexp.Compile(t.Description)
+		if err != nil {
+			return false, errors.EnsureStack(err)
+		}
+		// Aliases don't have their own description, so it's taken from the
+		// commit they alias.
+		root, err := d.resolveAlias(txnCtx, newHead.Commit)
+		if err != nil {
+			return false, err
+		}
+		merge(re.MatchString(root.Description))
+	}
 	if t.Commits != 0 {
 		ci := newHead
 		var commits int64
@@ -152,6 +187,286 @@ func (d *driver) isTriggered(txnCtx *txncontext.TransactionContext, t *pfs.Trigg
 	return result, nil
 }
 
+// isSizeTriggered checks to see if at least size bytes have been added
+// between oldHead and newHead.
+// The size of a commit is only known once its total fileset is compacted,
+// which happens after the commit is finished, so this is false until then.
+// triggerCompactedCommit evaluates the triggers again after compaction.
+func (d *driver) isSizeTriggered(txnCtx *txncontext.TransactionContext, size int64, oldHead, newHead *pfs.CommitInfo) (bool, error) {
+	newSize, ok, err := d.compactedCommitSize(txnCtx, newHead.Commit)
+	if err != nil || !ok {
+		return false, err
+	}
+	var oldSize int64
+	if oldHead != nil {
+		oldSize, ok, err = d.compactedCommitSize(txnCtx, oldHead.Commit)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return newSize-oldSize >= size, nil
+}
+
+// isGlobTriggered checks to see if a file matching glob differs between
+// oldHead and newHead.
+func (d *driver) isGlobTriggered(txnCtx *txncontext.TransactionContext, glob string, oldHead, newHead *pfs.CommitInfo) (bool, error) {
+	glob = cleanPath(glob)
+	mf, err := globMatchFunction(glob)
+	if err != nil {
+		return false, err
+	}
+	openSource := func(commitInfo *pfs.CommitInfo) (Source, error) {
+		ids, err := d.commitFileSetsTx(txnCtx, commitInfo.Commit)
+		if err != nil {
+			return nil, err
+		}
+		fs, err := d.storage.Open(txnCtx.ClientContext, ids, index.WithPrefix(globLiteralPrefix(glob)))
+		if err != nil {
+			return nil, err
+		}
+		return NewSource(commitInfo, fs), nil
+	}
+	oldSource, err := openSource(oldHead)
+	if err != nil {
+		return false, err
+	}
+	newSource, err := openSource(newHead)
+	if err != nil {
+		return false, err
+	}
+	var triggered bool
+	if err := NewDiffer(oldSource, newSource).Iterate(txnCtx.ClientContext, func(oldFi, newFi *pfs.FileInfo) error {
+		for _, fi := range []*pfs.FileInfo{oldFi, newFi} {
+			if fi != nil && mf(fi.File.Path) {
+				triggered = true
+				return errutil.ErrBreak
+			}
+		}
+		return nil
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return false, err
+	}
+	return triggered, nil
+}
+
+// commitFileSetsTx returns the filesets which make up the contents of commit,
+// which may have been finished in txnCtx. Unlike getFileSet, it doesn't
+// compact the commit or its ancestors, or create a composite fileset, which
+// couldn't be opened outside of txnCtx.
+func (d *driver) commitFileSetsTx(txnCtx *txncontext.TransactionContext, commit *pfs.Commit) ([]fileset.ID, error) {
+	commitInfo, err := d.resolveAlias(txnCtx, commit)
+	if err != nil {
+		return nil, err
+	}
+	id, err := getTotal(txnCtx.SqlTx, commitInfo.Commit)
+	if err == nil {
+		return []fileset.ID{*id}, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.EnsureStack(err)
+	}
+	var ids []fileset.ID
+	parentCommit := commitInfo.ParentCommit
+	for parentCommit != nil {
+		parentInfo, err := d.resolveCommit(txnCtx.SqlTx, parentCommit)
+		if err != nil {
+			return nil, err
+		}
+		if !parentInfo.Error {
+			parentIDs, err := d.commitFileSetsTx(txnCtx, parentCommit)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, parentIDs...)
+			break
+		}
+		parentCommit = parentInfo.ParentCommit
+	}
+	diffIDs, err := getDiff(txnCtx.SqlTx, commitInfo.Commit)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return append(ids, diffIDs...), nil
+}
+
+// compactedCommitSize returns the size of commit, and whether its total
+// fileset has been compacted. The size is unknown until then.
+func (d *driver) compactedCommitSize(txnCtx *txncontext.TransactionContext, commit *pfs.Commit) (int64, bool, error) {
+	commitInfo, err := d.resolveAlias(txnCtx, commit)
+	if err != nil {
+		return 0, false, err
+	}
+	id, err := getTotal(txnCtx.SqlTx, commitInfo.Commit)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, errors.EnsureStack(err)
+	}
+	size, err := d.storage.Size(txnCtx.ClientContext, *id)
+	if err != nil {
+		return 0, false, err
+	}
+	return size, true, nil
+}
+
+// triggerCompactedCommit evaluates the triggers in the repo of commit again
+// after compacting it, so that size triggers can fire. It compacts the heads
+// of branches with size triggers too, which are needed to compute how much
+// data has been added since they last fired.
+// It does nothing if there are no size triggers in the repo, or if commit
+// isn't the finished head of its branch. Aliases are compacted through the
+// commit they alias, so moving a branch can fire size triggers too.
+func (d *driver) triggerCompactedCommit(ctx context.Context, commit *pfs.Commit) error {
+	var head *pfs.Commit
+	var compact []*pfs.Commit
+	if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		head, compact = nil, nil
+		commitInfo, err := d.resolveCommit(txnCtx.SqlTx, proto.Clone(commit).(*pfs.Commit))
+		if err != nil {
+			return err
+		}
+		if commitInfo.Finished == nil || commitInfo.Error {
+			return nil
+		}
+		if isHead, err := d.isBranchHead(txnCtx, commitInfo.Commit); err != nil || !isHead {
+			return err
+		}
+		repoInfo := &pfs.RepoInfo{}
+		if err := d.repos.ReadWrite(txnCtx.SqlTx).Get(pfsdb.RepoKey(commitInfo.Commit.Branch.Repo), repoInfo); err != nil {
+			return err
+		}
+		for _, branch := range repoInfo.Branches {
+			bi := &pfs.BranchInfo{}
+			if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(pfsdb.BranchKey(branch), bi); err != nil {
+				return err
+			}
+			if bi.Trigger == nil || bi.Trigger.Size_ == "" || bi.Head == nil {
+				continue
+			}
+			root, err := d.resolveAlias(txnCtx, bi.Head)
+			if err != nil {
+				return err
+			}
+			if root.Finished != nil {
+				compact = append(compact, root.Commit)
+			}
+		}
+		if len(compact) > 0 {
+			root, err := d.resolveAlias(txnCtx, commitInfo.Commit)
+			if err != nil {
+				return err
+			}
+			head = commitInfo.Commit
+			compact = append(compact, root.Commit)
+		}
+		return nil
+	}); err != nil || head == nil {
+		return err
+	}
+	for _, commit := range compact {
+		if _, err := d.getOrComputeTotal(ctx, commit); err != nil {
+			return err
+		}
+	}
+	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		// Commits created by the triggers are in the same CommitSet as head,
+		// like when they fire as head is finished.
+		txnCtx.CommitSetID = head.ID
+		if isHead, err := d.isBranchHead(txnCtx, head); err != nil || !isHead {
+			return err
+		}
+		return d.triggerCommit(txnCtx, head)
+	})
+}
+
+// evaluateSizeTriggers calls triggerCompactedCommit, logging errors rather
+// than stopping watchSizeTriggers.
+func (d *driver) evaluateSizeTriggers(ctx context.Context, commit *pfs.Commit) {
+	if err := d.triggerCompactedCommit(ctx, commit); err != nil && !col.IsErrNotFound(err) {
+		log.Errorf("error evaluating size triggers on %v: %v", commit, err)
+	}
+}
+
+func (d *driver) isBranchHead(txnCtx *txncontext.TransactionContext, commit *pfs.Commit) (bool, error) {
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(pfsdb.BranchKey(commit.Branch), branchInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return branchInfo.Head != nil && branchInfo.Head.ID == commit.ID, nil
+}
+
+// watchSizeTriggers calls triggerCompactedCommit on the heads of branches as
+// their commits are finished and their heads are moved. Size triggers are only
+// evaluated here, in the background, since the commits have to be compacted
+// to evaluate them.
+func (d *driver) watchSizeTriggers(ctx context.Context) error {
+	var mu sync.Mutex
+	pending := make(map[string]*pfs.Branch)
+	notify := make(chan struct{}, 1)
+	// Only the latest head of each branch matters, so the pending evaluations
+	// are deduplicated by branch.
+	markPending := func(branch *pfs.Branch) {
+		mu.Lock()
+		pending[pfsdb.BranchKey(branch)] = branch
+		mu.Unlock()
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		return d.branches.ReadOnly(ctx).WatchF(func(ev *watch.Event) error {
+			var key string
+			branchInfo := &pfs.BranchInfo{}
+			if err := ev.Unmarshal(&key, branchInfo); err != nil {
+				return errors.Wrapf(err, "unmarshal")
+			}
+			if branchInfo.Trigger != nil && branchInfo.Trigger.Size_ != "" {
+				// The branch's trigger may fire on the current head of the
+				// branch it triggers on.
+				markPending(branchInfo.Branch.Repo.NewBranch(branchInfo.Trigger.Branch))
+			}
+			markPending(branchInfo.Branch)
+			return nil
+		}, watch.IgnoreDelete)
+	})
+	eg.Go(func() error {
+		return d.commits.ReadOnly(ctx).WatchF(func(ev *watch.Event) error {
+			var key string
+			commitInfo := &pfs.CommitInfo{}
+			if err := ev.Unmarshal(&key, commitInfo); err != nil {
+				return errors.Wrapf(err, "unmarshal")
+			}
+			if commitInfo.Finished != nil {
+				markPending(commitInfo.Commit.Branch)
+			}
+			return nil
+		}, watch.IgnoreDelete)
+	})
+	eg.Go(func() error {
+		for {
+			select {
+			case <-notify:
+			case <-ctx.Done():
+				return errors.EnsureStack(ctx.Err())
+			}
+			mu.Lock()
+			branches := pending
+			pending = make(map[string]*pfs.Branch)
+			mu.Unlock()
+			for _, branch := range branches {
+				d.evaluateSizeTriggers(ctx, branch.NewCommit(""))
+			}
+		}
+	})
+	return eg.Wait()
+}
+
 // validateTrigger returns an error if a trigger is invalid
 func (d *driver) validateTrigger(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, trigger *pfs.Trigger) error {
 	if trigger == nil {
@@ -172,6 +487,12 @@ func (d *driver) validateTrigger(txnCtx *txncontext.TransactionContext, branch *
 	if trigger.Commits < 0 {
 		return errors.Errorf("can't trigger on a negative number of commits")
 	}
+	if _, err := globMatchFunction(cleanPath(trigger.Glob)); trigger.Glob != "" && err != nil {
+		return errors.Wrapf(err, "invalid trigger glob")
+	}
+	if _, err := regexp.Compile(trigger.Description); trigger.Description != "" && err != nil {
+		return errors.Wrapf(err, "invalid trigger description")
+	}
 
 	biMaps := make(map[string]*pfs.BranchInfo)
 	if err := d.listBranch(txnCtx.ClientContext, branch.Repo, false, func(bi *pfs.BranchInfo) error {
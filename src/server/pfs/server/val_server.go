@@ -154,6 +154,23 @@ func (a *validatedAPIServer) GetFileTAR(request *pfs.GetFileRequest, server pfs.
 	return a.apiServer.GetFileTAR(request, server)
 }
 
+func (a *validatedAPIServer) GetFileRange(request *pfs.GetFileRangeRequest, server pfs.API_GetFileRangeServer) error {
+	if err := validateFile(request.File); err != nil {
+		return err
+	}
+	return a.apiServer.GetFileRange(request, server)
+}
+
+func (a *validatedAPIServer) ExportRepo(request *pfs.ExportRepoRequest, server pfs.API_ExportRepoServer) error {
+	if request.Repo == nil {
+		return errors.New("repo cannot be nil")
+	}
+	if request.Base != nil && request.Base.Branch.GetRepo().GetName() != request.Repo.Name {
+		return errors.New("base commit must belong to the exported repo")
+	}
+	return a.apiServer.ExportRepo(request, server)
+}
+
 func (a *validatedAPIServer) CreateBranchInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.CreateBranchRequest) error {
 	if request.Head != nil && request.Branch.Repo.Name != request.Head.Branch.Repo.Name {
 		return errors.New("branch and head commit must belong to the same repo")
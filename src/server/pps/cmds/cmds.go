@@ -24,6 +24,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing/extended"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	ppsclient "github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/server/cmd/pachctl/shell"
@@ -1117,6 +1118,168 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	}
 	commands = append(commands, cmdutil.CreateAlias(listSecret, "list secret"))
 
+	webhookDocs := &cobra.Command{
+		Short: "Docs for webhooks.",
+		Long: `Webhooks are URLs that pachd POSTs commit and job events to.
+
+Each event is a JSON object describing the commit or job and the state it
+reached. Requests carry the delivery's ID in the X-Pachyderm-Delivery header,
+and the HMAC-SHA256 of the body, keyed by the webhook's secret, in the
+X-Pachyderm-Signature header. Failed deliveries are retried with exponential
+backoff, and are dead-lettered after the webhook's maximum number of attempts.
+A delivery may be sent more than once, so receivers should deduplicate them by
+ID.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(webhookDocs, "webhook", " webhook$"))
+
+	var webhookCommits, webhookJobs bool
+	var webhookRepo, webhookBranch, webhookPipeline, webhookSecret string
+	var webhookCommitStates, webhookJobStates []string
+	var webhookMaxAttempts int64
+	var updateWebhook bool
+	createWebhook := &cobra.Command{
+		Use:   "{{alias}} <name> <url>",
+		Short: "Create a webhook.",
+		Long: `Create a webhook that url is POSTed the commit and job events matched by its filters.
+
+A commit filter is added by --commits or any of the commit flags, and matches the commits in user repos. A job filter is added by --jobs or any of the job flags. The secret that payloads are signed with is printed; it is generated if --secret isn't given.`,
+		Example: `
+# Send the commits finished on the master branch of repo "foo"
+$ {{alias}} foo-master https://example.com/hook --repo foo --branch master --commit-state finished
+
+# Send the failed jobs of all pipelines
+$ {{alias}} failures https://example.com/hook --job-state failure`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			request := &ppsclient.CreateWebhookRequest{
+				Webhook:     &ppsclient.Webhook{Name: args[0]},
+				URL:         args[1],
+				Secret:      webhookSecret,
+				MaxAttempts: webhookMaxAttempts,
+				Update:      updateWebhook,
+			}
+			if webhookCommits || webhookRepo != "" || webhookBranch != "" || len(webhookCommitStates) > 0 {
+				request.Commits = &ppsclient.WebhookCommitFilter{
+					Repo:   webhookRepo,
+					Branch: webhookBranch,
+				}
+				for _, stateStr := range webhookCommitStates {
+					state, ok := pfs.CommitState_value[strings.ToUpper(stateStr)]
+					if !ok {
+						return errors.Errorf("invalid commit state %q, it must be started or finished", stateStr)
+					}
+					request.Commits.States = append(request.Commits.States, pfs.CommitState(state))
+				}
+			}
+			if webhookJobs || webhookPipeline != "" || len(webhookJobStates) > 0 {
+				request.Jobs = &ppsclient.WebhookJobFilter{
+					Pipeline: webhookPipeline,
+				}
+				for _, stateStr := range webhookJobStates {
+					state, err := ppsclient.JobStateFromName(stateStr)
+					if err != nil {
+						return err
+					}
+					request.Jobs.States = append(request.Jobs.States, state)
+				}
+			}
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			webhookInfo, err := client.PpsAPIClient.CreateWebhook(client.Ctx(), request)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			fmt.Printf("webhook secret: %s\n", webhookInfo.Secret)
+			return nil
+		}),
+	}
+	createWebhook.Flags().BoolVar(&webhookCommits, "commits", false, "Send commit events, which are restricted by the other commit flags.")
+	createWebhook.Flags().StringVar(&webhookRepo, "repo", "", "Only send the commits in this repo.")
+	createWebhook.Flags().StringVar(&webhookBranch, "branch", "", "Only send the commits on this branch.")
+	createWebhook.Flags().StringSliceVar(&webhookCommitStates, "commit-state", nil, "Only send commits that reach this state, started or finished; may be repeated.")
+	createWebhook.Flags().BoolVar(&webhookJobs, "jobs", false, "Send job events, which are restricted by the other job flags.")
+	createWebhook.Flags().StringVar(&webhookPipeline, "pipeline", "", "Only send the jobs of this pipeline.")
+	createWebhook.Flags().StringSliceVar(&webhookJobStates, "job-state", nil, "Only send jobs that reach this state; may be repeated.")
+	createWebhook.Flags().StringVar(&webhookSecret, "secret", "", "The secret that payloads are signed with. It is generated if not given, or kept if the webhook is updated.")
+	createWebhook.Flags().Int64Var(&webhookMaxAttempts, "max-attempts", 0, "The number of times a delivery is attempted before it is dead-lettered (default 10).")
+	createWebhook.Flags().BoolVar(&updateWebhook, "update", false, "Update the webhook if it already exists.")
+	commands = append(commands, cmdutil.CreateAlias(createWebhook, "create webhook"))
+
+	listWebhook := &cobra.Command{
+		Short: "List all webhooks.",
+		Long:  "List all webhooks.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			webhookInfos, err := client.ListWebhook()
+			if err != nil {
+				return err
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.WebhookHeader)
+			for _, wi := range webhookInfos {
+				pretty.PrintWebhookInfo(writer, wi)
+			}
+			return writer.Flush()
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(listWebhook, "list webhook"))
+
+	deleteWebhook := &cobra.Command{
+		Use:   "{{alias}} <name>",
+		Short: "Delete a webhook.",
+		Long:  "Delete a webhook and its delivery history.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.DeleteWebhook(args[0])
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(deleteWebhook, "delete webhook"))
+
+	var deliveryState string
+	listDelivery := &cobra.Command{
+		Use:   "{{alias}} <webhook>",
+		Short: "List the delivery history of a webhook.",
+		Long:  "List the deliveries of events to a webhook, newest first. Successful deliveries are kept for a week, and dead-lettered ones until the webhook is deleted.",
+		Example: `
+# List the dead-lettered deliveries to webhook "foo"
+$ {{alias}} foo --state dead-letter`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			var state ppsclient.WebhookDeliveryState
+			if deliveryState != "" {
+				s, ok := ppsclient.WebhookDeliveryState_value["DELIVERY_"+strings.ToUpper(strings.ReplaceAll(deliveryState, "-", "_"))]
+				if !ok {
+					return errors.Errorf("invalid delivery state %q, it must be pending, succeeded or dead-letter", deliveryState)
+				}
+				state = ppsclient.WebhookDeliveryState(s)
+			}
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			deliveries, err := client.ListWebhookDelivery(args[0], state)
+			if err != nil {
+				return err
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.WebhookDeliveryHeader)
+			for _, delivery := range deliveries {
+				pretty.PrintWebhookDelivery(writer, delivery)
+			}
+			return writer.Flush()
+		}),
+	}
+	listDelivery.Flags().StringVar(&deliveryState, "state", "", "Only list the deliveries in this state, pending, succeeded or dead-letter.")
+	commands = append(commands, cmdutil.CreateAlias(listDelivery, "list delivery"))
+
 	return commands
 }
 
@@ -285,11 +285,6 @@ func TestJSONMultiplePipelinesError(t *testing.T) {
 }
 
 func TestRunPipeline(t *testing.T) {
-	// TODO(2.0 optional): Run pipeline creates a dangling commit, but uses the stats branch for stats commits.
-	// Since there is no relationship between the commits created by run pipeline, there should be no
-	// relationship between the stats commits. So, the stats commits should also be dangling commits.
-	// This might be easier to address when global IDs are implemented.
-	t.Skip("Run pipeline does not work correctly with stats enabled")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
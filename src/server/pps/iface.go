@@ -17,4 +17,9 @@ type APIServer interface {
 	StopJobInTransaction(*txncontext.TransactionContext, *pps_client.StopJobRequest) error
 	UpdateJobStateInTransaction(*txncontext.TransactionContext, *pps_client.UpdateJobStateRequest) error
 	CreatePipelineInTransaction(*txncontext.TransactionContext, *pps_client.CreatePipelineRequest, *string, *uint64) error
+
+	// Methods to update the inputs of pipelines when repos and branches are
+	// renamed in PFS. These check that the caller can update the pipelines.
+	RenameInputRepoInTransaction(*txncontext.TransactionContext, string, string) error
+	RenameInputBranchInTransaction(*txncontext.TransactionContext, string, string, string) error
 }
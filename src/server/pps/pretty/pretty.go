@@ -31,6 +31,10 @@ const (
 	DatumHeader = "ID\tFILES\tSTATUS\tTIME\t\n"
 	// SecretHeader is the header for secrets
 	SecretHeader = "NAME\tTYPE\tCREATED\t\n"
+	// WebhookHeader is the header for webhooks
+	WebhookHeader = "NAME\tURL\tEVENTS\tMAX ATTEMPTS\tCREATED\t\n"
+	// WebhookDeliveryHeader is the header for webhook deliveries
+	WebhookDeliveryHeader = "ID\tEVENT\tSTATE\tATTEMPTS\tCREATED\tLAST ERROR\t\n"
 	// jobReasonLen is the amount of the job reason that we print
 	jobReasonLen = 25
 )
@@ -378,6 +382,64 @@ func PrintSecretInfo(w io.Writer, secretInfo *ppsclient.SecretInfo) {
 	fmt.Fprintf(w, "%s\t%s\t%s\t\n", secretInfo.Secret.Name, secretInfo.Type, pretty.Ago(secretInfo.CreationTimestamp))
 }
 
+// PrintWebhookInfo pretty-prints webhook info.
+func PrintWebhookInfo(w io.Writer, webhookInfo *ppsclient.WebhookInfo) {
+	var events []string
+	if filter := webhookInfo.Commits; filter != nil {
+		var states []string
+		for _, state := range filter.States {
+			states = append(states, state.String())
+		}
+		events = append(events, "commits"+webhookFilter("repo", filter.Repo)+webhookFilter("branch", filter.Branch)+webhookFilter("states", strings.Join(states, ",")))
+	}
+	if filter := webhookInfo.Jobs; filter != nil {
+		var states []string
+		for _, state := range filter.States {
+			states = append(states, strings.ToLower(strings.TrimPrefix(state.String(), "JOB_")))
+		}
+		events = append(events, "jobs"+webhookFilter("pipeline", filter.Pipeline)+webhookFilter("states", strings.Join(states, ",")))
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t\n", webhookInfo.Webhook.Name, webhookInfo.URL, strings.Join(events, "; "), webhookInfo.MaxAttempts, pretty.Ago(webhookInfo.Created))
+}
+
+func webhookFilter(name, value string) string {
+	if value == "" {
+		return ""
+	}
+	return " " + name + "=" + value
+}
+
+// PrintWebhookDelivery pretty-prints a webhook delivery.
+func PrintWebhookDelivery(w io.Writer, delivery *ppsclient.WebhookDelivery) {
+	event := delivery.Event
+	var subject string
+	switch {
+	case event.Commit != nil:
+		subject = event.Commit.Commit.String()
+	case event.Job != nil:
+		subject = event.Job.Job.String()
+	}
+	fmt.Fprintf(w, "%s\t%s %s %s\t", event.ID, event.Type, subject, event.State)
+	fmt.Fprintf(w, "%s\t%d\t%s\t", webhookDeliveryState(delivery.State), delivery.Attempts, pretty.Ago(delivery.Created))
+	if delivery.Error != "" {
+		fmt.Fprintf(w, "%s\t\n", safeTrim(delivery.Error, jobReasonLen))
+	} else {
+		fmt.Fprintf(w, "-\t\n")
+	}
+}
+
+func webhookDeliveryState(state ppsclient.WebhookDeliveryState) string {
+	switch state {
+	case ppsclient.WebhookDeliveryState_DELIVERY_PENDING:
+		return color.New(color.FgYellow).SprintFunc()("pending")
+	case ppsclient.WebhookDeliveryState_DELIVERY_SUCCEEDED:
+		return color.New(color.FgGreen).SprintFunc()("succeeded")
+	case ppsclient.WebhookDeliveryState_DELIVERY_DEAD_LETTER:
+		return color.New(color.FgRed).SprintFunc()("dead letter")
+	}
+	return "-"
+}
+
 // PrintFileHeader prints the header for a pfs file.
 func PrintFileHeader(w io.Writer) {
 	fmt.Fprintf(w, "  REPO\tCOMMIT\tPATH\t\n")
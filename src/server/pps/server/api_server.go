@@ -43,6 +43,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing/extended"
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
@@ -106,8 +107,53 @@ type apiServer struct {
 	peerPort              uint16
 	gcPercent             int
 	// collections
-	pipelines col.PostgresCollection
-	jobs      col.PostgresCollection
+	pipelines         col.PostgresCollection
+	jobs              col.PostgresCollection
+	webhooks          col.PostgresCollection
+	webhookDeliveries col.PostgresCollection
+	// renameFileSets holds the spec filesets written for the pipelines whose
+	// inputs are renamed by each call, by the call's context.
+	renameFileSets   map[context.Context]*renameFileSets
+	renameFileSetsMu sync.Mutex
+}
+
+// renameFileSets holds the spec filesets written for the pipelines whose
+// inputs are renamed by one call, across retries of its transaction. They're
+// renewed until the call's context is done, when they're forgotten.
+type renameFileSets struct {
+	renewer *renew.StringSet
+	// ids maps pipeline specs to the filesets they were written to.
+	ids map[string]string
+}
+
+// getRenameFileSets returns the spec filesets written for the call with ctx.
+func (a *apiServer) getRenameFileSets(ctx context.Context) *renameFileSets {
+	a.renameFileSetsMu.Lock()
+	defer a.renameFileSetsMu.Unlock()
+	if fileSets, ok := a.renameFileSets[ctx]; ok {
+		return fileSets
+	}
+	if a.renameFileSets == nil {
+		a.renameFileSets = make(map[context.Context]*renameFileSets)
+	}
+	pachClient := a.env.GetPachClient(ctx)
+	fileSets := &renameFileSets{
+		renewer: renew.NewStringSet(ctx, client.DefaultTTL, func(ctx context.Context, id string, ttl time.Duration) error {
+			return pachClient.WithCtx(ctx).RenewFileSet(id, ttl)
+		}),
+		ids: make(map[string]string),
+	}
+	a.renameFileSets[ctx] = fileSets
+	go func() {
+		<-ctx.Done()
+		if err := fileSets.renewer.Close(); err != nil {
+			logrus.Errorf("error renewing the spec filesets of renamed pipelines: %v", err)
+		}
+		a.renameFileSetsMu.Lock()
+		defer a.renameFileSetsMu.Unlock()
+		delete(a.renameFileSets, ctx)
+	}()
+	return fileSets
 }
 
 func merge(from, to map[string]bool) {
@@ -1034,7 +1080,9 @@ func (a *apiServer) listDatumInput(ctx context.Context, input *pps.Input, cb fun
 	if visitErr := pps.VisitInput(input, func(input *pps.Input) error {
 		if input.Pfs != nil {
 			pachClient := a.env.GetPachClient(ctx)
-			ci, err := pachClient.InspectCommit(input.Pfs.Repo, input.Pfs.Branch, "")
+			// A commit given in the input, such as "@<time>", is resolved
+			// relative to its branch.
+			ci, err := pachClient.InspectCommit(input.Pfs.Repo, input.Pfs.Branch, input.Pfs.Commit)
 			if err != nil {
 				return err
 			}
@@ -1904,9 +1952,39 @@ func (a *apiServer) pipelineInfosForUpdate(txnCtx *txncontext.TransactionContext
 		return nil, nil, err
 	}
 
+	if err := a.resolveInputTimes(txnCtx, newPipelineInfo.Details.Input); err != nil {
+		return nil, nil, err
+	}
+
 	return oldPipelineInfo, newPipelineInfo, nil
 }
 
+// resolveInputTimes pins the PFS inputs in input whose commits are time
+// references (@<time>) to the commits they refer to, so that every job of the
+// pipeline reads the same commit of those inputs. Other inputs are unpinned.
+func (a *apiServer) resolveInputTimes(txnCtx *txncontext.TransactionContext, input *pps.Input) error {
+	return pps.VisitInput(input, func(input *pps.Input) error {
+		if input.Pfs == nil {
+			return nil
+		}
+		input.Pfs.PinnedCommit = ""
+		if input.Pfs.Commit == "" {
+			return nil
+		}
+		if _, t, err := ancestry.ParseTime(input.Pfs.Commit); err != nil || t == nil {
+			return err
+		}
+		ci, err := a.env.PfsServer().InspectCommitInTransaction(txnCtx, &pfs.InspectCommitRequest{
+			Commit: client.NewSystemRepo(input.Pfs.Repo, input.Pfs.RepoType).NewCommit(input.Pfs.Branch, input.Pfs.Commit),
+		})
+		if err != nil {
+			return err
+		}
+		input.Pfs.PinnedCommit = ci.Commit.ID
+		return nil
+	})
+}
+
 func (a *apiServer) CreatePipelineInTransaction(
 	txnCtx *txncontext.TransactionContext,
 	request *pps.CreatePipelineRequest,
@@ -2228,6 +2306,99 @@ func (a *apiServer) CreatePipelineInTransaction(
 	return nil
 }
 
+// RenameInputRepoInTransaction updates the inputs of all pipelines which read
+// from the repo oldName to read from newName. This is not an RPC.
+func (a *apiServer) RenameInputRepoInTransaction(txnCtx *txncontext.TransactionContext, oldName, newName string) error {
+	return a.renamePipelineInputs(txnCtx, func(input *pps.Input) (bool, error) {
+		switch {
+		case input.Pfs != nil && input.Pfs.Repo == oldName && input.Pfs.RepoType == pfs.UserRepoType:
+			input.Pfs.Repo = newName
+			return true, nil
+		case input.Cron != nil && input.Cron.Repo == oldName:
+			input.Cron.Repo = newName
+			return true, nil
+		}
+		return false, nil
+	})
+}
+
+// RenameInputBranchInTransaction updates the inputs of all pipelines which
+// read from, or are triggered by, the branch oldName of repo to use newName.
+// This is not an RPC.
+func (a *apiServer) RenameInputBranchInTransaction(txnCtx *txncontext.TransactionContext, repo, oldName, newName string) error {
+	return a.renamePipelineInputs(txnCtx, func(input *pps.Input) (bool, error) {
+		switch {
+		case input.Pfs != nil && input.Pfs.Repo == repo && input.Pfs.RepoType == pfs.UserRepoType:
+			var renamed bool
+			if input.Pfs.Branch == oldName {
+				input.Pfs.Branch = newName
+				renamed = true
+			}
+			if input.Pfs.Trigger != nil && input.Pfs.Trigger.Branch == oldName {
+				input.Pfs.Trigger.Branch = newName
+				renamed = true
+			}
+			return renamed, nil
+		case input.Cron != nil && input.Cron.Repo == repo && oldName == "master":
+			return false, errors.Errorf("branch master of %s is the input of a cron pipeline and cannot be renamed", repo)
+		}
+		return false, nil
+	})
+}
+
+// renamePipelineInputs updates every pipeline whose input is changed by
+// rename. The new spec of each pipeline is written to a fileset outside of the
+// transaction, so the transaction is retried until all of them are visible.
+func (a *apiServer) renamePipelineInputs(txnCtx *txncontext.TransactionContext, rename func(*pps.Input) (bool, error)) error {
+	var pipelineNames []string
+	if err := a.pipelines.ReadOnly(txnCtx.ClientContext).List(&pps.PipelineInfo{}, col.DefaultOptions(), func(key string) error {
+		pipelineNames = append(pipelineNames, key)
+		return nil
+	}); err != nil {
+		return err
+	}
+	fileSets := a.getRenameFileSets(txnCtx.ClientContext)
+	var stale bool
+	for _, pipelineName := range pipelineNames {
+		pipelineInfo, err := a.latestPipelineInfo(txnCtx, pipelineName)
+		if err != nil {
+			if col.IsErrNotFound(err) {
+				continue
+			}
+			return err
+		}
+		var renamed bool
+		if err := pps.VisitInput(pipelineInfo.Details.Input, func(input *pps.Input) error {
+			inputRenamed, err := rename(input)
+			renamed = renamed || inputRenamed
+			return err
+		}); err != nil {
+			return err
+		}
+		if !renamed {
+			continue
+		}
+		request := ppsutil.PipelineReqFromInfo(pipelineInfo)
+		request.Update = true
+		key := fmt.Sprintf("%s@%d/%s", pipelineName, pipelineInfo.Version, request.Input.String())
+		filesetID := fileSets.ids[key]
+		prevPipelineVersion := pipelineInfo.Version
+		if err := a.CreatePipelineInTransaction(txnCtx, request, &filesetID, &prevPipelineVersion); err != nil {
+			if errors.Is(err, col.ErrTransactionConflict{}) {
+				fileSets.ids[key] = filesetID
+				fileSets.renewer.Add(filesetID)
+				stale = true
+				continue
+			}
+			return err
+		}
+	}
+	if stale {
+		return &col.ErrTransactionConflict{}
+	}
+	return nil
+}
+
 func pipelineTypeFromInfo(pipelineInfo *pps.PipelineInfo) pps.PipelineInfo_PipelineType {
 	if pipelineInfo.Details.Spout != nil {
 		return pps.PipelineInfo_PIPELINE_TYPE_SPOUT
@@ -2772,11 +2943,80 @@ func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineR
 	return &types.Empty{}, nil
 }
 
+// RunPipeline implements the protobuf pps.RunPipeline RPC
 func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if request.Pipeline == nil {
+		return nil, errors.New("request.Pipeline cannot be nil")
+	}
+
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		pipelineInfo, err := a.latestPipelineInfo(txnCtx, request.Pipeline.Name)
+		if err != nil {
+			return err
+		}
 
-	return nil, errors.New("unimplemented")
+		// check if the caller is authorized to update this pipeline
+		if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpUpdate, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
+			return err
+		}
+		if pipelineInfo.Type == pps.PipelineInfo_PIPELINE_TYPE_SPOUT {
+			return errors.Errorf("cannot run spout pipeline %s", pipelineInfo.Pipeline.Name)
+		}
+		if pipelineInfo.Stopped {
+			return errors.Errorf("cannot run stopped pipeline %s", pipelineInfo.Pipeline.Name)
+		}
+
+		// Resolve the requested provenance, so that the commits from job_id can be
+		// filled in for any input branches which weren't given explicitly.
+		var provenance []*pfs.Commit
+		explicit := make(map[string]bool)
+		for _, commit := range request.Provenance {
+			commitInfo, err := a.env.PfsServer().InspectCommitInTransaction(txnCtx, &pfs.InspectCommitRequest{
+				Commit: proto.Clone(commit).(*pfs.Commit),
+			})
+			if err != nil {
+				return err
+			}
+			if commitInfo.Commit.Branch.Repo.Type == pfs.SpecRepoType {
+				return errors.Errorf("cannot run pipeline %s with an explicit spec commit", pipelineInfo.Pipeline.Name)
+			}
+			provenance = append(provenance, commitInfo.Commit)
+			explicit[commitInfo.Commit.Branch.String()] = true
+		}
+		outputBranch := client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch)
+		if request.JobID != "" {
+			jobInfo := &pps.JobInfo{}
+			if err := a.jobs.ReadWrite(txnCtx.SqlTx).Get(ppsdb.JobKey(client.NewJob(pipelineInfo.Pipeline.Name, request.JobID)), jobInfo); err != nil {
+				return err
+			}
+			branchInfo, err := a.env.PfsServer().InspectBranchInTransaction(txnCtx, &pfs.InspectBranchRequest{
+				Branch: outputBranch,
+			})
+			if err != nil {
+				return err
+			}
+			// The pipeline's spec commit is always the current one, so that the new
+			// job runs with the current version of the pipeline.
+			for _, branch := range branchInfo.DirectProvenance {
+				if branch.Repo.Type == pfs.SpecRepoType || explicit[branch.String()] {
+					continue
+				}
+				provenance = append(provenance, branch.NewCommit(jobInfo.OutputCommit.ID))
+			}
+		}
+
+		// Start the new output and meta commits, PPS will create the job for them
+		// when the transaction's commits are propagated.
+		return a.env.PfsServer().RunBranchesInTransaction(txnCtx, []*pfs.Branch{
+			outputBranch,
+			client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
+		}, provenance)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
 }
 
 func (a *apiServer) RunCron(ctx context.Context, request *pps.RunCronRequest) (response *types.Empty, retErr error) {
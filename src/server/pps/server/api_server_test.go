@@ -0,0 +1,138 @@
+package server
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestRunPipelineProvenance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestRunPipelineProvenance_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{"cp /pfs/*/file /pfs/out/file"},
+		nil,
+		client.NewPFSInput(dataRepo, "/"),
+		"",
+		false,
+	))
+
+	var commits []*pfs.Commit
+	for _, data := range []string{"foo", "bar"} {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, "file", strings.NewReader(data)))
+		require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+		_, err = c.WaitCommitSetAll(commit.ID)
+		require.NoError(t, err)
+		commits = append(commits, commit)
+	}
+
+	// Run the pipeline on the first commit, which is no longer the head of the
+	// input branch
+	jobInfos, err := c.ListJob(pipeline, nil, -1, false)
+	require.NoError(t, err)
+	require.NoError(t, c.RunPipeline(pipeline, []*pfs.Commit{commits[0]}, ""))
+
+	var jobInfo *pps.JobInfo
+	require.NoErrorWithinTRetry(t, 60*time.Second, func() error {
+		newJobInfos, err := c.ListJob(pipeline, nil, -1, false)
+		if err != nil {
+			return err
+		}
+		if len(newJobInfos) != len(jobInfos)+1 {
+			return errors.Errorf("expected %d jobs, got %d", len(jobInfos)+1, len(newJobInfos))
+		}
+		prevIDs := make(map[string]bool)
+		for _, ji := range jobInfos {
+			prevIDs[ji.Job.ID] = true
+		}
+		for _, ji := range newJobInfos {
+			if !prevIDs[ji.Job.ID] {
+				jobInfo = ji
+			}
+		}
+		return nil
+	})
+	require.NotNil(t, jobInfo)
+
+	// The job's commitset should contain the first input commit's data, and the
+	// output commit should be the new head of the output branch
+	commitInfos, err := c.WaitCommitSetAll(jobInfo.Job.ID)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(client.NewCommit(dataRepo, "master", jobInfo.Job.ID), "file", &buf))
+	require.Equal(t, "foo", buf.String())
+	buf.Reset()
+	require.NoError(t, c.GetFile(client.NewCommit(pipeline, "master", ""), "file", &buf))
+	require.Equal(t, "foo", buf.String())
+	for _, ci := range commitInfos {
+		require.False(t, ci.Error)
+	}
+
+	// The input branch's head should be unchanged
+	headInfo, err := c.InspectCommit(dataRepo, "master", "")
+	require.NoError(t, err)
+	require.Equal(t, commits[1].ID, headInfo.Commit.ID)
+}
+
+func TestRunPipelineErrors(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestRunPipelineErrors_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	otherRepo := tu.UniqueString("TestRunPipelineErrors_other")
+	require.NoError(t, c.CreateRepo(otherRepo))
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{"cp /pfs/*/file /pfs/out/file"},
+		nil,
+		client.NewPFSInput(dataRepo, "/"),
+		"",
+		false,
+	))
+	require.NoError(t, c.PutFile(client.NewCommit(dataRepo, "master", ""), "file", strings.NewReader("foo")))
+	require.NoError(t, c.PutFile(client.NewCommit(otherRepo, "master", ""), "file", strings.NewReader("bar")))
+
+	// Commits outside the pipeline's provenance are rejected
+	err := c.RunPipeline(pipeline, []*pfs.Commit{client.NewCommit(otherRepo, "master", "")}, "")
+	require.YesError(t, err)
+	require.Matches(t, "not in the provenance", err.Error())
+
+	// Commits without a branch are rejected, rather than crashing pachd
+	require.YesError(t, c.RunPipeline(pipeline, []*pfs.Commit{{ID: "0123456789abcdef0123456789abcdef"}}, ""))
+
+	// Unknown jobs are rejected
+	require.YesError(t, c.RunPipeline(pipeline, nil, "0123456789abcdef0123456789abcdef"))
+
+	// Stopped pipelines can't be run
+	require.NoError(t, c.StopPipeline(pipeline))
+	err = c.RunPipeline(pipeline, nil, "")
+	require.YesError(t, err)
+	require.Matches(t, "stopped", err.Error())
+}
@@ -35,6 +35,8 @@ func NewAPIServer(
 		workerUsesRoot:        env.Config().WorkerUsesRoot,
 		pipelines:             ppsdb.Pipelines(env.GetDBClient(), env.GetPostgresListener()),
 		jobs:                  ppsdb.Jobs(env.GetDBClient(), env.GetPostgresListener()),
+		webhooks:              ppsdb.Webhooks(env.GetDBClient(), env.GetPostgresListener()),
+		webhookDeliveries:     ppsdb.WebhookDeliveries(env.GetDBClient(), env.GetPostgresListener()),
 		workerGrpcPort:        env.Config().PPSWorkerPort,
 		port:                  env.Config().Port,
 		peerPort:              env.Config().PeerPort,
@@ -42,6 +44,7 @@ func NewAPIServer(
 	}
 	apiServer.validateKube()
 	go apiServer.master()
+	go apiServer.webhookMaster()
 	return apiServer, nil
 }
 
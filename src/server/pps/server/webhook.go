@@ -0,0 +1,685 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	webhookMasterLockPath = "_webhook_master_lock"
+
+	defaultWebhookMaxAttempts = 10
+	webhookTimeout            = 10 * time.Second
+	webhookPollInterval       = time.Second
+	webhookMinBackoff         = 5 * time.Second
+	webhookMaxBackoff         = time.Hour
+	// webhookDeliveryRetention is how long successful deliveries are kept in
+	// the delivery history. Dead-lettered deliveries are kept until their
+	// webhook is deleted.
+	webhookDeliveryRetention = 7 * 24 * time.Hour
+	webhookPruneInterval     = time.Hour
+
+	webhookCommitEvent = "commit"
+	webhookJobEvent    = "job"
+)
+
+// CreateWebhook implements the protobuf pps.CreateWebhook RPC
+func (a *apiServer) CreateWebhook(ctx context.Context, request *pps.CreateWebhookRequest) (response *pps.WebhookInfo, retErr error) {
+	// The secret is left out of the logs.
+	logRequest := proto.Clone(request).(*pps.CreateWebhookRequest)
+	logRequest.Secret = ""
+	func() { a.Log(logRequest, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(logRequest, nil, retErr, time.Since(start)) }(time.Now())
+
+	if err := validateWebhookRequest(request); err != nil {
+		return nil, err
+	}
+	webhookInfo := &pps.WebhookInfo{
+		Webhook:     request.Webhook,
+		URL:         request.URL,
+		Secret:      request.Secret,
+		Commits:     request.Commits,
+		Jobs:        request.Jobs,
+		MaxAttempts: request.MaxAttempts,
+		Created:     types.TimestampNow(),
+	}
+	if webhookInfo.MaxAttempts == 0 {
+		webhookInfo.MaxAttempts = defaultWebhookMaxAttempts
+	}
+	if err := col.NewSQLTx(ctx, a.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
+		webhooks := a.webhooks.ReadWrite(sqlTx)
+		existing := &pps.WebhookInfo{}
+		if err := webhooks.Get(request.Webhook.Name, existing); err != nil {
+			if !col.IsErrNotFound(err) {
+				return err
+			}
+		} else {
+			if !request.Update {
+				return errors.Errorf("webhook %q already exists", request.Webhook.Name)
+			}
+			// Events are only sent to a webhook if they happened after it
+			// was created, so updates keep the creation time.
+			webhookInfo.Created = existing.Created
+			if webhookInfo.Secret == "" {
+				webhookInfo.Secret = existing.Secret
+			}
+		}
+		if webhookInfo.Secret == "" {
+			secret, err := newWebhookSecret()
+			if err != nil {
+				return err
+			}
+			webhookInfo.Secret = secret
+		}
+		return webhooks.Put(request.Webhook.Name, webhookInfo)
+	}); err != nil {
+		return nil, err
+	}
+	return webhookInfo, nil
+}
+
+func validateWebhookRequest(request *pps.CreateWebhookRequest) error {
+	if request.Webhook == nil || request.Webhook.Name == "" {
+		return errors.New("webhook name cannot be empty")
+	}
+	if err := ancestry.ValidateName(request.Webhook.Name); err != nil {
+		return errors.Wrapf(err, "invalid webhook name")
+	}
+	u, err := url.Parse(request.URL)
+	if err != nil {
+		return errors.Wrapf(err, "invalid webhook URL")
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("webhook URL %q must be an absolute http or https URL", request.URL)
+	}
+	if request.Commits == nil && request.Jobs == nil {
+		return errors.New("a webhook must have a commit filter or a job filter")
+	}
+	if request.Commits != nil {
+		for _, state := range request.Commits.States {
+			if state != pfs.CommitState_STARTED && state != pfs.CommitState_FINISHED {
+				return errors.Errorf("webhooks can only be sent commits in the STARTED or FINISHED states, not %v", state)
+			}
+		}
+	}
+	if request.MaxAttempts < 0 {
+		return errors.Errorf("max attempts cannot be negative")
+	}
+	return nil
+}
+
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// ListWebhook implements the protobuf pps.ListWebhook RPC
+func (a *apiServer) ListWebhook(request *pps.ListWebhookRequest, srv pps.API_ListWebhookServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+
+	webhookInfo := &pps.WebhookInfo{}
+	return a.webhooks.ReadOnly(srv.Context()).List(webhookInfo, col.DefaultOptions(), func(string) error {
+		webhookInfo.Secret = ""
+		return errors.EnsureStack(srv.Send(webhookInfo))
+	})
+}
+
+// DeleteWebhook implements the protobuf pps.DeleteWebhook RPC
+func (a *apiServer) DeleteWebhook(ctx context.Context, request *pps.DeleteWebhookRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if request.Webhook == nil {
+		return nil, errors.New("webhook cannot be nil")
+	}
+	if err := col.NewSQLTx(ctx, a.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
+		if err := a.webhooks.ReadWrite(sqlTx).Delete(request.Webhook.Name); err != nil {
+			return err
+		}
+		return a.webhookDeliveries.ReadWrite(sqlTx).DeleteByIndex(ppsdb.WebhookDeliveriesWebhookIndex, request.Webhook.Name)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// ListWebhookDelivery implements the protobuf pps.ListWebhookDelivery RPC
+func (a *apiServer) ListWebhookDelivery(request *pps.ListWebhookDeliveryRequest, srv pps.API_ListWebhookDeliveryServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+
+	if request.Webhook == nil {
+		return errors.New("webhook cannot be nil")
+	}
+	if err := a.webhooks.ReadOnly(srv.Context()).Get(request.Webhook.Name, &pps.WebhookInfo{}); err != nil {
+		return err
+	}
+	delivery := &pps.WebhookDelivery{}
+	return a.webhookDeliveries.ReadOnly(srv.Context()).GetByIndex(ppsdb.WebhookDeliveriesWebhookIndex, request.Webhook.Name, delivery, col.DefaultOptions(), func(string) error {
+		if request.State != pps.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_UNKNOWN && delivery.State != request.State {
+			return nil
+		}
+		return errors.EnsureStack(srv.Send(delivery))
+	})
+}
+
+// webhookMaster runs the webhook dispatcher on one pachd at a time.
+func (a *apiServer) webhookMaster() {
+	d := newWebhookDispatcher(a.env.GetDBClient(), a.env.GetPostgresListener())
+	masterLock := dlock.NewDLock(a.env.GetEtcdClient(), path.Join(a.etcdPrefix, webhookMasterLockPath))
+	ctx := context.Background()
+	backoff.RetryUntilCancel(ctx, func() error {
+		masterCtx, err := masterLock.Lock(ctx)
+		if err != nil {
+			return err
+		}
+		defer masterLock.Unlock(masterCtx)
+		return d.run(masterCtx)
+	}, backoff.NewInfiniteBackOff(), func(err error, _ time.Duration) error {
+		log.Errorf("error in webhook dispatcher: %v", err)
+		return nil
+	})
+}
+
+// webhookDispatcher sends commit and job events to webhooks. It watches the
+// commits and jobs collections, records a pending delivery for each event that
+// matches a webhook, and POSTs the pending deliveries, retrying the failed ones
+// with exponential backoff until they are dead-lettered.
+//
+// Deliveries are keyed by the commit or job and the state it reached, so each
+// event is recorded once per webhook however many times the watches see it.
+// The watches start by listing the collections, so the events that happened
+// while no dispatcher was running are recorded when the next one starts,
+// though only the latest state of each commit and job is seen. Deliveries are
+// at least once: a delivery that is received just before the dispatcher stops
+// is sent again by the next one.
+type webhookDispatcher struct {
+	db         *sqlx.DB
+	commits    col.PostgresCollection
+	jobs       col.PostgresCollection
+	webhooks   col.PostgresCollection
+	deliveries col.PostgresCollection
+	client     *http.Client
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	notify     chan struct{}
+
+	mu           sync.Mutex
+	webhookInfos map[string]*pps.WebhookInfo
+	// delivering holds the names of the webhooks whose deliveries are being
+	// attempted.
+	delivering map[string]bool
+}
+
+func newWebhookDispatcher(db *sqlx.DB, listener col.PostgresListener) *webhookDispatcher {
+	return &webhookDispatcher{
+		db:         db,
+		commits:    pfsdb.Commits(db, listener),
+		jobs:       ppsdb.Jobs(db, listener),
+		webhooks:   ppsdb.Webhooks(db, listener),
+		deliveries: ppsdb.WebhookDeliveries(db, listener),
+		client:     &http.Client{Timeout: webhookTimeout},
+		minBackoff: webhookMinBackoff,
+		maxBackoff: webhookMaxBackoff,
+		notify:     make(chan struct{}, 1),
+		delivering: make(map[string]bool),
+	}
+}
+
+func (d *webhookDispatcher) run(ctx context.Context) error {
+	// The webhooks are loaded before the commits and jobs are watched, so the
+	// events listed by those watches are matched against all of them.
+	webhookInfos := make(map[string]*pps.WebhookInfo)
+	webhookInfo := &pps.WebhookInfo{}
+	if err := d.webhooks.ReadOnly(ctx).List(webhookInfo, col.DefaultOptions(), func(name string) error {
+		webhookInfos[name] = proto.Clone(webhookInfo).(*pps.WebhookInfo)
+		return nil
+	}); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	d.webhookInfos = webhookInfos
+	d.mu.Unlock()
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		return d.watchWebhooks(ctx)
+	})
+	eg.Go(func() error {
+		return d.watchCommits(ctx)
+	})
+	eg.Go(func() error {
+		return d.watchJobs(ctx)
+	})
+	eg.Go(func() error {
+		return d.deliverForever(ctx)
+	})
+	eg.Go(func() error {
+		return d.pruneForever(ctx)
+	})
+	return eg.Wait()
+}
+
+func (d *webhookDispatcher) watchWebhooks(ctx context.Context) error {
+	return d.webhooks.ReadOnly(ctx).WatchF(func(ev *watch.Event) error {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		if ev.Type == watch.EventDelete {
+			delete(d.webhookInfos, string(ev.Key))
+			return nil
+		}
+		var name string
+		webhookInfo := &pps.WebhookInfo{}
+		if err := ev.Unmarshal(&name, webhookInfo); err != nil {
+			return errors.Wrapf(err, "unmarshal")
+		}
+		d.webhookInfos[name] = webhookInfo
+		return nil
+	})
+}
+
+func (d *webhookDispatcher) watchCommits(ctx context.Context) error {
+	return d.commits.ReadOnly(ctx).WatchF(func(ev *watch.Event) error {
+		var key string
+		commitInfo := &pfs.CommitInfo{}
+		if err := ev.Unmarshal(&key, commitInfo); err != nil {
+			return errors.Wrapf(err, "unmarshal")
+		}
+		if commitInfo.Commit.Branch.Repo.Type != pfs.UserRepoType {
+			return nil
+		}
+		state, eventTime := pfs.CommitState_STARTED, commitInfo.Started
+		if commitInfo.Finished != nil {
+			state, eventTime = pfs.CommitState_FINISHED, commitInfo.Finished
+		}
+		event := &pps.WebhookEvent{
+			Type:   webhookCommitEvent,
+			State:  state.String(),
+			Time:   eventTime,
+			Commit: commitInfo,
+		}
+		return d.record(ctx, key+"/"+state.String(), event, func(webhookInfo *pps.WebhookInfo) bool {
+			return matchesWebhookCommitFilter(webhookInfo.Commits, commitInfo, state)
+		})
+	}, watch.IgnoreDelete)
+}
+
+func matchesWebhookCommitFilter(filter *pps.WebhookCommitFilter, commitInfo *pfs.CommitInfo, state pfs.CommitState) bool {
+	if filter == nil {
+		return false
+	}
+	if filter.Repo != "" && filter.Repo != commitInfo.Commit.Branch.Repo.Name {
+		return false
+	}
+	if filter.Branch != "" && filter.Branch != commitInfo.Commit.Branch.Name {
+		return false
+	}
+	if len(filter.States) == 0 {
+		return true
+	}
+	for _, s := range filter.States {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *webhookDispatcher) watchJobs(ctx context.Context) error {
+	return d.jobs.ReadOnly(ctx).WatchF(func(ev *watch.Event) error {
+		var key string
+		jobInfo := &pps.JobInfo{}
+		if err := ev.Unmarshal(&key, jobInfo); err != nil {
+			return errors.Wrapf(err, "unmarshal")
+		}
+		jobInfo.Details = nil
+		eventTime := jobInfo.Created
+		if ppsutil.IsTerminal(jobInfo.State) && jobInfo.Finished != nil {
+			eventTime = jobInfo.Finished
+		} else if jobInfo.Started != nil {
+			eventTime = jobInfo.Started
+		}
+		event := &pps.WebhookEvent{
+			Type:  webhookJobEvent,
+			State: jobInfo.State.String(),
+			Time:  eventTime,
+			Job:   jobInfo,
+		}
+		// Restarted jobs go through the same states again.
+		eventKey := fmt.Sprintf("%s/%d/%s", key, jobInfo.Restart, jobInfo.State)
+		return d.record(ctx, eventKey, event, func(webhookInfo *pps.WebhookInfo) bool {
+			return matchesWebhookJobFilter(webhookInfo.Jobs, jobInfo)
+		})
+	}, watch.IgnoreDelete)
+}
+
+func matchesWebhookJobFilter(filter *pps.WebhookJobFilter, jobInfo *pps.JobInfo) bool {
+	if filter == nil {
+		return false
+	}
+	if filter.Pipeline != "" && filter.Pipeline != jobInfo.Job.Pipeline.Name {
+		return false
+	}
+	if len(filter.States) == 0 {
+		return true
+	}
+	for _, s := range filter.States {
+		if s == jobInfo.State {
+			return true
+		}
+	}
+	return false
+}
+
+// record records a pending delivery of event to each webhook that matches it
+// and that was created before it happened. eventKey identifies the event.
+func (d *webhookDispatcher) record(ctx context.Context, eventKey string, event *pps.WebhookEvent, matches func(*pps.WebhookInfo) bool) error {
+	var webhookInfos []*pps.WebhookInfo
+	d.mu.Lock()
+	for _, webhookInfo := range d.webhookInfos {
+		if matches(webhookInfo) && !timestampBefore(event.Time, webhookInfo.Created) {
+			webhookInfos = append(webhookInfos, webhookInfo)
+		}
+	}
+	d.mu.Unlock()
+	if len(webhookInfos) == 0 {
+		return nil
+	}
+	id := webhookEventID(eventKey)
+	now := types.TimestampNow()
+	if err := col.NewSQLTx(ctx, d.db, func(sqlTx *sqlx.Tx) error {
+		deliveries := d.deliveries.ReadWrite(sqlTx)
+		for _, webhookInfo := range webhookInfos {
+			key := ppsdb.WebhookDeliveryKey(webhookInfo.Webhook, id)
+			if err := deliveries.Get(key, &pps.WebhookDelivery{}); err == nil {
+				continue
+			} else if !col.IsErrNotFound(err) {
+				return err
+			}
+			webhookEvent := proto.Clone(event).(*pps.WebhookEvent)
+			webhookEvent.ID = id
+			webhookEvent.Webhook = webhookInfo.Webhook.Name
+			if err := deliveries.Put(key, &pps.WebhookDelivery{
+				Webhook:     webhookInfo.Webhook,
+				Event:       webhookEvent,
+				State:       pps.WebhookDeliveryState_DELIVERY_PENDING,
+				Created:     now,
+				NextAttempt: now,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	d.wake()
+	return nil
+}
+
+// wake wakes up deliverForever to attempt the pending deliveries.
+func (d *webhookDispatcher) wake() {
+	select {
+	case d.notify <- struct{}{}:
+	default:
+	}
+}
+
+func webhookEventID(eventKey string) string {
+	sum := sha256.Sum256([]byte(eventKey))
+	return hex.EncodeToString(sum[:16])
+}
+
+// timestampBefore returns true if a is before b. A missing timestamp is never
+// before another.
+func timestampBefore(a, b *types.Timestamp) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Seconds < b.Seconds || (a.Seconds == b.Seconds && a.Nanos < b.Nanos)
+}
+
+func (d *webhookDispatcher) deliverForever(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		ticker := time.NewTicker(webhookPollInterval)
+		defer ticker.Stop()
+		for {
+			if err := d.deliverPending(ctx, eg); err != nil {
+				return err
+			}
+			select {
+			case <-d.notify:
+			case <-ticker.C:
+			case <-ctx.Done():
+				return errors.EnsureStack(ctx.Err())
+			}
+		}
+	})
+	return eg.Wait()
+}
+
+// deliverPending attempts the pending deliveries that are due. Each webhook's
+// deliveries are attempted oldest first, in a goroutine of their own started
+// in eg, so a slow or unreachable webhook doesn't hold up the deliveries to
+// the others. The webhooks whose previous deliveries are still being
+// attempted are skipped until those are done.
+func (d *webhookDispatcher) deliverPending(ctx context.Context, eg *errgroup.Group) error {
+	now := time.Now()
+	due := make(map[string][]*pps.WebhookDelivery)
+	var names []string
+	delivery := &pps.WebhookDelivery{}
+	opts := &col.Options{Target: col.SortByCreateRevision, Order: col.SortAscend}
+	d.mu.Lock()
+	delivering := make(map[string]bool)
+	for name := range d.delivering {
+		delivering[name] = true
+	}
+	d.mu.Unlock()
+	if err := d.deliveries.ReadOnly(ctx).GetByIndex(ppsdb.WebhookDeliveriesStateIndex, pps.WebhookDeliveryState_DELIVERY_PENDING.String(), delivery, opts, func(string) error {
+		name := delivery.Webhook.Name
+		if delivering[name] {
+			return nil
+		}
+		nextAttempt, err := types.TimestampFromProto(delivery.NextAttempt)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if !nextAttempt.After(now) {
+			if _, ok := due[name]; !ok {
+				names = append(names, name)
+			}
+			due[name] = append(due[name], proto.Clone(delivery).(*pps.WebhookDelivery))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, name := range names {
+		name, deliveries := name, due[name]
+		d.mu.Lock()
+		d.delivering[name] = true
+		d.mu.Unlock()
+		eg.Go(func() error {
+			defer func() {
+				d.mu.Lock()
+				delete(d.delivering, name)
+				d.mu.Unlock()
+				// Pick up the deliveries to the webhook that became due
+				// while these were being attempted.
+				d.wake()
+			}()
+			for _, delivery := range deliveries {
+				if err := d.attempt(ctx, delivery); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	return nil
+}
+
+// attempt POSTs a delivery to its webhook and records the result.
+func (d *webhookDispatcher) attempt(ctx context.Context, delivery *pps.WebhookDelivery) error {
+	key := ppsdb.WebhookDeliveryKey(delivery.Webhook, delivery.Event.ID)
+	d.mu.Lock()
+	webhookInfo, ok := d.webhookInfos[delivery.Webhook.Name]
+	d.mu.Unlock()
+	if !ok {
+		// The webhook was deleted after the delivery was recorded.
+		return col.NewSQLTx(ctx, d.db, func(sqlTx *sqlx.Tx) error {
+			if err := d.deliveries.ReadWrite(sqlTx).Delete(key); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+			return nil
+		})
+	}
+	statusCode, postErr := d.post(ctx, webhookInfo, delivery.Event)
+	if err := col.NewSQLTx(ctx, d.db, func(sqlTx *sqlx.Tx) error {
+		update := &pps.WebhookDelivery{}
+		return d.deliveries.ReadWrite(sqlTx).Update(key, update, func() error {
+			update.Attempts++
+			update.StatusCode = int32(statusCode)
+			update.Error = ""
+			now := types.TimestampNow()
+			switch {
+			case postErr == nil:
+				update.State = pps.WebhookDeliveryState_DELIVERY_SUCCEEDED
+				update.NextAttempt = nil
+				update.Finished = now
+			case update.Attempts >= webhookInfo.MaxAttempts:
+				log.Errorf("dead-lettering delivery %s to webhook %q after %d attempts: %v", update.Event.ID, webhookInfo.Webhook.Name, update.Attempts, postErr)
+				update.State = pps.WebhookDeliveryState_DELIVERY_DEAD_LETTER
+				update.Error = postErr.Error()
+				update.NextAttempt = nil
+				update.Finished = now
+			default:
+				nextAttempt, err := types.TimestampProto(time.Now().Add(d.retryDelay(update.Attempts)))
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				update.Error = postErr.Error()
+				update.NextAttempt = nextAttempt
+			}
+			return nil
+		})
+	}); err != nil && !col.IsErrNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// retryDelay returns how long to wait after a delivery's attempts before
+// attempting it again. The delay doubles with each attempt.
+func (d *webhookDispatcher) retryDelay(attempts int64) time.Duration {
+	delay := d.minBackoff
+	for i := int64(1); i < attempts && delay < d.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > d.maxBackoff {
+		delay = d.maxBackoff
+	}
+	return delay
+}
+
+// post POSTs event to a webhook, and returns the status code of the response.
+func (d *webhookDispatcher) post(ctx context.Context, webhookInfo *pps.WebhookInfo, event *pps.WebhookEvent) (int, error) {
+	payload, err := (&jsonpb.Marshaler{}).MarshalToString(event)
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookInfo.URL, strings.NewReader(payload))
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(pps.WebhookDeliveryHeader, event.ID)
+	req.Header.Set(pps.WebhookEventHeader, event.Type)
+	req.Header.Set(pps.WebhookSignatureHeader, pps.SignWebhookPayload(webhookInfo.Secret, []byte(payload)))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, errors.Errorf("webhook responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+func (d *webhookDispatcher) pruneForever(ctx context.Context) error {
+	ticker := time.NewTicker(webhookPruneInterval)
+	defer ticker.Stop()
+	for {
+		if err := d.prune(ctx, time.Now().Add(-webhookDeliveryRetention)); err != nil {
+			return err
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+	}
+}
+
+// prune deletes the successful deliveries that finished before cutoff.
+func (d *webhookDispatcher) prune(ctx context.Context, cutoff time.Time) error {
+	var keys []string
+	delivery := &pps.WebhookDelivery{}
+	if err := d.deliveries.ReadOnly(ctx).GetByIndex(ppsdb.WebhookDeliveriesStateIndex, pps.WebhookDeliveryState_DELIVERY_SUCCEEDED.String(), delivery, col.DefaultOptions(), func(key string) error {
+		finished, err := types.TimestampFromProto(delivery.Finished)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if finished.Before(cutoff) {
+			keys = append(keys, key)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return col.NewSQLTx(ctx, d.db, func(sqlTx *sqlx.Tx) error {
+		deliveries := d.deliveries.ReadWrite(sqlTx)
+		for _, key := range keys {
+			if err := deliveries.Delete(key); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+		}
+		return nil
+	})
+}
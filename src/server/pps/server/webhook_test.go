@@ -0,0 +1,186 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestWebhookDispatcher(t *testing.T) {
+	env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+	db := env.ServiceEnv.GetDBClient()
+	d := newWebhookDispatcher(db, env.ServiceEnv.GetPostgresListener())
+	d.minBackoff = 10 * time.Millisecond
+
+	received := make(chan *pps.WebhookEvent, 10)
+	okServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, err := ioutil.ReadAll(r.Body)
+		if err != nil || !pps.VerifyWebhookSignature("secret", payload, r.Header.Get(pps.WebhookSignatureHeader)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		event := &pps.WebhookEvent{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(payload), event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- event
+	}))
+	defer okServer.Close()
+	failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failingServer.Close()
+
+	filter := &pps.WebhookCommitFilter{
+		Repo:   "test",
+		States: []pfs.CommitState{pfs.CommitState_FINISHED},
+	}
+	webhooks := ppsdb.Webhooks(db, nil)
+	require.NoError(t, col.NewSQLTx(context.Background(), db, func(sqlTx *sqlx.Tx) error {
+		if err := webhooks.ReadWrite(sqlTx).Put("ok", &pps.WebhookInfo{
+			Webhook:     &pps.Webhook{Name: "ok"},
+			URL:         okServer.URL,
+			Secret:      "secret",
+			Commits:     filter,
+			MaxAttempts: 10,
+			Created:     types.TimestampNow(),
+		}); err != nil {
+			return err
+		}
+		return webhooks.ReadWrite(sqlTx).Put("failing", &pps.WebhookInfo{
+			Webhook:     &pps.Webhook{Name: "failing"},
+			URL:         failingServer.URL,
+			Secret:      "secret",
+			Commits:     filter,
+			MaxAttempts: 2,
+			Created:     types.TimestampNow(),
+		})
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go d.run(ctx)
+
+	require.NoError(t, env.PachClient.CreateRepo("test"))
+	require.NoError(t, env.PachClient.CreateRepo("other"))
+	require.NoError(t, env.PachClient.PutFile(client.NewCommit("other", "master", ""), "/a", strings.NewReader("a")))
+	require.NoError(t, env.PachClient.PutFile(client.NewCommit("test", "master", ""), "/a", strings.NewReader("a")))
+
+	select {
+	case event := <-received:
+		require.Equal(t, "ok", event.Webhook)
+		require.Equal(t, "commit", event.Type)
+		require.Equal(t, "FINISHED", event.State)
+		require.Equal(t, "test", event.Commit.Commit.Branch.Repo.Name)
+	case <-time.After(30 * time.Second):
+		t.Fatal("timed out waiting for the webhook")
+	}
+
+	deliveries := ppsdb.WebhookDeliveries(db, nil)
+	require.NoErrorWithinTRetry(t, 30*time.Second, func() error {
+		var states []pps.WebhookDeliveryState
+		delivery := &pps.WebhookDelivery{}
+		if err := deliveries.ReadOnly(ctx).GetByIndex(ppsdb.WebhookDeliveriesWebhookIndex, "failing", delivery, col.DefaultOptions(), func(string) error {
+			if delivery.State == pps.WebhookDeliveryState_DELIVERY_DEAD_LETTER && (delivery.Attempts != 2 || delivery.StatusCode != http.StatusInternalServerError) {
+				return errors.Errorf("dead-lettered after %d attempts with status %d", delivery.Attempts, delivery.StatusCode)
+			}
+			states = append(states, delivery.State)
+			return nil
+		}); err != nil {
+			return err
+		}
+		if len(states) != 1 || states[0] != pps.WebhookDeliveryState_DELIVERY_DEAD_LETTER {
+			return errors.Errorf("expected one dead-lettered delivery, got %v", states)
+		}
+		return nil
+	})
+	select {
+	case event := <-received:
+		t.Fatalf("unexpected event for %v", event.Commit.Commit)
+	default:
+	}
+}
+
+func TestWebhookSlowEndpoint(t *testing.T) {
+	env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+	db := env.ServiceEnv.GetDBClient()
+	d := newWebhookDispatcher(db, env.ServiceEnv.GetPostgresListener())
+	d.client = &http.Client{}
+
+	// The slow webhook doesn't respond until the test is over.
+	release := make(chan struct{})
+	defer close(release)
+	slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer slowServer.Close()
+	received := make(chan struct{}, 10)
+	okServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- struct{}{}
+	}))
+	defer okServer.Close()
+
+	filter := &pps.WebhookCommitFilter{
+		Repo:   "test",
+		States: []pfs.CommitState{pfs.CommitState_FINISHED},
+	}
+	webhooks := ppsdb.Webhooks(db, nil)
+	require.NoError(t, col.NewSQLTx(context.Background(), db, func(sqlTx *sqlx.Tx) error {
+		for name, url := range map[string]string{"slow": slowServer.URL, "ok": okServer.URL} {
+			if err := webhooks.ReadWrite(sqlTx).Put(name, &pps.WebhookInfo{
+				Webhook:     &pps.Webhook{Name: name},
+				URL:         url,
+				Secret:      "secret",
+				Commits:     filter,
+				MaxAttempts: 1,
+				Created:     types.TimestampNow(),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go d.run(ctx)
+
+	// Deliveries to the ok webhook go through while the slow webhook is still
+	// handling the first one.
+	require.NoError(t, env.PachClient.CreateRepo("test"))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("test", "master", ""), "/a", strings.NewReader("a")))
+		select {
+		case <-received:
+		case <-time.After(30 * time.Second):
+			t.Fatal("timed out waiting for the webhook")
+		}
+	}
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	d := &webhookDispatcher{minBackoff: time.Second, maxBackoff: time.Minute}
+	require.Equal(t, time.Second, d.retryDelay(1))
+	require.Equal(t, 4*time.Second, d.retryDelay(3))
+	require.Equal(t, time.Minute, d.retryDelay(10))
+}
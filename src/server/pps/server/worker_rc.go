@@ -243,6 +243,23 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		sidecarVolumeMounts = append(sidecarVolumeMounts, emptyDirVolumeMount)
 		userVolumeMounts = append(userVolumeMounts, emptyDirVolumeMount)
 	}
+	// Share the persistent disk cache between the workers on a node.
+	if hostPath := a.env.Config().StorageDiskCacheHostPath; hostPath != "" && a.env.Config().StorageDiskCacheBytes > 0 {
+		hostPathType := v1.HostPathDirectoryOrCreate
+		options.volumes = append(options.volumes, v1.Volume{
+			Name: "pach-disk-cache",
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: hostPath,
+					Type: &hostPathType,
+				},
+			},
+		})
+		sidecarVolumeMounts = append(sidecarVolumeMounts, v1.VolumeMount{
+			Name:      "pach-disk-cache",
+			MountPath: a.env.Config().StorageDiskCachePath,
+		})
+	}
 	secretVolume, secretMount := assets.GetBackendSecretVolumeAndMount(a.storageBackend)
 	options.volumes = append(options.volumes, secretVolume)
 	sidecarVolumeMounts = append(sidecarVolumeMounts, secretMount)
@@ -427,6 +444,10 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 func (a *apiServer) getStorageEnvVars(pipelineInfo *pps.PipelineInfo) []v1.EnvVar {
 	vars := []v1.EnvVar{
 		{Name: assets.UploadConcurrencyLimitEnvVar, Value: strconv.Itoa(a.env.Config().StorageUploadConcurrencyLimit)},
+		{Name: assets.CompressionEnvVar, Value: a.env.Config().StorageCompression},
+		{Name: assets.DiskCacheBytesEnvVar, Value: strconv.FormatInt(a.env.Config().StorageDiskCacheBytes, 10)},
+		{Name: assets.DiskCachePathEnvVar, Value: a.env.Config().StorageDiskCachePath},
+		{Name: assets.DiskCachePolicyEnvVar, Value: a.env.Config().StorageDiskCachePolicy},
 		{Name: client.PPSPipelineNameEnv, Value: pipelineInfo.Pipeline.Name},
 	}
 	return vars
@@ -69,6 +69,10 @@ func sprintDeleteRepo(request *pfs.DeleteRepoRequest) string {
 	return fmt.Sprintf("delete repo %s %s", request.Repo, force)
 }
 
+func sprintRenameRepo(request *pfs.RenameRepoRequest) string {
+	return fmt.Sprintf("rename repo %s %s", request.Repo, request.NewName)
+}
+
 func sprintStartCommit(request *pfs.StartCommitRequest, response *transaction.TransactionResponse) string {
 	var commit string
 	if response == nil || response.Commit == nil {
@@ -104,6 +108,10 @@ func sprintDeleteBranch(request *pfs.DeleteBranchRequest) string {
 	return fmt.Sprintf("delete branch %s%s", request.Branch, force)
 }
 
+func sprintRenameBranch(request *pfs.RenameBranchRequest) string {
+	return fmt.Sprintf("rename branch %s %s", request.Branch, request.NewName)
+}
+
 func sprintUpdateJobState(request *pps.UpdateJobStateRequest) string {
 	state := func() string {
 		switch request.State {
@@ -150,6 +158,8 @@ func transactionRequests(
 			line = sprintCreateRepo(request.CreateRepo)
 		} else if request.DeleteRepo != nil {
 			line = sprintDeleteRepo(request.DeleteRepo)
+		} else if request.RenameRepo != nil {
+			line = sprintRenameRepo(request.RenameRepo)
 		} else if request.StartCommit != nil {
 			if len(responses) > i {
 				line = sprintStartCommit(request.StartCommit, responses[i])
@@ -164,6 +174,8 @@ func transactionRequests(
 			line = sprintCreateBranch(request.CreateBranch)
 		} else if request.DeleteBranch != nil {
 			line = sprintDeleteBranch(request.DeleteBranch)
+		} else if request.RenameBranch != nil {
+			line = sprintRenameBranch(request.RenameBranch)
 		} else if request.UpdateJobState != nil {
 			line = sprintUpdateJobState(request.UpdateJobState)
 		} else if request.CreatePipeline != nil {
@@ -20,6 +20,7 @@ import (
 )
 
 type driver struct {
+	env serviceenv.ServiceEnv
 	// txnEnv stores references to other pachyderm APIServer instances so we can
 	// make calls within the same transaction without serializing through RPCs
 	txnEnv       *txnenv.TransactionEnv
@@ -33,6 +34,7 @@ func newDriver(
 ) (*driver, error) {
 
 	return &driver{
+		env:          env,
 		txnEnv:       txnEnv,
 		db:           env.GetDBClient(),
 		transactions: transactiondb.Transactions(env.GetDBClient(), env.GetPostgresListener()),
@@ -47,7 +49,21 @@ func now() *types.Timestamp {
 	return t
 }
 
+// prepareRequests does the work of requests which can be done before the
+// transaction running them, so that it isn't held open for it.
+func (d *driver) prepareRequests(ctx context.Context, requests []*transaction.TransactionRequest) {
+	if d.env.PfsServer() == nil {
+		return
+	}
+	for _, request := range requests {
+		if request.FinishCommit != nil {
+			d.env.PfsServer().PrepareFinishCommit(ctx, request.FinishCommit)
+		}
+	}
+}
+
 func (d *driver) batchTransaction(ctx context.Context, req []*transaction.TransactionRequest) (*transaction.TransactionInfo, error) {
+	d.prepareRequests(ctx, req)
 	var result *transaction.TransactionInfo
 	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
 		// Because we're building and running the entire transaction atomically here,
@@ -166,6 +182,10 @@ func (d *driver) runTransaction(txnCtx *txncontext.TransactionContext, info *tra
 			err = directTxn.CreateBranch(request.CreateBranch)
 		} else if request.DeleteBranch != nil {
 			err = directTxn.DeleteBranch(request.DeleteBranch)
+		} else if request.RenameRepo != nil {
+			err = directTxn.RenameRepo(request.RenameRepo)
+		} else if request.RenameBranch != nil {
+			err = directTxn.RenameBranch(request.RenameBranch)
 		} else if request.UpdateJobState != nil {
 			err = directTxn.UpdateJobState(request.UpdateJobState)
 		} else if request.DeleteAll != nil {
@@ -198,6 +218,11 @@ func (d *driver) runTransaction(txnCtx *txncontext.TransactionContext, info *tra
 }
 
 func (d *driver) finishTransaction(ctx context.Context, txn *transaction.Transaction) (*transaction.TransactionInfo, error) {
+	// The requests are run with the version of the transaction read in the
+	// write transaction, which is usually this one.
+	if info, err := d.inspectTransaction(ctx, txn); err == nil {
+		d.prepareRequests(ctx, info.Requests)
+	}
 	return d.updateTransaction(ctx, true, txn, func(txnCtx *txncontext.TransactionContext, info *transaction.TransactionInfo, restarted bool) (*transaction.TransactionInfo, error) {
 		info, err := d.runTransaction(txnCtx, info)
 		if err != nil {
@@ -228,7 +253,7 @@ func (d *driver) appendTransaction(
 	// We do a dryrun of the transaction to
 	// 1. make sure the appended request is valid
 	// 2. Capture the result of the request to be returned
-
+	d.prepareRequests(ctx, items)
 	return d.updateTransaction(ctx, false, txn, func(txnCtx *txncontext.TransactionContext, info *transaction.TransactionInfo, restarted bool) (*transaction.TransactionInfo, error) {
 		if restarted {
 			info.Requests = append(info.Requests, items...)
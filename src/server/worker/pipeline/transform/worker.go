@@ -76,9 +76,9 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 	storageRoot := filepath.Join(driver.InputDir(), client.PPSScratchSpace, uuid.NewWithoutDashes())
 	datumSet.Stats = &datum.Stats{ProcessStats: &pps.ProcessStats{}}
 	// Setup file operation client for output meta commit.
-	resp, err := pachClient.WithCreateFileSetClient(func(mfMeta client.ModifyFile) error {
+	resp, err := pachClient.WithCreateFileSetClientForCommit(datumSet.OutputCommit, func(mfMeta client.ModifyFile) error {
 		// Setup file operation client for output PFS commit.
-		resp, err := pachClient.WithCreateFileSetClient(func(mfPFS client.ModifyFile) (retErr error) {
+		resp, err := pachClient.WithCreateFileSetClientForCommit(datumSet.OutputCommit, func(mfPFS client.ModifyFile) (retErr error) {
 			opts := []datum.SetOption{
 				datum.WithMetaOutput(mfMeta),
 				datum.WithPFSOutput(mfPFS),